@@ -17,92 +17,274 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+var (
+	ErrResumeLegacyBackup = errors.New("unable to resume a legacy backup")
+	ErrBackupInterrupted  = errors.New("the backup has been interrupted")
+)
+
 // CreateBackup fetches blockchain data with the specific range via gRPC
-// and save this data as binary archive to given path
+// and save this data as binary archive to given path.
+// The blocks are written in chunks compressed with the given compression.
+// If resume is set and the file exists, the backup continues after the last complete chunk of the file,
+// with the compression of the file
 func CreateBackup(
 	conn *grpc.ClientConn,
 	logger hclog.Logger,
 	from uint64,
 	to *uint64,
 	outPath string,
+	compression Compression,
+	resume bool,
 ) (uint64, uint64, error) {
-	// always create new file, throw error if the file exists
-	fs, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	signalCh := common.GetTerminationSignalCh()
+	ctx, cancelFn := context.WithCancel(context.Background())
+
+	defer cancelFn()
+
+	go func() {
+		<-signalCh
+		logger.Info("Caught termination signal, shutting down...")
+		cancelFn()
+	}()
+
+	return createBackup(ctx, proto.NewSystemClient(conn), logger, from, to, outPath, compression, resume)
+}
+
+func createBackup(
+	ctx context.Context,
+	clt proto.SystemClient,
+	logger hclog.Logger,
+	from uint64,
+	to *uint64,
+	outPath string,
+	compression Compression,
+	resume bool,
+) (uint64, uint64, error) {
+	backup, err := openBackupFile(outPath, logger, compression, resume)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	closeFile := func() error {
-		if err := fs.Close(); err != nil {
-			logger.Error("an error occurred while closing file", "err", err)
+	resFrom, resTo, err := backup.write(ctx, clt, from, to)
 
-			return err
-		}
+	if closeErr := backup.file.Close(); closeErr != nil {
+		logger.Error("an error occurred while closing file", "err", closeErr)
 
-		return nil
-	}
-	removeFile := func() {
-		if err := os.Remove(outPath); err != nil {
-			logger.Error("an error occurred while removing file", "err", err)
+		if err == nil {
+			err = closeErr
 		}
 	}
-	// clean up function for the file when error occurs in the middle of function
-	closeAndRemoveFile := func() {
-		if err := closeFile(); err == nil {
-			removeFile()
+
+	if err != nil {
+		if backup.created && len(backup.chunks) == 0 {
+			// clean up the file when nothing could be written
+			if err := os.Remove(outPath); err != nil {
+				logger.Error("an error occurred while removing file", "err", err)
+			}
+		} else if len(backup.chunks) != 0 {
+			logger.Info(
+				"The written blocks are kept, the backup can be resumed",
+				"path", outPath,
+				"to", backup.chunks[len(backup.chunks)-1].To,
+			)
 		}
+
+		return 0, 0, err
 	}
 
-	signalCh := common.GetTerminationSignalCh()
-	ctx, cancelFn := context.WithCancel(context.Background())
+	return resFrom, resTo, nil
+}
 
-	defer cancelFn()
+// backupFile is a backup file being written
+type backupFile struct {
+	file        *os.File
+	logger      hclog.Logger
+	compression Compression
+	// chunks are the chunks written in the file so far
+	chunks []*Chunk
+	// created is true if the file has been created for this backup
+	created bool
+}
 
-	go func() {
-		<-signalCh
-		logger.Info("Caught termination signal, shutting down...")
-		cancelFn()
-	}()
+// openBackupFile creates the backup file, or opens the existing file to resume it
+func openBackupFile(path string, logger hclog.Logger, compression Compression, resume bool) (*backupFile, error) {
+	if resume {
+		file, err := os.OpenFile(path, os.O_RDWR, 0)
+		if err == nil {
+			backup, err := resumeBackupFile(file, logger, compression)
+			if err != nil {
+				_ = file.Close()
 
-	clt := proto.NewSystemClient(conn)
+				return nil, err
+			}
 
-	reqTo, reqToHash, err := determineTo(ctx, clt, to)
+			return backup, nil
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		logger.Info("No backup to resume, creating a new one", "path", path)
+	}
+
+	// always create new file, throw error if the file exists
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
 	if err != nil {
-		closeAndRemoveFile()
+		return nil, err
+	}
 
-		return 0, 0, err
+	backup := &backupFile{
+		file:        file,
+		logger:      logger,
+		compression: compression,
+		created:     true,
 	}
 
-	stream, err := clt.Export(ctx, &proto.ExportRequest{
-		From: from,
-		To:   reqTo,
-	})
+	if err := writeHeader(file, compression); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// resumeBackupFile verifies the chunks of the existing backup file,
+// and drops everything after the last complete chunk so that new chunks can be appended
+func resumeBackupFile(file *os.File, logger hclog.Logger, compression Compression) (*backupFile, error) {
+	fileCompression, err := readHeader(file)
+	if errors.Is(err, errLegacyBackup) {
+		return nil, ErrResumeLegacyBackup
+	} else if err != nil {
+		return nil, err
+	}
+
+	if fileCompression != compression {
+		logger.Info("Keeping the compression of the resumed backup", "compression", fileCompression)
+	}
+
+	scan, err := scanBackup(file, fileCompression, true)
 	if err != nil {
-		closeAndRemoveFile()
+		return nil, err
+	}
+
+	if scan.damaged {
+		logger.Info("Dropping the incomplete data at the end of the backup")
+	}
+
+	// the metadata is written again once the backup is complete
+	if err := file.Truncate(scan.end); err != nil {
+		return nil, err
+	}
+
+	if _, err := file.Seek(scan.end, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	backup := &backupFile{
+		file:        file,
+		logger:      logger,
+		compression: fileCompression,
+		chunks:      make([]*Chunk, len(scan.chunks)),
+	}
+
+	for i, f := range scan.chunks {
+		backup.chunks[i] = f.chunk()
+	}
 
+	if last := scan.lastChunk(); last != nil {
+		logger.Info("Resuming backup", "from", scan.chunks[0].from, "to", last.to, "chunks", len(scan.chunks))
+	}
+
+	return backup, nil
+}
+
+// write fetches the blocks after the last chunk in the file up to the given height,
+// and completes the backup with the metadata
+func (b *backupFile) write(ctx context.Context, clt proto.SystemClient, from uint64, to *uint64) (uint64, uint64, error) {
+	if len(b.chunks) != 0 {
+		from = b.chunks[len(b.chunks)-1].To + 1
+	}
+
+	reqTo, reqToHash, err := determineTo(ctx, clt, to)
+	if err != nil {
 		return 0, 0, err
 	}
 
-	if err := writeMetadata(fs, logger, reqTo, reqToHash); err != nil {
-		closeAndRemoveFile()
+	if from <= reqTo {
+		stream, err := clt.Export(ctx, &proto.ExportRequest{
+			From: from,
+			To:   reqTo,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if _, _, err := processExportStream(stream, b.logger, b, from, reqTo); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if len(b.chunks) == 0 {
+		return 0, 0, errors.New("couldn't get any blocks")
+	}
+
+	first, last := b.chunks[0], b.chunks[len(b.chunks)-1]
+	if last.To < reqTo {
+		return 0, 0, fmt.Errorf("%w at block %d", ErrBackupInterrupted, last.To)
+	}
+
+	latestHash := reqToHash
+	if last.To != reqTo {
+		// the resumed backup already goes beyond the requested height
+		if latestHash, err = getBlockHash(ctx, clt, last.To); err != nil {
+			return 0, 0, err
+		}
+	}
 
+	if err := b.writeMetadata(last.To, latestHash); err != nil {
 		return 0, 0, err
 	}
 
-	resFrom, resTo, err := processExportStream(stream, logger, fs, from, reqTo)
+	return first.From, last.To, nil
+}
+
+// writeChunk compresses the blocks and writes them as a new chunk
+func (b *backupFile) writeChunk(from, to uint64, data []byte) error {
+	payload, err := b.compression.compress(data)
 	if err != nil {
-		closeAndRemoveFile()
+		return err
+	}
 
-		return 0, 0, err
+	chunk, err := writeFrame(b.file, frameKindChunk, from, to, payload)
+	if err != nil {
+		return err
 	}
 
-	if err := closeFile(); err != nil {
-		removeFile()
+	b.chunks = append(b.chunks, chunk)
 
-		return 0, 0, err
+	return nil
+}
+
+// writeMetadata writes the latest block height and the block hash
+// with the manifest of the chunks at the end of the backup
+func (b *backupFile) writeMetadata(to uint64, toHash types.Hash) error {
+	metadata := Metadata{
+		Latest:      to,
+		LatestHash:  toHash,
+		Compression: b.compression,
+		Chunks:      b.chunks,
+	}
+
+	if _, err := writeFrame(b.file, frameKindMetadata, 0, 0, metadata.MarshalRLP()); err != nil {
+		return err
 	}
 
-	return *resFrom, *resTo, nil
+	b.logger.Info("Wrote metadata to backup", "latest", to, "hash", toHash, "chunks", len(b.chunks))
+
+	return nil
 }
 
 func determineTo(ctx context.Context, clt proto.SystemClient, to *uint64) (uint64, types.Hash, error) {
@@ -127,27 +309,30 @@ func determineTo(ctx context.Context, clt proto.SystemClient, to *uint64) (uint6
 	return uint64(status.Current.Number), types.StringToHash(status.Current.Hash), nil
 }
 
-// writeMetadata writes the latest block height and the block hash to the writer
-func writeMetadata(writer io.Writer, logger hclog.Logger, to uint64, toHash types.Hash) error {
-	metadata := Metadata{
-		Latest:     to,
-		LatestHash: toHash,
+// getBlockHash returns the hash of the block at the given height
+func getBlockHash(ctx context.Context, clt proto.SystemClient, number uint64) (types.Hash, error) {
+	resp, err := clt.BlockByNumber(ctx, &proto.BlockByNumberRequest{Number: number})
+	if err != nil {
+		return types.Hash{}, err
 	}
 
-	_, err := writer.Write(metadata.MarshalRLP())
-	if err != nil {
-		return err
+	block := types.Block{}
+	if err := block.UnmarshalRLP(resp.Data); err != nil {
+		return types.Hash{}, err
 	}
 
-	logger.Info("Wrote metadata to backup", "latest", to, "hash", toHash)
+	return block.Hash(), nil
+}
 
-	return err
+// chunkWriter writes the blocks received from the export stream
+type chunkWriter interface {
+	writeChunk(from, to uint64, data []byte) error
 }
 
 func processExportStream(
 	stream proto.System_ExportClient,
 	logger hclog.Logger,
-	writer io.Writer,
+	writer chunkWriter,
 	targetFrom, targetTo uint64,
 ) (*uint64, *uint64, error) {
 	var from, to *uint64
@@ -193,7 +378,7 @@ func processExportStream(
 			return nil, nil, err
 		}
 
-		if _, err := writer.Write(event.Data); err != nil {
+		if err := writer.writeChunk(event.From, event.To, event.Data); err != nil {
 			return nil, nil, err
 		}
 
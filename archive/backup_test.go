@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/server/proto"
@@ -90,6 +92,16 @@ func (m *systemClientMock) BlockByNumber(
 	return m.block, m.errForBlock
 }
 
+type mockChunkWriter struct {
+	buffer bytes.Buffer
+}
+
+func (m *mockChunkWriter) writeChunk(_, _ uint64, data []byte) error {
+	_, err := m.buffer.Write(data)
+
+	return err
+}
+
 func Test_determineTo(t *testing.T) {
 	t.Parallel()
 
@@ -251,8 +263,8 @@ func Test_processExportStream(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var buffer bytes.Buffer
-			from, to, err := processExportStream(tt.mockSystemExportClient, hclog.NewNullLogger(), &buffer, 0, 0)
+			writer := &mockChunkWriter{}
+			from, to, err := processExportStream(tt.mockSystemExportClient, hclog.NewNullLogger(), writer, 0, 0)
 
 			assert.Equal(t, tt.err, err)
 			if err != nil {
@@ -270,7 +282,281 @@ func Test_processExportStream(t *testing.T) {
 				}
 				expectedData = append(expectedData, rv.event.Data...)
 			}
-			assert.Equal(t, expectedData, buffer.Bytes())
+			assert.Equal(t, expectedData, writer.buffer.Bytes())
 		})
 	}
 }
+
+// exportSystemClientMock is a node with the genesis and the test blocks
+type exportSystemClientMock struct {
+	proto.SystemClient
+	// latest is the height of the latest block in the node
+	latest uint64
+}
+
+func (m *exportSystemClientMock) getBlock(number uint64) *types.Block {
+	return append([]*types.Block{genesis}, blocks...)[number]
+}
+
+func (m *exportSystemClientMock) GetStatus(context.Context, *emptypb.Empty, ...grpc.CallOption) (*proto.ServerStatus, error) {
+	return &proto.ServerStatus{
+		Current: &proto.ServerStatus_Block{
+			Number: int64(m.latest),
+			Hash:   m.getBlock(m.latest).Hash().String(),
+		},
+	}, nil
+}
+
+func (m *exportSystemClientMock) BlockByNumber(
+	_ context.Context,
+	req *proto.BlockByNumberRequest,
+	_ ...grpc.CallOption,
+) (*proto.BlockResponse, error) {
+	if req.Number > m.latest {
+		return nil, errors.New("block not found")
+	}
+
+	return &proto.BlockResponse{
+		Data: m.getBlock(req.Number).MarshalRLP(),
+	}, nil
+}
+
+// Export sends the requested blocks two by two
+func (m *exportSystemClientMock) Export(
+	_ context.Context,
+	req *proto.ExportRequest,
+	_ ...grpc.CallOption,
+) (proto.System_ExportClient, error) {
+	stream := &mockSystemExportClient{}
+
+	for from := req.From; from <= req.To; from += 2 {
+		event := &proto.ExportEvent{
+			From:   from,
+			To:     from,
+			Latest: m.latest,
+			Data:   m.getBlock(from).MarshalRLP(),
+		}
+
+		if from+1 <= req.To {
+			event.To = from + 1
+			event.Data = append(event.Data, m.getBlock(from+1).MarshalRLP()...)
+		}
+
+		stream.recvs = append(stream.recvs, recvData{event: event})
+	}
+
+	return stream, nil
+}
+
+// readBackup returns the blocks and the metadata of the backup file
+func readBackup(t *testing.T, path string) ([]*types.Block, *Metadata) {
+	t.Helper()
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+
+	defer file.Close()
+
+	source, err := newBlockSource(file)
+	assert.NoError(t, err)
+
+	metadata, err := source.getMetadata()
+	assert.NoError(t, err)
+
+	backupBlocks := []*types.Block{}
+
+	for {
+		block, err := source.nextBlock()
+		assert.NoError(t, err)
+
+		if block == nil {
+			return backupBlocks, metadata
+		}
+
+		backupBlocks = append(backupBlocks, block)
+	}
+}
+
+func Test_createBackup(t *testing.T) {
+	t.Parallel()
+
+	toPtr := func(x uint64) *uint64 {
+		return &x
+	}
+
+	t.Run("should write compressed chunks with the manifest", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup")
+
+		from, to, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionZstd,
+			false,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), from)
+		assert.Equal(t, uint64(3), to)
+
+		backupBlocks, metadata := readBackup(t, path)
+		assert.Equal(t, append([]*types.Block{genesis}, blocks...), backupBlocks)
+		assert.Equal(t, uint64(3), metadata.Latest)
+		assert.Equal(t, blocks[2].Hash(), metadata.LatestHash)
+		assert.Equal(t, CompressionZstd, metadata.Compression)
+		assert.Len(t, metadata.Chunks, 2)
+
+		// the file is never overwritten
+		_, _, err = createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionZstd,
+			false,
+		)
+		assert.ErrorIs(t, err, os.ErrExist)
+	})
+
+	t.Run("should resume after the last complete chunk", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup")
+
+		_, to, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			toPtr(1),
+			path,
+			CompressionGzip,
+			false,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), to)
+
+		// append a partially written chunk
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+		assert.NoError(t, err)
+
+		_, err = file.Write([]byte{frameKindChunk, 0, 0, 0})
+		assert.NoError(t, err)
+		assert.NoError(t, file.Close())
+
+		// the compression of the file is kept
+		from, to, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionNone,
+			true,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), from)
+		assert.Equal(t, uint64(3), to)
+
+		backupBlocks, metadata := readBackup(t, path)
+		assert.Equal(t, append([]*types.Block{genesis}, blocks...), backupBlocks)
+		assert.Equal(t, uint64(3), metadata.Latest)
+		assert.Equal(t, blocks[2].Hash(), metadata.LatestHash)
+		assert.Equal(t, CompressionGzip, metadata.Compression)
+		assert.Len(t, metadata.Chunks, 2)
+	})
+
+	t.Run("should resume a single block", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup")
+
+		_, _, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 2},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionGzip,
+			false,
+		)
+		assert.NoError(t, err)
+
+		_, to, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionGzip,
+			true,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), to)
+
+		backupBlocks, metadata := readBackup(t, path)
+		assert.Equal(t, append([]*types.Block{genesis}, blocks...), backupBlocks)
+		assert.Equal(t, []*Chunk{
+			{From: 0, To: 1},
+			{From: 2, To: 2},
+			{From: 3, To: 3},
+		}, chunkRanges(metadata.Chunks))
+	})
+
+	t.Run("should create a new backup if there is none to resume", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup")
+
+		_, to, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionGzip,
+			true,
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), to)
+	})
+
+	t.Run("should not resume a legacy backup", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "backup")
+		assert.NoError(t, os.WriteFile(path, append(metadata.MarshalRLP(), genesis.MarshalRLP()...), 0600))
+
+		_, _, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			0,
+			nil,
+			path,
+			CompressionGzip,
+			true,
+		)
+		assert.ErrorIs(t, err, ErrResumeLegacyBackup)
+	})
+}
+
+// chunkRanges returns the chunks with their ranges only
+func chunkRanges(chunks []*Chunk) []*Chunk {
+	ranges := make([]*Chunk, len(chunks))
+	for i, chunk := range chunks {
+		ranges[i] = &Chunk{From: chunk.From, To: chunk.To}
+	}
+
+	return ranges
+}
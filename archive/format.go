@@ -0,0 +1,384 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+// The backup file starts with a header followed by frames:
+//
+//	header: magic (4 bytes) | version (1 byte) | compression (1 byte)
+//	frame:  kind (1 byte) | from (8 bytes) | to (8 bytes) | size (4 bytes) | checksum (32 bytes) | payload
+//
+// A chunk frame holds the RLP encoded blocks from `from` to `to`, compressed on its own,
+// and its checksum is the SHA-256 of the compressed payload. The metadata frame holds
+// the RLP encoded Metadata with the manifest of the chunks, and is written last once
+// the backup is complete.
+//
+// Legacy backups start with the RLP encoded Metadata instead, followed by the RLP encoded blocks
+const (
+	backupVersion byte = 1
+
+	frameKindChunk    byte = 1
+	frameKindMetadata byte = 2
+
+	frameHeaderSize = 1 + 8 + 8 + 4 + types.HashLength
+)
+
+var backupMagic = []byte("EBAK")
+
+var (
+	ErrUnknownCompression = errors.New("unknown compression")
+	ErrChecksumMismatch   = errors.New("checksum mismatch")
+	ErrIncompleteBackup   = errors.New("the backup is incomplete")
+	ErrManifestMismatch   = errors.New("the chunks do not match the manifest of the backup")
+
+	errLegacyBackup = errors.New("legacy backup")
+)
+
+// Compression is the compression algorithm of the chunks in the backup
+type Compression string
+
+const (
+	CompressionNone Compression = "none"
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+// compressionCodes are the identifiers of the compressions in the backup header
+var compressionCodes = map[Compression]byte{
+	CompressionNone: 0,
+	CompressionGzip: 1,
+	CompressionZstd: 2,
+}
+
+// ParseCompression returns the compression with the given name
+func ParseCompression(name string) (Compression, error) {
+	compression := Compression(name)
+	if _, ok := compressionCodes[compression]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownCompression, name)
+	}
+
+	return compression, nil
+}
+
+func compressionFromCode(code byte) (Compression, error) {
+	for compression, c := range compressionCodes {
+		if c == code {
+			return compression, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %d", ErrUnknownCompression, code)
+}
+
+// compress compresses the data with the algorithm
+func (c Compression) compress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		encoder, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		defer encoder.Close()
+
+		return encoder.EncodeAll(data, nil), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownCompression, c)
+}
+
+// decompress decompresses the data with the algorithm
+func (c Compression) decompress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		defer decoder.Close()
+
+		return decoder.DecodeAll(data, nil)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownCompression, c)
+}
+
+// writeHeader writes the header of the backup file
+func writeHeader(writer io.Writer, compression Compression) error {
+	code, ok := compressionCodes[compression]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCompression, compression)
+	}
+
+	header := make([]byte, 0, len(backupMagic)+2)
+	header = append(header, backupMagic...)
+	header = append(header, backupVersion, code)
+
+	_, err := writer.Write(header)
+
+	return err
+}
+
+// readHeader reads the header of the backup file and returns the compression of the chunks.
+// It returns errLegacyBackup if the file is a legacy backup
+func readHeader(reader io.Reader) (Compression, error) {
+	header := make([]byte, len(backupMagic)+2)
+
+	n, err := io.ReadFull(reader, header)
+	if n < len(backupMagic) || !bytes.Equal(header[:len(backupMagic)], backupMagic) {
+		return "", errLegacyBackup
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("unable to read backup header: %w", err)
+	}
+
+	if version := header[len(backupMagic)]; version != backupVersion {
+		return "", fmt.Errorf("unsupported backup version %d", version)
+	}
+
+	return compressionFromCode(header[len(backupMagic)+1])
+}
+
+// frame is the header of a frame in the backup file
+type frame struct {
+	kind     byte
+	from     uint64
+	to       uint64
+	size     uint32
+	checksum types.Hash
+
+	// offset is the position of the payload in the file
+	offset int64
+}
+
+// chunk returns the manifest entry of the chunk frame
+func (f *frame) chunk() *Chunk {
+	return &Chunk{
+		From:     f.from,
+		To:       f.to,
+		Size:     uint64(f.size),
+		Checksum: f.checksum,
+	}
+}
+
+// writeFrame writes the frame with the payload and returns its manifest entry
+func writeFrame(writer io.Writer, kind byte, from, to uint64, payload []byte) (*Chunk, error) {
+	checksum := types.Hash(sha256.Sum256(payload))
+
+	header := make([]byte, frameHeaderSize)
+	header[0] = kind
+	binary.BigEndian.PutUint64(header[1:9], from)
+	binary.BigEndian.PutUint64(header[9:17], to)
+	binary.BigEndian.PutUint32(header[17:21], uint32(len(payload)))
+	copy(header[21:], checksum[:])
+
+	if _, err := writer.Write(append(header, payload...)); err != nil {
+		return nil, err
+	}
+
+	return &Chunk{
+		From:     from,
+		To:       to,
+		Size:     uint64(len(payload)),
+		Checksum: checksum,
+	}, nil
+}
+
+// readFrameHeader reads the header of the next frame.
+// It returns io.EOF if there is no more frame and io.ErrUnexpectedEOF if the header is truncated
+func readFrameHeader(reader io.Reader) (*frame, error) {
+	header := make([]byte, frameHeaderSize)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+
+	f := &frame{
+		kind: header[0],
+		from: binary.BigEndian.Uint64(header[1:9]),
+		to:   binary.BigEndian.Uint64(header[9:17]),
+		size: binary.BigEndian.Uint32(header[17:21]),
+	}
+
+	copy(f.checksum[:], header[21:])
+
+	return f, nil
+}
+
+// readFramePayload reads the payload of the frame and verifies its checksum
+func readFramePayload(reader io.Reader, f *frame) ([]byte, error) {
+	payload := make([]byte, f.size)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, err
+	}
+
+	if types.Hash(sha256.Sum256(payload)) != f.checksum {
+		return nil, fmt.Errorf("%w in the frame of blocks %d-%d", ErrChecksumMismatch, f.from, f.to)
+	}
+
+	return payload, nil
+}
+
+// backupScan is the content of a backup file found by scanBackup
+type backupScan struct {
+	compression Compression
+	chunks      []*frame
+	metadata    *Metadata
+
+	// end is the position right after the last complete chunk
+	end int64
+	// damaged is true if the file has truncated or corrupted data after end
+	damaged bool
+}
+
+// scanBackup reads the frames of the backup file after its header. The checksums of the chunks
+// are verified only if verify is set, otherwise their payloads are skipped
+func scanBackup(file *os.File, compression Compression, verify bool) (*backupScan, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+
+	scan := &backupScan{
+		compression: compression,
+		end:         offset,
+	}
+
+	for {
+		f, err := readFrameHeader(file)
+		if errors.Is(err, io.EOF) {
+			return scan, nil
+		} else if errors.Is(err, io.ErrUnexpectedEOF) {
+			scan.damaged = true
+
+			return scan, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		f.offset = offset + frameHeaderSize
+		offset = f.offset + int64(f.size)
+
+		if offset > info.Size() {
+			scan.damaged = true
+
+			return scan, nil
+		}
+
+		switch f.kind {
+		case frameKindChunk:
+			if scan.metadata != nil {
+				return nil, errors.New("unexpected chunk after the metadata of the backup")
+			}
+
+			if last := scan.lastChunk(); last != nil && f.from != last.to+1 {
+				return nil, fmt.Errorf("expected the chunk to start at block %d but got %d", last.to+1, f.from)
+			}
+
+			if verify {
+				if _, err := readFramePayload(file, f); errors.Is(err, ErrChecksumMismatch) {
+					scan.damaged = true
+
+					return scan, nil
+				} else if err != nil {
+					return nil, err
+				}
+			} else if _, err := file.Seek(offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+
+			scan.chunks = append(scan.chunks, f)
+			scan.end = offset
+		case frameKindMetadata:
+			payload, err := readFramePayload(file, f)
+			if errors.Is(err, ErrChecksumMismatch) {
+				scan.damaged = true
+
+				return scan, nil
+			} else if err != nil {
+				return nil, err
+			}
+
+			metadata := &Metadata{}
+			if err := metadata.UnmarshalRLP(payload); err != nil {
+				return nil, err
+			}
+
+			scan.metadata = metadata
+		default:
+			return nil, fmt.Errorf("unknown frame kind %d", f.kind)
+		}
+	}
+}
+
+// lastChunk returns the last complete chunk, or nil if there is none
+func (s *backupScan) lastChunk() *frame {
+	if len(s.chunks) == 0 {
+		return nil
+	}
+
+	return s.chunks[len(s.chunks)-1]
+}
+
+// verifyManifest checks the chunks in the file are the ones listed in the metadata
+func (s *backupScan) verifyManifest() error {
+	if len(s.metadata.Chunks) != len(s.chunks) {
+		return fmt.Errorf(
+			"%w: expected %d chunks but found %d",
+			ErrManifestMismatch,
+			len(s.metadata.Chunks),
+			len(s.chunks),
+		)
+	}
+
+	for i, f := range s.chunks {
+		if *f.chunk() != *s.metadata.Chunks[i] {
+			return fmt.Errorf("%w: chunk of blocks %d-%d", ErrManifestMismatch, f.from, f.to)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompression(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat(append(blocks[0].MarshalRLP(), blocks[1].MarshalRLP()...), 10)
+
+	for compression := range compressionCodes {
+		compression := compression
+
+		t.Run(string(compression), func(t *testing.T) {
+			t.Parallel()
+
+			compressed, err := compression.compress(data)
+			assert.NoError(t, err)
+
+			decompressed, err := compression.decompress(compressed)
+			assert.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+		})
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	t.Parallel()
+
+	compression, err := ParseCompression("zstd")
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionZstd, compression)
+
+	_, err = ParseCompression("lz4")
+	assert.ErrorIs(t, err, ErrUnknownCompression)
+}
+
+func Test_readHeader(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	assert.NoError(t, writeHeader(&buf, CompressionZstd))
+
+	compression, err := readHeader(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionZstd, compression)
+
+	// legacy backups start with the RLP encoded metadata
+	_, err = readHeader(bytes.NewReader(metadata.MarshalRLP()))
+	assert.ErrorIs(t, err, errLegacyBackup)
+
+	_, err = readHeader(bytes.NewReader(nil))
+	assert.ErrorIs(t, err, errLegacyBackup)
+}
+
+func Test_MetadataManifest(t *testing.T) {
+	t.Parallel()
+
+	manifest := &Metadata{
+		Latest:      3,
+		LatestHash:  blocks[2].Hash(),
+		Compression: CompressionGzip,
+		Chunks: []*Chunk{
+			{From: 0, To: 1, Size: 100, Checksum: types.StringToHash("1")},
+			{From: 2, To: 3, Size: 200, Checksum: types.StringToHash("2")},
+		},
+	}
+
+	decoded := &Metadata{}
+	assert.NoError(t, decoded.UnmarshalRLP(manifest.MarshalRLP()))
+	assert.Equal(t, manifest, decoded)
+
+	// the legacy metadata is encoded as before
+	legacy := &Metadata{
+		Latest:     metadata.Latest,
+		LatestHash: metadata.LatestHash,
+	}
+
+	decoded = &Metadata{}
+	assert.NoError(t, decoded.UnmarshalRLP(legacy.MarshalRLP()))
+	assert.Equal(t, legacy, decoded)
+}
+
+func Test_scanBackup(t *testing.T) {
+	t.Parallel()
+
+	newBackupFile := func(t *testing.T, trailing []byte) *os.File {
+		t.Helper()
+
+		var buf bytes.Buffer
+
+		assert.NoError(t, writeHeader(&buf, CompressionNone))
+
+		chunks := make([]*Chunk, 0, len(blocks))
+
+		for _, b := range blocks {
+			chunk, err := writeFrame(&buf, frameKindChunk, b.Number(), b.Number(), b.MarshalRLP())
+			assert.NoError(t, err)
+
+			chunks = append(chunks, chunk)
+		}
+
+		if trailing == nil {
+			trailer := &Metadata{
+				Latest:      blocks[2].Number(),
+				LatestHash:  blocks[2].Hash(),
+				Compression: CompressionNone,
+				Chunks:      chunks,
+			}
+
+			_, err := writeFrame(&buf, frameKindMetadata, 0, 0, trailer.MarshalRLP())
+			assert.NoError(t, err)
+		} else {
+			buf.Write(trailing)
+		}
+
+		path := filepath.Join(t.TempDir(), "backup")
+		assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+
+		file, err := os.Open(path)
+		assert.NoError(t, err)
+
+		t.Cleanup(func() {
+			_ = file.Close()
+		})
+
+		_, err = readHeader(file)
+		assert.NoError(t, err)
+
+		return file
+	}
+
+	t.Run("should read the chunks and the manifest", func(t *testing.T) {
+		t.Parallel()
+
+		scan, err := scanBackup(newBackupFile(t, nil), CompressionNone, true)
+		assert.NoError(t, err)
+		assert.False(t, scan.damaged)
+		assert.Len(t, scan.chunks, 3)
+		assert.Equal(t, blocks[2].Number(), scan.metadata.Latest)
+		assert.NoError(t, scan.verifyManifest())
+	})
+
+	t.Run("should stop at a truncated frame", func(t *testing.T) {
+		t.Parallel()
+
+		file := newBackupFile(t, []byte{frameKindChunk, 0, 0})
+
+		scan, err := scanBackup(file, CompressionNone, true)
+		assert.NoError(t, err)
+		assert.True(t, scan.damaged)
+		assert.Len(t, scan.chunks, 3)
+		assert.Nil(t, scan.metadata)
+
+		info, err := file.Stat()
+		assert.NoError(t, err)
+		assert.Equal(t, info.Size()-3, scan.end)
+	})
+
+	t.Run("should stop at a corrupted chunk", func(t *testing.T) {
+		t.Parallel()
+
+		var corrupted bytes.Buffer
+
+		_, err := writeFrame(&corrupted, frameKindChunk, 4, 4, []byte{0xc0})
+		assert.NoError(t, err)
+
+		// flip the payload
+		data := corrupted.Bytes()
+		data[len(data)-1] = 0xc1
+
+		file := newBackupFile(t, data)
+
+		scan, err := scanBackup(file, CompressionNone, true)
+		assert.NoError(t, err)
+		assert.True(t, scan.damaged)
+		assert.Len(t, scan.chunks, 3)
+
+		// the payloads are not verified if not asked
+		_, err = file.Seek(int64(len(backupMagic)+2), io.SeekStart)
+		assert.NoError(t, err)
+
+		scan, err = scanBackup(file, CompressionNone, false)
+		assert.NoError(t, err)
+		assert.False(t, scan.damaged)
+		assert.Len(t, scan.chunks, 4)
+	})
+}
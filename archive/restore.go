@@ -1,6 +1,7 @@
 package archive
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -26,22 +27,89 @@ type blockchainInterface interface {
 	VerifyFinalizedBlock(*types.Block) error
 }
 
-// RestoreChain reads blocks from the archive and write to the chain
-func RestoreChain(chain blockchainInterface, filePath string, progression *progress.ProgressionWrapper) error {
+var errRestoreInterrupted = errors.New("restore interrupted")
+
+// blockSource is a source of the blocks of a backup
+type blockSource interface {
+	getMetadata() (*Metadata, error)
+	nextBlock() (*types.Block, error)
+}
+
+// RestoreChain reads blocks from the archives in order and write to the chain
+func RestoreChain(chain blockchainInterface, filePaths []string, progression *progress.ProgressionWrapper) error {
+	shutdownCh := common.GetTerminationSignalCh()
+
+	for _, filePath := range filePaths {
+		err := restoreFile(chain, filePath, progression, shutdownCh)
+		if errors.Is(err, errRestoreInterrupted) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to restore %s: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreFile reads blocks from the archive and write to the chain
+func restoreFile(
+	chain blockchainInterface,
+	filePath string,
+	progression *progress.ProgressionWrapper,
+	shutdownCh <-chan os.Signal,
+) error {
 	fp, err := os.Open(filePath)
 	if err != nil {
 		return err
 	}
 
-	blockStream := newBlockStream(fp)
+	defer fp.Close()
+
+	source, err := newBlockSource(fp)
+	if err != nil {
+		return err
+	}
 
-	return importBlocks(chain, blockStream, progression)
+	return importBlocks(chain, source, progression, shutdownCh)
 }
 
-// import blocks scans all blocks from stream and write them to chain
-func importBlocks(chain blockchainInterface, blockStream *blockStream, progression *progress.ProgressionWrapper) error {
-	shutdownCh := common.GetTerminationSignalCh()
+// newBlockSource returns the source of the blocks in the archive,
+// after checking the chunks match the manifest of the framed archives
+func newBlockSource(fp *os.File) (blockSource, error) {
+	compression, err := readHeader(fp)
+	if errors.Is(err, errLegacyBackup) {
+		if _, err := fp.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		return newBlockStream(fp), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	scan, err := scanBackup(fp, compression, false)
+	if err != nil {
+		return nil, err
+	}
 
+	if scan.damaged || scan.metadata == nil {
+		return nil, ErrIncompleteBackup
+	}
+
+	if err := scan.verifyManifest(); err != nil {
+		return nil, err
+	}
+
+	return newChunkStream(fp, scan), nil
+}
+
+// import blocks scans all blocks from stream and write them to chain
+func importBlocks(
+	chain blockchainInterface,
+	blockStream blockSource,
+	progression *progress.ProgressionWrapper,
+	shutdownCh <-chan os.Signal,
+) error {
 	metadata, err := blockStream.getMetadata()
 	if err != nil {
 		return err
@@ -99,7 +167,7 @@ func importBlocks(chain blockchainInterface, blockStream *blockStream, progressi
 
 		select {
 		case <-shutdownCh:
-			return nil
+			return errRestoreInterrupted
 		default:
 		}
 	}
@@ -111,7 +179,7 @@ func importBlocks(chain blockchainInterface, blockStream *blockStream, progressi
 // returns the first block to be written into chain
 func consumeCommonBlocks(
 	chain blockchainInterface,
-	blockStream *blockStream,
+	blockStream blockSource,
 	shutdownCh <-chan os.Signal,
 ) (*types.Block, error) {
 	for {
@@ -142,12 +210,81 @@ func consumeCommonBlocks(
 
 		select {
 		case <-shutdownCh:
-			return nil, nil
+			return nil, errRestoreInterrupted
 		default:
 		}
 	}
 }
 
+// chunkStream reads the blocks from the chunks of a framed archive,
+// verifying the checksum of each chunk before decompressing it
+type chunkStream struct {
+	input       io.ReadSeeker
+	compression Compression
+	metadata    *Metadata
+	chunks      []*frame
+
+	// current is the stream of the blocks in the chunk being read
+	current *blockStream
+}
+
+func newChunkStream(input io.ReadSeeker, scan *backupScan) *chunkStream {
+	return &chunkStream{
+		input:       input,
+		compression: scan.compression,
+		metadata:    scan.metadata,
+		chunks:      scan.chunks,
+	}
+}
+
+// getMetadata returns the Metadata at the end of the archive
+func (c *chunkStream) getMetadata() (*Metadata, error) {
+	return c.metadata, nil
+}
+
+// nextBlock returns the next block in the chunks, loading the next chunk if needed
+func (c *chunkStream) nextBlock() (*types.Block, error) {
+	for {
+		if c.current != nil {
+			block, err := c.current.nextBlock()
+			if err != nil || block != nil {
+				return block, err
+			}
+		}
+
+		if len(c.chunks) == 0 {
+			return nil, nil
+		}
+
+		if err := c.loadChunk(c.chunks[0]); err != nil {
+			return nil, err
+		}
+
+		c.chunks = c.chunks[1:]
+	}
+}
+
+// loadChunk reads the chunk, verifies its checksum and decompresses it
+func (c *chunkStream) loadChunk(f *frame) error {
+	if _, err := c.input.Seek(f.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	payload, err := readFramePayload(c.input, f)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.compression.decompress(payload)
+	if err != nil {
+		return fmt.Errorf("unable to decompress the chunk of blocks %d-%d: %w", f.from, f.to, err)
+	}
+
+	c.current = newBlockStream(bytes.NewReader(data))
+
+	return nil
+}
+
 // blockStream parse RLP-encoded block from stream and consumed the used bytes
 type blockStream struct {
 	input  io.Reader
@@ -2,15 +2,19 @@ package archive
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/blockchain"
 	"github.com/LaChain/polygon-edge/helper/progress"
 	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -115,7 +119,7 @@ func Test_importBlocks(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			progression := progress.NewProgressionWrapper(progress.ChainSyncRestore)
 			blockStream := newTestBlockStream(tt.metadata, tt.archiveBlocks...)
-			err := importBlocks(tt.chain, blockStream, progression)
+			err := importBlocks(tt.chain, blockStream, progression, make(<-chan os.Signal))
 
 			assert.Equal(t, tt.err, err)
 			latestBlock := getLatestBlockFromMockChain(tt.chain)
@@ -463,3 +467,111 @@ func Test_reserveCap(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreChain(t *testing.T) {
+	t.Parallel()
+
+	// writeBackup writes a backup of the test chain from 0 to the given height
+	writeBackup := func(t *testing.T, from uint64, to uint64) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), fmt.Sprintf("backup-%d-%d", from, to))
+
+		_, _, err := createBackup(
+			context.Background(),
+			&exportSystemClientMock{latest: 3},
+			hclog.NewNullLogger(),
+			from,
+			&to,
+			path,
+			CompressionGzip,
+			false,
+		)
+		assert.NoError(t, err)
+
+		return path
+	}
+
+	t.Run("should restore the files in order", func(t *testing.T) {
+		t.Parallel()
+
+		chain := &mockChain{
+			genesis: genesis,
+			blocks:  []*types.Block{},
+		}
+
+		err := RestoreChain(
+			chain,
+			[]string{writeBackup(t, 0, 1), writeBackup(t, 2, 3)},
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, blocks, chain.blocks)
+	})
+
+	t.Run("should fail on a corrupted chunk", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeBackup(t, 0, 3)
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+
+		// flip the last byte of the payload of the first chunk
+		firstChunkEnd := len(backupMagic) + 2 + frameHeaderSize + int(binary.BigEndian.Uint32(
+			data[len(backupMagic)+2+17:],
+		))
+		data[firstChunkEnd-1] ^= 0xff
+
+		assert.NoError(t, os.WriteFile(path, data, 0600))
+
+		err = RestoreChain(
+			&mockChain{genesis: genesis, blocks: []*types.Block{}},
+			[]string{path},
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		)
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("should fail on an incomplete backup", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeBackup(t, 0, 3)
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+
+		assert.NoError(t, os.WriteFile(path, data[:len(data)-1], 0600))
+
+		err = RestoreChain(
+			&mockChain{genesis: genesis, blocks: []*types.Block{}},
+			[]string{path},
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		)
+		assert.ErrorIs(t, err, ErrIncompleteBackup)
+	})
+
+	t.Run("should restore a legacy backup", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		buf.Write((&Metadata{Latest: blocks[2].Number(), LatestHash: blocks[2].Hash()}).MarshalRLP())
+
+		for _, b := range append([]*types.Block{genesis}, blocks...) {
+			buf.Write(b.MarshalRLP())
+		}
+
+		path := filepath.Join(t.TempDir(), "backup")
+		assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+
+		chain := &mockChain{
+			genesis: genesis,
+			blocks:  []*types.Block{},
+		}
+
+		err := RestoreChain(chain, []string{path}, progress.NewProgressionWrapper(progress.ChainSyncRestore))
+		assert.NoError(t, err)
+		assert.Equal(t, blocks, chain.blocks)
+	})
+}
@@ -0,0 +1,588 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/crypto"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/fastrlp"
+)
+
+// The state snapshot file starts with a header followed by records:
+//
+//	header: magic (4 bytes) | version (1 byte)
+//	record: kind (1 byte) | size (uvarint) | payload
+//
+// The records are, in order, the SnapshotMetadata, the headers of the ancestors of the anchor block,
+// the anchor block, its receipts, the trie nodes and the contract code of the states, and the end
+// record with the number of nodes and codes
+const (
+	snapshotVersion byte = 1
+
+	snapshotRecordMetadata byte = 1
+	snapshotRecordHeader   byte = 2
+	snapshotRecordAnchor   byte = 3
+	snapshotRecordReceipts byte = 4
+	snapshotRecordNode     byte = 5
+	snapshotRecordCode     byte = 6
+	snapshotRecordEnd      byte = 7
+
+	// maxSnapshotRecordSize is the maximum size of a record, bigger than any block or code
+	maxSnapshotRecordSize = 128 * 1024 * 1024
+
+	// snapshotBatchSize is the number of trie nodes written at once on import
+	snapshotBatchSize = 1024
+)
+
+var snapshotMagic = []byte("ESNP")
+
+var (
+	ErrInvalidSnapshot   = errors.New("invalid state snapshot")
+	ErrUntrustedSnapshot = errors.New("the anchor block of the state snapshot is not the trusted one")
+)
+
+// SnapshotMetadata describes the anchor block of the state snapshot
+type SnapshotMetadata struct {
+	Number uint64
+	Hash   types.Hash
+	// StateRoots are the roots of the states in the snapshot, the state of the anchor block first
+	StateRoots      []types.Hash
+	TotalDifficulty *big.Int
+}
+
+// MarshalRLPWith appends own field into arena for encode
+func (m *SnapshotMetadata) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	vv := arena.NewArray()
+
+	vv.Set(arena.NewUint(m.Number))
+	vv.Set(arena.NewBytes(m.Hash.Bytes()))
+
+	roots := arena.NewArray()
+	for _, root := range m.StateRoots {
+		roots.Set(arena.NewBytes(root.Bytes()))
+	}
+
+	vv.Set(roots)
+	vv.Set(arena.NewBigInt(m.TotalDifficulty))
+
+	return vv
+}
+
+// UnmarshalRLPFrom sets the fields from parsed RLP encoded value
+func (m *SnapshotMetadata) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if len(elems) < 4 {
+		return fmt.Errorf("incorrect number of elements to decode SnapshotMetadata, expected 4 but found %d", len(elems))
+	}
+
+	if m.Number, err = elems[0].GetUint64(); err != nil {
+		return err
+	}
+
+	if err = elems[1].GetHash(m.Hash[:]); err != nil {
+		return err
+	}
+
+	rootElems, err := elems[2].GetElems()
+	if err != nil {
+		return err
+	}
+
+	m.StateRoots = make([]types.Hash, len(rootElems))
+	for i, rootElem := range rootElems {
+		if err := rootElem.GetHash(m.StateRoots[i][:]); err != nil {
+			return err
+		}
+	}
+
+	m.TotalDifficulty = new(big.Int)
+
+	return elems[3].GetBigInt(m.TotalDifficulty)
+}
+
+// ExportStateSnapshot writes the state at the given block from the storages to the file,
+// with the block and the headers of its ancestors. The state at the end of the previous epoch
+// is exported as well if available, for the validator stores fetching the validators from the state
+func ExportStateSnapshot(
+	db storage.Storage,
+	stateStorage itrie.Storage,
+	logger hclog.Logger,
+	number uint64,
+	epochSize uint64,
+	outPath string,
+) (*SnapshotMetadata, uint64, uint64, error) {
+	anchor, receipts, td, err := readSnapshotAnchor(db, number)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	ancestors := make([]*types.Header, 0)
+
+	for n := blockchain.AnchorAncestorsFrom(number, epochSize); n < number; n++ {
+		header, err := readCanonicalHeader(db, n)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		ancestors = append(ancestors, header)
+	}
+
+	st := itrie.NewState(stateStorage)
+
+	metadata := &SnapshotMetadata{
+		Number:          number,
+		Hash:            anchor.Hash(),
+		StateRoots:      []types.Hash{anchor.Header.StateRoot},
+		TotalDifficulty: td,
+	}
+
+	if epochSize != 0 && number >= epochSize {
+		epochEnd := (number/epochSize)*epochSize - 1
+		root := ancestors[epochEnd-ancestors[0].Number].StateRoot
+
+		if root != anchor.Header.StateRoot {
+			if err := st.VerifyState(root); err != nil {
+				logger.Warn(
+					"The state at the end of the previous epoch is not available, "+
+						"the validators stored in the state can't be fetched from the snapshot",
+					"number", epochEnd,
+					"err", err,
+				)
+			} else {
+				metadata.StateRoots = append(metadata.StateRoots, root)
+			}
+		}
+	}
+
+	// always create new file, throw error if the file exists
+	fs, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	writer := &snapshotWriter{writer: bufio.NewWriter(fs)}
+
+	err = writer.write(st, metadata, ancestors, anchor, receipts, logger)
+	if err == nil {
+		err = writer.writer.Flush()
+	}
+
+	if closeErr := fs.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		if removeErr := os.Remove(outPath); removeErr != nil {
+			logger.Error("an error occurred while removing file", "err", removeErr)
+		}
+
+		return nil, 0, 0, err
+	}
+
+	return metadata, writer.nodes, writer.codes, nil
+}
+
+// readSnapshotAnchor reads the block at the given height with its receipts and total difficulty
+func readSnapshotAnchor(db storage.Storage, number uint64) (*types.Block, []*types.Receipt, *big.Int, error) {
+	header, err := readCanonicalHeader(db, number)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	body, err := db.ReadBody(header.Hash)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to read body of block %d: %w", number, err)
+	}
+
+	receipts, err := db.ReadReceipts(header.Hash)
+	if err != nil && len(body.Transactions) != 0 {
+		return nil, nil, nil, fmt.Errorf("unable to read receipts of block %d: %w", number, err)
+	}
+
+	td, ok := db.ReadTotalDifficulty(header.Hash)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("total difficulty of block %d not found", number)
+	}
+
+	return &types.Block{
+		Header:       header,
+		Transactions: body.Transactions,
+		Uncles:       body.Uncles,
+	}, receipts, td, nil
+}
+
+// readCanonicalHeader reads the header of the canonical block at the given height
+func readCanonicalHeader(db storage.Storage, number uint64) (*types.Header, error) {
+	hash, ok := db.ReadCanonicalHash(number)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of block %d not found", number)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header of block %d: %w", number, err)
+	}
+
+	// the hash computed on decoding may differ from the consensus one
+	header.Hash = hash
+
+	return header, nil
+}
+
+// snapshotWriter writes the records of a state snapshot
+type snapshotWriter struct {
+	writer *bufio.Writer
+	nodes  uint64
+	codes  uint64
+}
+
+func (w *snapshotWriter) write(
+	st *itrie.State,
+	metadata *SnapshotMetadata,
+	ancestors []*types.Header,
+	anchor *types.Block,
+	receipts []*types.Receipt,
+	logger hclog.Logger,
+) error {
+	header := append([]byte{}, snapshotMagic...)
+	if _, err := w.writer.Write(append(header, snapshotVersion)); err != nil {
+		return err
+	}
+
+	if err := w.writeRecord(snapshotRecordMetadata, types.MarshalRLPTo(metadata.MarshalRLPWith, nil)); err != nil {
+		return err
+	}
+
+	for _, ancestor := range ancestors {
+		if err := w.writeRecord(snapshotRecordHeader, ancestor.MarshalRLP()); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writeRecord(snapshotRecordAnchor, anchor.MarshalRLP()); err != nil {
+		return err
+	}
+
+	if err := w.writeRecord(snapshotRecordReceipts, types.Receipts(receipts).MarshalStoreRLPTo(nil)); err != nil {
+		return err
+	}
+
+	for _, root := range metadata.StateRoots {
+		logger.Info("Exporting state", "root", root)
+
+		if err := st.ExportState(
+			root,
+			func(_ types.Hash, data []byte) error {
+				w.nodes++
+				if w.nodes%100000 == 0 {
+					logger.Info("Exported trie nodes", "nodes", w.nodes, "codes", w.codes)
+				}
+
+				return w.writeRecord(snapshotRecordNode, data)
+			},
+			func(_ types.Hash, code []byte) error {
+				w.codes++
+
+				return w.writeRecord(snapshotRecordCode, code)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	end := types.MarshalRLPTo(func(arena *fastrlp.Arena) *fastrlp.Value {
+		vv := arena.NewArray()
+		vv.Set(arena.NewUint(w.nodes))
+		vv.Set(arena.NewUint(w.codes))
+
+		return vv
+	}, nil)
+
+	return w.writeRecord(snapshotRecordEnd, end)
+}
+
+func (w *snapshotWriter) writeRecord(kind byte, payload []byte) error {
+	prefix := make([]byte, 1+binary.MaxVarintLen64)
+	prefix[0] = kind
+	n := binary.PutUvarint(prefix[1:], uint64(len(payload)))
+
+	if _, err := w.writer.Write(prefix[:1+n]); err != nil {
+		return err
+	}
+
+	_, err := w.writer.Write(payload)
+
+	return err
+}
+
+// anchorWriter is the chain the anchor block of the snapshot is written to
+type anchorWriter interface {
+	Header() *types.Header
+	WriteAnchor(ancestors []*types.Header, anchor *types.Block, receipts []*types.Receipt, td *big.Int) error
+}
+
+// ImportStateSnapshot writes the trie nodes and the contract code of the snapshot to the state storage,
+// verifying them by their hashes, and writes the anchor block as the head of the chain.
+// The snapshot is rejected before anything is written unless its anchor block is the trusted one,
+// since the file itself can't prove the anchor is canonical.
+// Nothing is imported if the chain has blocks after the genesis already
+func ImportStateSnapshot(
+	chain anchorWriter,
+	stateStorage itrie.Storage,
+	logger hclog.Logger,
+	filePath string,
+	trustedHash types.Hash,
+) (*SnapshotMetadata, error) {
+	if head := chain.Header(); head.Number != 0 {
+		logger.Info("The chain is not empty, skipping the state snapshot import", "head", head.Number)
+
+		return nil, nil
+	}
+
+	fp, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fp.Close()
+
+	reader := &snapshotReader{
+		reader:      bufio.NewReader(fp),
+		storage:     stateStorage,
+		logger:      logger,
+		trustedHash: trustedHash,
+	}
+
+	if err := reader.read(); err != nil {
+		if errors.Is(err, ErrUntrustedSnapshot) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+
+	st := itrie.NewState(stateStorage)
+
+	for _, root := range reader.metadata.StateRoots {
+		if err := st.VerifyState(root); err != nil {
+			return nil, fmt.Errorf("%w: incomplete state %s: %v", ErrInvalidSnapshot, root, err)
+		}
+	}
+
+	if err := chain.WriteAnchor(
+		reader.ancestors,
+		reader.anchor,
+		reader.receipts,
+		reader.metadata.TotalDifficulty,
+	); err != nil {
+		return nil, err
+	}
+
+	return reader.metadata, nil
+}
+
+// snapshotReader reads the records of a state snapshot
+type snapshotReader struct {
+	reader  *bufio.Reader
+	storage itrie.Storage
+	logger  hclog.Logger
+
+	// trustedHash is the hash the anchor block must have
+	trustedHash types.Hash
+
+	metadata  *SnapshotMetadata
+	ancestors []*types.Header
+	anchor    *types.Block
+	receipts  []*types.Receipt
+
+	nodes uint64
+	codes uint64
+}
+
+func (r *snapshotReader) read() error {
+	header := make([]byte, len(snapshotMagic)+1)
+	if _, err := io.ReadFull(r.reader, header); err != nil {
+		return err
+	}
+
+	if string(header[:len(snapshotMagic)]) != string(snapshotMagic) {
+		return errors.New("not a state snapshot")
+	}
+
+	if version := header[len(snapshotMagic)]; version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", version)
+	}
+
+	batch := r.storage.Batch()
+	pending := 0
+
+	for {
+		kind, payload, err := r.readRecord()
+		if err != nil {
+			return err
+		}
+
+		if kind != snapshotRecordMetadata && r.metadata == nil {
+			return errors.New("expected metadata first")
+		}
+
+		switch kind {
+		case snapshotRecordMetadata:
+			r.metadata = &SnapshotMetadata{}
+			if err := types.UnmarshalRlp(r.metadata.UnmarshalRLPFrom, payload); err != nil {
+				return err
+			}
+
+			if len(r.metadata.StateRoots) == 0 {
+				return errors.New("no state root")
+			}
+
+			// checked before the first node is written, the anchor itself is checked against the metadata
+			if r.metadata.Hash != r.trustedHash {
+				return fmt.Errorf("%w: %s, expected %s", ErrUntrustedSnapshot, r.metadata.Hash, r.trustedHash)
+			}
+
+		case snapshotRecordHeader:
+			header := &types.Header{}
+			if err := header.UnmarshalRLP(payload); err != nil {
+				return err
+			}
+
+			r.ancestors = append(r.ancestors, header)
+
+		case snapshotRecordAnchor:
+			if err := r.readAnchor(payload); err != nil {
+				return err
+			}
+
+		case snapshotRecordReceipts:
+			receipts := types.Receipts{}
+			if err := receipts.UnmarshalStoreRLP(payload); err != nil {
+				return err
+			}
+
+			r.receipts = receipts
+
+		case snapshotRecordNode:
+			batch.Put(crypto.Keccak256(payload), payload)
+			r.nodes++
+
+			if pending++; pending == snapshotBatchSize {
+				batch.Write()
+				batch, pending = r.storage.Batch(), 0
+			}
+
+			if r.nodes%100000 == 0 {
+				r.logger.Info("Imported trie nodes", "nodes", r.nodes, "codes", r.codes)
+			}
+
+		case snapshotRecordCode:
+			r.storage.SetCode(types.BytesToHash(crypto.Keccak256(payload)), payload)
+			r.codes++
+
+		case snapshotRecordEnd:
+			batch.Write()
+
+			return r.readEnd(payload)
+
+		default:
+			return fmt.Errorf("unknown record kind %d", kind)
+		}
+	}
+}
+
+// readAnchor reads the anchor block and checks it is the one of the metadata
+func (r *snapshotReader) readAnchor(payload []byte) error {
+	anchor := &types.Block{}
+	if err := anchor.UnmarshalRLP(payload); err != nil {
+		return err
+	}
+
+	if anchor.Number() != r.metadata.Number || anchor.Hash() != r.metadata.Hash {
+		return fmt.Errorf("the anchor block %d (%s) is not the expected one", anchor.Number(), anchor.Hash())
+	}
+
+	if anchor.Header.StateRoot != r.metadata.StateRoots[0] {
+		return errors.New("the state root of the anchor block is not the expected one")
+	}
+
+	r.anchor = anchor
+
+	return nil
+}
+
+// readEnd checks the number of the nodes and codes read
+func (r *snapshotReader) readEnd(payload []byte) error {
+	if r.anchor == nil {
+		return errors.New("anchor block not found")
+	}
+
+	var nodes, codes uint64
+
+	if err := types.UnmarshalRlp(func(_ *fastrlp.Parser, v *fastrlp.Value) error {
+		elems, err := v.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(elems) < 2 {
+			return fmt.Errorf("incorrect number of elements to decode the end record, expected 2 but found %d", len(elems))
+		}
+
+		if nodes, err = elems[0].GetUint64(); err != nil {
+			return err
+		}
+
+		codes, err = elems[1].GetUint64()
+
+		return err
+	}, payload); err != nil {
+		return err
+	}
+
+	if nodes != r.nodes || codes != r.codes {
+		return fmt.Errorf("expected %d nodes and %d codes but read %d and %d", nodes, codes, r.nodes, r.codes)
+	}
+
+	return nil
+}
+
+// readRecord reads the next record
+func (r *snapshotReader) readRecord() (byte, []byte, error) {
+	kind, err := r.reader.ReadByte()
+	if errors.Is(err, io.EOF) {
+		return 0, nil, errors.New("unexpected end of the snapshot")
+	} else if err != nil {
+		return 0, nil, err
+	}
+
+	size, err := binary.ReadUvarint(r.reader)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if size > maxSnapshotRecordSize {
+		return 0, nil, fmt.Errorf("record too large: %d bytes", size)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r.reader, payload); err != nil {
+		return 0, nil, err
+	}
+
+	return kind, payload, nil
+}
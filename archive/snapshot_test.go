@@ -0,0 +1,166 @@
+package archive
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/blockchain/storage/memory"
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockAnchorWriter struct {
+	head      *types.Header
+	ancestors []*types.Header
+	anchor    *types.Block
+	receipts  []*types.Receipt
+	td        *big.Int
+}
+
+func (m *mockAnchorWriter) Header() *types.Header {
+	return m.head
+}
+
+func (m *mockAnchorWriter) WriteAnchor(
+	ancestors []*types.Header,
+	anchor *types.Block,
+	receipts []*types.Receipt,
+	td *big.Int,
+) error {
+	m.ancestors, m.anchor, m.receipts, m.td = ancestors, anchor, receipts, td
+	m.head = anchor.Header
+
+	return nil
+}
+
+// newSnapshotTestChain writes a chain of the given length whose blocks have the state
+// with a contract account, and returns the storages and the headers
+func newSnapshotTestChain(t *testing.T, length int) (storage.Storage, itrie.Storage, []*types.Header) {
+	t.Helper()
+
+	stateStorage := itrie.NewMemoryStorage()
+	code := []byte{0x1, 0x2}
+
+	_, rootBytes := itrie.NewState(stateStorage).NewSnapshot().Commit([]*state.Object{
+		{
+			Address:   types.StringToAddress("1"),
+			Balance:   big.NewInt(1),
+			Root:      types.EmptyRootHash,
+			CodeHash:  types.BytesToHash(crypto.Keccak256(code)),
+			Code:      code,
+			DirtyCode: true,
+			Storage: []*state.StorageObject{
+				{
+					Key: types.StringToHash("1").Bytes(),
+					Val: types.StringToHash("1").Bytes(),
+				},
+			},
+		},
+	})
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	assert.NoError(t, err)
+
+	headers := blockchain.NewTestHeaders(length)
+	td := big.NewInt(0)
+
+	for i, header := range headers {
+		header.StateRoot = types.BytesToHash(rootBytes)
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash
+		}
+
+		header.ComputeHash()
+
+		td = new(big.Int).Add(td, new(big.Int).SetUint64(header.Difficulty))
+
+		assert.NoError(t, db.WriteCanonicalHeader(header, td))
+		assert.NoError(t, db.WriteBody(header.Hash, &types.Body{}))
+		assert.NoError(t, db.WriteReceipts(header.Hash, []*types.Receipt{}))
+	}
+
+	return db, stateStorage, headers
+}
+
+func TestStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, stateStorage, headers := newSnapshotTestChain(t, 12)
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+
+	metadata, nodes, codes, err := ExportStateSnapshot(db, stateStorage, hclog.NewNullLogger(), 10, 4, path)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), metadata.Number)
+	assert.Equal(t, headers[10].Hash, metadata.Hash)
+	assert.Equal(t, []types.Hash{headers[10].StateRoot}, metadata.StateRoots)
+	assert.Equal(t, big.NewInt(55), metadata.TotalDifficulty)
+	assert.NotZero(t, nodes)
+	assert.Equal(t, uint64(1), codes)
+
+	// the file is never overwritten
+	_, _, _, err = ExportStateSnapshot(db, stateStorage, hclog.NewNullLogger(), 10, 4, path)
+	assert.ErrorIs(t, err, os.ErrExist)
+
+	t.Run("should import the state and the anchor", func(t *testing.T) {
+		t.Parallel()
+
+		importedStorage := itrie.NewMemoryStorage()
+		chain := &mockAnchorWriter{head: headers[0]}
+
+		imported, err := ImportStateSnapshot(chain, importedStorage, hclog.NewNullLogger(), path, headers[10].Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, metadata, imported)
+
+		assert.NoError(t, itrie.NewState(importedStorage).VerifyState(headers[10].StateRoot))
+
+		// the ancestors cover the BLOCKHASH window back to the genesis
+		assert.Len(t, chain.ancestors, 10)
+		assert.Equal(t, headers[0].Hash, chain.ancestors[0].Hash)
+		assert.Equal(t, headers[10].Hash, chain.anchor.Hash())
+		assert.Equal(t, big.NewInt(55), chain.td)
+
+		// nothing is imported in a chain with blocks
+		imported, err = ImportStateSnapshot(chain, itrie.NewMemoryStorage(), hclog.NewNullLogger(), path, headers[10].Hash)
+		assert.NoError(t, err)
+		assert.Nil(t, imported)
+	})
+
+	t.Run("should not import an untrusted anchor", func(t *testing.T) {
+		t.Parallel()
+
+		importedStorage := itrie.NewMemoryStorage()
+		chain := &mockAnchorWriter{head: headers[0]}
+
+		_, err := ImportStateSnapshot(chain, importedStorage, hclog.NewNullLogger(), path, headers[11].Hash)
+		assert.ErrorIs(t, err, ErrUntrustedSnapshot)
+		assert.Nil(t, chain.anchor)
+
+		// no node is written before the anchor is checked
+		assert.Error(t, itrie.NewState(importedStorage).VerifyState(headers[10].StateRoot))
+	})
+
+	t.Run("should fail on a truncated snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+
+		truncated := filepath.Join(t.TempDir(), "snapshot")
+		assert.NoError(t, os.WriteFile(truncated, data[:len(data)-4], 0600))
+
+		chain := &mockAnchorWriter{head: headers[0]}
+
+		_, err = ImportStateSnapshot(chain, itrie.NewMemoryStorage(), hclog.NewNullLogger(), truncated, headers[10].Hash)
+		assert.ErrorIs(t, err, ErrInvalidSnapshot)
+		assert.Nil(t, chain.anchor)
+	})
+}
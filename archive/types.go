@@ -7,10 +7,25 @@ import (
 	"github.com/umbracle/fastrlp"
 )
 
-// Metadata is the data stored in the beginning of backup
+// Metadata is the data stored in the beginning of legacy backups,
+// and at the end of the framed backups with the manifest of the chunks
 type Metadata struct {
 	Latest     uint64
 	LatestHash types.Hash
+
+	// Compression and Chunks are only set in the framed backups
+	Compression Compression
+	Chunks      []*Chunk
+}
+
+// Chunk is the manifest entry of a chunk of blocks in the backup
+type Chunk struct {
+	From uint64
+	To   uint64
+	// Size is the size of the compressed chunk
+	Size uint64
+	// Checksum is the SHA-256 checksum of the compressed chunk
+	Checksum types.Hash
 }
 
 // MarshalRLP returns RLP encoded bytes
@@ -30,6 +45,34 @@ func (m *Metadata) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewUint(m.Latest))
 	vv.Set(arena.NewBytes(m.LatestHash.Bytes()))
 
+	// the legacy metadata is kept as is
+	if m.Compression == "" && len(m.Chunks) == 0 {
+		return vv
+	}
+
+	vv.Set(arena.NewString(string(m.Compression)))
+
+	if len(m.Chunks) == 0 {
+		vv.Set(arena.NewNullArray())
+
+		return vv
+	}
+
+	chunks := arena.NewArray()
+
+	for _, chunk := range m.Chunks {
+		cv := arena.NewArray()
+
+		cv.Set(arena.NewUint(chunk.From))
+		cv.Set(arena.NewUint(chunk.To))
+		cv.Set(arena.NewUint(chunk.Size))
+		cv.Set(arena.NewBytes(chunk.Checksum.Bytes()))
+
+		chunks.Set(cv)
+	}
+
+	vv.Set(chunks)
+
 	return vv
 }
 
@@ -57,5 +100,62 @@ func (m *Metadata) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 		return err
 	}
 
+	// legacy metadata
+	if len(elems) == 2 {
+		return nil
+	}
+
+	if len(elems) < 4 {
+		return fmt.Errorf("incorrect number of elements to decode Metadata, expected 4 but found %d", len(elems))
+	}
+
+	compression, err := elems[2].GetString()
+	if err != nil {
+		return err
+	}
+
+	m.Compression = Compression(compression)
+
+	chunkElems, err := elems[3].GetElems()
+	if err != nil {
+		return err
+	}
+
+	m.Chunks = make([]*Chunk, len(chunkElems))
+
+	for i, chunkElem := range chunkElems {
+		chunk := &Chunk{}
+		if err := chunk.unmarshalRLPFrom(chunkElem); err != nil {
+			return err
+		}
+
+		m.Chunks[i] = chunk
+	}
+
 	return nil
 }
+
+func (c *Chunk) unmarshalRLPFrom(v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if len(elems) < 4 {
+		return fmt.Errorf("incorrect number of elements to decode Chunk, expected 4 but found %d", len(elems))
+	}
+
+	if c.From, err = elems[0].GetUint64(); err != nil {
+		return err
+	}
+
+	if c.To, err = elems[1].GetUint64(); err != nil {
+		return err
+	}
+
+	if c.Size, err = elems[2].GetUint64(); err != nil {
+		return err
+	}
+
+	return elems[3].GetHash(c.Checksum[:])
+}
@@ -4,13 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"path/filepath"
 	"sync"
 	"sync/atomic"
 
 	"github.com/LaChain/polygon-edge/blockchain/storage"
-	"github.com/LaChain/polygon-edge/blockchain/storage/leveldb"
-	"github.com/LaChain/polygon-edge/blockchain/storage/memory"
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/helper/common"
 	"github.com/LaChain/polygon-edge/state"
@@ -24,6 +21,7 @@ import (
 const (
 	BlockGasTargetDivisor uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
 	defaultCacheSize      int    = 100  // The default size for Blockchain LRU cache structures
+	blockHashWindow       uint64 = 256  // The number of ancestors whose hash can be read by the BLOCKHASH opcode
 )
 
 var (
@@ -38,6 +36,9 @@ var (
 	ErrInvalidStateRoot     = errors.New("invalid block state root")
 	ErrInvalidGasUsed       = errors.New("invalid block gas used")
 	ErrInvalidReceiptsRoot  = errors.New("invalid block receipts root")
+	ErrInvalidBaseFee       = errors.New("invalid block base fee")
+	ErrChainNotEmpty        = errors.New("the chain already has blocks after the genesis")
+	ErrAnchorNotVerifiable  = errors.New("the consensus can't verify an anchor block")
 )
 
 // Blockchain is a blockchain reference
@@ -91,6 +92,18 @@ type Verifier interface {
 	PreCommitState(header *types.Header, txn *state.Transition) error
 }
 
+// AnchorVerifier is implemented by the consensus able to verify a block without its ancestors in the chain
+type AnchorVerifier interface {
+	// VerifyAnchor verifies the finalized header, fetching the headers it depends on with getHeader
+	VerifyAnchor(anchor *types.Header, getHeader func(uint64) (*types.Header, error)) error
+}
+
+// GasLimitProvider is implemented by the consensus fixing the gas limit of the blocks at some heights
+type GasLimitProvider interface {
+	// GetBlockGasLimit returns the gas limit of the block, or false if it's not fixed
+	GetBlockGasLimit(number uint64) (uint64, bool)
+}
+
 type Executor interface {
 	ProcessBlock(parentRoot types.Hash, block *types.Block, blockCreator types.Address) (*state.Transition, error)
 }
@@ -187,7 +200,7 @@ func (b *Blockchain) GetAvgGasPrice() *big.Int {
 // NewBlockchain creates a new blockchain object
 func NewBlockchain(
 	logger hclog.Logger,
-	dataDir string,
+	db storage.Storage,
 	config *chain.Chain,
 	consensus Verifier,
 	executor Executor,
@@ -195,6 +208,7 @@ func NewBlockchain(
 ) (*Blockchain, error) {
 	b := &Blockchain{
 		logger:    logger.Named("blockchain"),
+		db:        db,
 		config:    config,
 		consensus: consensus,
 		executor:  executor,
@@ -206,26 +220,6 @@ func NewBlockchain(
 		},
 	}
 
-	var (
-		db  storage.Storage
-		err error
-	)
-
-	if dataDir == "" {
-		if db, err = memory.NewMemoryStorage(nil); err != nil {
-			return nil, err
-		}
-	} else {
-		if db, err = leveldb.NewLevelDBStorage(
-			filepath.Join(dataDir, "blockchain"),
-			logger,
-		); err != nil {
-			return nil, err
-		}
-	}
-
-	b.db = db
-
 	if err := b.initCaches(defaultCacheSize); err != nil {
 		return nil, err
 	}
@@ -378,9 +372,23 @@ func (b *Blockchain) CalculateGasLimit(number uint64) (uint64, error) {
 		return 0, fmt.Errorf("parent of block %d not found", number)
 	}
 
+	if gasLimit, ok := b.fixedGasLimit(number); ok {
+		return gasLimit, nil
+	}
+
 	return b.calculateGasLimit(parent.GasLimit), nil
 }
 
+// fixedGasLimit returns the gas limit of the block if the consensus fixes it
+func (b *Blockchain) fixedGasLimit(number uint64) (uint64, bool) {
+	provider, ok := b.consensus.(GasLimitProvider)
+	if !ok {
+		return 0, false
+	}
+
+	return provider.GetBlockGasLimit(number)
+}
+
 // calculateGasLimit calculates gas limit in reference to the block gas target
 func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
 	// The gas limit cannot move more than 1/1024 * parentGasLimit
@@ -412,6 +420,51 @@ func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
 	return common.Max(blockGasTarget, common.Max(parentGasLimit-delta, 0))
 }
 
+// CalculateBaseFee returns the base fee of the next block after parent (EIP-1559).
+// It is zero before the London fork
+func (b *Blockchain) CalculateBaseFee(parent *types.Header) uint64 {
+	if !b.config.Params.Forks.IsLondon(parent.Number + 1) {
+		return 0
+	}
+
+	return calculateBaseFee(parent)
+}
+
+// calculateBaseFee calculates the base fee in reference to the gas used by the parent block
+func calculateBaseFee(parent *types.Header) uint64 {
+	// The first London block starts with the initial base fee
+	if parent.BaseFee == 0 {
+		return chain.GenesisBaseFee
+	}
+
+	parentGasTarget := parent.GasLimit / chain.ElasticityMultiplier
+	if parentGasTarget == 0 || parent.GasUsed == parentGasTarget {
+		return parent.BaseFee
+	}
+
+	// The base fee cannot move more than 1/BaseFeeChangeDenom * parentBaseFee
+	// in either direction per block, proportionally to the distance from the gas target
+	var gasDelta uint64
+	if parent.GasUsed > parentGasTarget {
+		gasDelta = parent.GasUsed - parentGasTarget
+	} else {
+		gasDelta = parentGasTarget - parent.GasUsed
+	}
+
+	delta := new(big.Int).SetUint64(parent.BaseFee)
+	delta.Mul(delta, new(big.Int).SetUint64(gasDelta))
+	delta.Div(delta, new(big.Int).SetUint64(parentGasTarget))
+	delta.Div(delta, big.NewInt(chain.BaseFeeChangeDenom))
+
+	if parent.GasUsed > parentGasTarget {
+		// The block was more full than the target, so the base fee
+		// should increase by at least 1
+		return parent.BaseFee + common.Max(delta.Uint64(), 1)
+	}
+
+	return parent.BaseFee - delta.Uint64()
+}
+
 // writeGenesis wrapper for the genesis write function
 func (b *Blockchain) writeGenesis(genesis *chain.Genesis) error {
 	header := genesis.GenesisHeader()
@@ -630,6 +683,28 @@ func (b *Blockchain) GetHeaderByNumber(n uint64) (*types.Header, bool) {
 	return h, true
 }
 
+// RecentStateRoots returns the state roots of the last n canonical blocks,
+// starting from the head
+func (b *Blockchain) RecentStateRoots(n uint64) []types.Hash {
+	head := b.Header()
+	if head == nil {
+		return nil
+	}
+
+	roots := make([]types.Hash, 0, n)
+
+	for i := uint64(0); i < n && i <= head.Number; i++ {
+		header, ok := b.GetHeaderByNumber(head.Number - i)
+		if !ok {
+			break
+		}
+
+		roots = append(roots, header.StateRoot)
+	}
+
+	return roots
+}
+
 // WriteHeaders writes an array of headers
 func (b *Blockchain) WriteHeaders(headers []*types.Header) error {
 	return b.WriteHeadersWithBodies(headers)
@@ -698,6 +773,17 @@ func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) error {
 	return nil
 }
 
+// VerifyFinalizedHeader verifies that the header of a sealed (committed) block is valid,
+// without its body. It is used by the nodes following only the headers of the chain
+func (b *Blockchain) VerifyFinalizedHeader(header *types.Header) error {
+	// Make sure the consensus layer verifies this block header
+	if err := b.consensus.VerifyHeader(header); err != nil {
+		return fmt.Errorf("failed to verify the header: %w", err)
+	}
+
+	return b.verifyHeaderParent(header)
+}
+
 // verifyBlock does the base (common) block verification steps by
 // verifying the block body as well as the parent information
 func (b *Blockchain) verifyBlock(block *types.Block) error {
@@ -725,16 +811,22 @@ func (b *Blockchain) verifyBlock(block *types.Block) error {
 // - The hashes match up
 // - The block numbers match up
 // - The block gas limit / used matches up
+// - The block base fee matches up
 func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
+	return b.verifyHeaderParent(childBlock.Header)
+}
+
+// verifyHeaderParent makes sure that the header is in line with the locally saved parent block
+func (b *Blockchain) verifyHeaderParent(header *types.Header) error {
 	// Grab the parent block
-	parentHash := childBlock.ParentHash()
+	parentHash := header.ParentHash
 	parent, ok := b.readHeader(parentHash)
 
 	if !ok {
 		b.logger.Error(fmt.Sprintf(
 			"parent of %s (%d) not found: %s",
-			childBlock.Hash().String(),
-			childBlock.Number(),
+			header.Hash.String(),
+			header.Number,
 			parentHash,
 		))
 
@@ -752,10 +844,10 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 	}
 
 	// Make sure the block numbers are correct
-	if childBlock.Number()-1 != parent.Number {
+	if header.Number-1 != parent.Number {
 		b.logger.Error(fmt.Sprintf(
 			"number sequence not correct at %d and %d",
-			childBlock.Number(),
+			header.Number,
 			parent.Number,
 		))
 
@@ -763,10 +855,21 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 	}
 
 	// Make sure the gas limit is within correct bounds
-	if gasLimitErr := b.verifyGasLimit(childBlock.Header, parent); gasLimitErr != nil {
+	if gasLimitErr := b.verifyGasLimit(header, parent); gasLimitErr != nil {
 		return fmt.Errorf("invalid gas limit, %w", gasLimitErr)
 	}
 
+	// Make sure the base fee is in line with the parent
+	if baseFee := b.CalculateBaseFee(parent); header.BaseFee != baseFee {
+		b.logger.Error(fmt.Sprintf(
+			"base fee mismatch: have %d, want %d",
+			header.BaseFee,
+			baseFee,
+		))
+
+		return ErrInvalidBaseFee
+	}
+
 	return nil
 }
 
@@ -797,6 +900,11 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) error {
 		return ErrInvalidTxRoot
 	}
 
+	// Make sure the transactions are allowed by the whitelists
+	if err := b.verifyBlockWhitelists(block); err != nil {
+		return err
+	}
+
 	// Execute the transactions in the block and grab the result
 	blockResult, executeErr := b.executeBlockTransactions(block)
 	if executeErr != nil {
@@ -811,6 +919,27 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) error {
 	return nil
 }
 
+// verifyBlockWhitelists verifies that the whitelists active at the block
+// allow the senders and recipients of its transactions
+func (b *Blockchain) verifyBlockWhitelists(block *types.Block) error {
+	whitelists := b.config.Params.Whitelists.At(block.Number())
+	if whitelists == nil {
+		return nil
+	}
+
+	if err := b.recoverFromFieldsInBlock(block); err != nil {
+		return err
+	}
+
+	for _, tx := range block.Transactions {
+		if err := whitelists.ValidateTx(tx.From, tx.To); err != nil {
+			return fmt.Errorf("transaction %s not allowed, %w", tx.Hash, err)
+		}
+	}
+
+	return nil
+}
+
 // verifyBlockResult verifies that the block transaction execution result
 // matches up to the expected values
 func (br *BlockResult) verifyBlockResult(referenceBlock *types.Block) error {
@@ -938,6 +1067,167 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	return nil
 }
 
+// WriteHeader writes the header of a verified block to the local blockchain,
+// without its body and receipts. It is used by the nodes following only the headers of the chain
+func (b *Blockchain) WriteHeader(header *types.Header, source string) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	if header.Number <= b.Header().Number {
+		b.logger.Info("header already inserted", "header", header.Number, "source", source)
+
+		return nil
+	}
+
+	evnt := &Event{Source: source}
+	if err := b.writeHeaderImpl(evnt, header); err != nil {
+		return err
+	}
+
+	// update snapshot
+	if err := b.consensus.ProcessHeaders([]*types.Header{header}); err != nil {
+		return err
+	}
+
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("new header", "number", header.Number, "hash", header.Hash, "parent", header.ParentHash)
+
+	return nil
+}
+
+// AnchorAncestorsFrom returns the height of the first ancestor to write with an anchor block,
+// so that the hashes read by the BLOCKHASH opcode and the headers required by the validator
+// stores of IBFT from the end of the previous epoch are available
+func AnchorAncestorsFrom(number, epochSize uint64) uint64 {
+	from := uint64(0)
+	if number > blockHashWindow {
+		from = number - blockHashWindow
+	}
+
+	if epochSize == 0 {
+		return from
+	}
+
+	if epochBegin := (number / epochSize) * epochSize; epochBegin == 0 {
+		from = 0
+	} else if epochBegin-1 < from {
+		from = epochBegin - 1
+	}
+
+	return from
+}
+
+// VerifyAnchor verifies the header of the block to be written by WriteAnchor with the consensus,
+// fetching the headers it depends on with getHeader
+func (b *Blockchain) VerifyAnchor(anchor *types.Header, getHeader func(uint64) (*types.Header, error)) error {
+	verifier, ok := b.consensus.(AnchorVerifier)
+	if !ok {
+		return ErrAnchorNotVerifiable
+	}
+
+	return verifier.VerifyAnchor(anchor, getHeader)
+}
+
+// WriteAnchor writes the anchor block of an imported state snapshot as the head of the chain,
+// preceded by the given headers of its ancestors, so that the chain continues from the anchor
+// without the history before it. The state of the anchor must have been imported,
+// and the chain must not have any block after the genesis
+func (b *Blockchain) WriteAnchor(
+	ancestors []*types.Header,
+	anchor *types.Block,
+	receipts []*types.Receipt,
+	td *big.Int,
+) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	if b.Header().Number != 0 {
+		return ErrChainNotEmpty
+	}
+
+	headers := make([]*types.Header, 0, len(ancestors)+1)
+	headers = append(headers, ancestors...)
+	headers = append(headers, anchor.Header)
+
+	for i, header := range headers {
+		if i > 0 && (header.Number != headers[i-1].Number+1 || header.ParentHash != headers[i-1].Hash) {
+			return ErrInvalidBlockSequence
+		}
+	}
+
+	if headers[0].Number == 0 {
+		if headers[0].Hash != b.genesis {
+			return ErrInvalidBlockSequence
+		}
+
+		// the genesis is written already
+		headers = headers[1:]
+	} else if headers[0].Number == 1 && headers[0].ParentHash != b.genesis {
+		return ErrParentHashMismatch
+	}
+
+	if hash := buildroot.CalculateUncleRoot(anchor.Uncles); hash != anchor.Header.Sha3Uncles {
+		return ErrInvalidSha3Uncles
+	}
+
+	if hash := buildroot.CalculateTransactionsRoot(anchor.Transactions); hash != anchor.Header.TxRoot {
+		return ErrInvalidTxRoot
+	}
+
+	if hash := buildroot.CalculateReceiptsRoot(receipts); hash != anchor.Header.ReceiptsRoot {
+		return ErrInvalidReceiptsRoot
+	}
+
+	if err := b.writeBody(anchor); err != nil {
+		return err
+	}
+
+	if err := b.db.WriteReceipts(anchor.Hash(), receipts); err != nil {
+		return err
+	}
+
+	// the total difficulties of the ancestors are derived from the anchor one
+	headerTD := new(big.Int).Set(td)
+
+	for i := len(headers) - 1; i >= 0; i-- {
+		header := headers[i]
+
+		if err := b.db.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if err := b.db.WriteCanonicalHash(header.Number, header.Hash); err != nil {
+			return err
+		}
+
+		if err := b.db.WriteTotalDifficulty(header.Hash, headerTD); err != nil {
+			return err
+		}
+
+		headerTD = new(big.Int).Sub(headerTD, new(big.Int).SetUint64(header.Difficulty))
+	}
+
+	if err := b.db.WriteHeadHash(anchor.Hash()); err != nil {
+		return err
+	}
+
+	if err := b.db.WriteHeadNumber(anchor.Number()); err != nil {
+		return err
+	}
+
+	b.setCurrentHeader(anchor.Header, td)
+
+	evnt := &Event{Source: "snapshot"}
+	evnt.AddNewHeader(anchor.Header)
+	evnt.SetDifficulty(td)
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("anchor block written", "number", anchor.Number(), "hash", anchor.Hash(), "ancestors", len(ancestors))
+
+	return nil
+}
+
 // extractBlockReceipts extracts the receipts from the passed in block
 func (b *Blockchain) extractBlockReceipts(block *types.Block) ([]*types.Receipt, error) {
 	// Check the cache for the block receipts
@@ -972,7 +1262,7 @@ func (b *Blockchain) updateGasPriceAvgWithBlock(block *types.Block) {
 
 	gasPrices := make([]*big.Int, len(block.Transactions))
 	for i, transaction := range block.Transactions {
-		gasPrices[i] = transaction.GasPrice
+		gasPrices[i] = transaction.GetGasPrice(block.Header.BaseFee)
 	}
 
 	b.updateGasPriceAvg(gasPrices)
@@ -1068,6 +1358,15 @@ func (b *Blockchain) verifyGasLimit(header *types.Header, parentHeader *types.He
 		return nil
 	}
 
+	// The gas limit fixed by the consensus is switched at once
+	if gasLimit, ok := b.fixedGasLimit(header.Number); ok {
+		if header.GasLimit != gasLimit {
+			return fmt.Errorf("invalid gas limit, limit = %d, want %d", header.GasLimit, gasLimit)
+		}
+
+		return nil
+	}
+
 	// Find the absolute delta between the limits
 	diff := int64(parentHeader.GasLimit) - int64(header.GasLimit)
 	if diff < 0 {
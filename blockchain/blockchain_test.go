@@ -16,6 +16,7 @@ import (
 	"github.com/LaChain/polygon-edge/blockchain/storage"
 	"github.com/LaChain/polygon-edge/blockchain/storage/memory"
 	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/types/buildroot"
 )
 
 func TestGenesis(t *testing.T) {
@@ -966,6 +967,116 @@ func TestCalculateGasLimit(t *testing.T) {
 	}
 }
 
+// mockGasLimitVerifier is a verifier fixing the gas limit of the blocks from a height
+type mockGasLimitVerifier struct {
+	MockVerifier
+
+	from     uint64
+	gasLimit uint64
+}
+
+func (m *mockGasLimitVerifier) GetBlockGasLimit(number uint64) (uint64, bool) {
+	return m.gasLimit, number >= m.from
+}
+
+func TestFixedGasLimit(t *testing.T) {
+	t.Parallel()
+
+	const (
+		parentGasLimit = 20000000
+		fixedGasLimit  = 50000000
+	)
+
+	b, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+		StorageCallback: func(storage *storage.MockStorage) {
+			storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+				return &types.Header{GasLimit: parentGasLimit}, nil
+			})
+		},
+	})
+	assert.NoError(t, err)
+
+	b.config.Params = &chain.Params{
+		BlockGasTarget: 25000000,
+	}
+
+	b.SetConsensus(&mockGasLimitVerifier{from: 2, gasLimit: fixedGasLimit})
+
+	// the gas limit moves towards the target before the fork
+	gasLimit, err := b.CalculateGasLimit(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(parentGasLimit+parentGasLimit/1024), gasLimit)
+
+	// the fixed gas limit is used at once from the fork
+	gasLimit, err = b.CalculateGasLimit(2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(fixedGasLimit), gasLimit)
+
+	parent := &types.Header{Number: 1, GasLimit: parentGasLimit}
+
+	assert.NoError(t, b.verifyGasLimit(&types.Header{Number: 2, GasLimit: fixedGasLimit}, parent))
+	assert.Error(t, b.verifyGasLimit(&types.Header{Number: 2, GasLimit: parentGasLimit}, parent))
+	assert.Error(t, b.verifyGasLimit(&types.Header{Number: 1, GasLimit: fixedGasLimit}, parent))
+}
+
+func TestCalculateBaseFee(t *testing.T) {
+	tests := []struct {
+		name            string
+		parentBaseFee   uint64
+		parentGasLimit  uint64
+		parentGasUsed   uint64
+		expectedBaseFee uint64
+	}{
+		{
+			name:            "should start with the initial base fee",
+			parentBaseFee:   0,
+			parentGasLimit:  20000000,
+			parentGasUsed:   10000000,
+			expectedBaseFee: chain.GenesisBaseFee,
+		},
+		{
+			name:            "should not alter base fee when gas used is on target",
+			parentBaseFee:   1000000000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   10000000,
+			expectedBaseFee: 1000000000,
+		},
+		{
+			name:            "should increase base fee when gas used is above target",
+			parentBaseFee:   1000000000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   20000000,
+			expectedBaseFee: 1125000000,
+		},
+		{
+			name:            "should decrease base fee when gas used is below target",
+			parentBaseFee:   1000000000,
+			parentGasLimit:  20000000,
+			parentGasUsed:   0,
+			expectedBaseFee: 875000000,
+		},
+		{
+			name:            "should increase base fee by at least 1",
+			parentBaseFee:   1,
+			parentGasLimit:  20000000,
+			parentGasUsed:   10000001,
+			expectedBaseFee: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFee := calculateBaseFee(&types.Header{
+				BaseFee:  tt.parentBaseFee,
+				GasLimit: tt.parentGasLimit,
+				GasUsed:  tt.parentGasUsed,
+			})
+
+			assert.Equal(t, tt.expectedBaseFee, baseFee)
+		})
+	}
+}
+
 // TestGasPriceAverage tests the average gas price of the
 // blockchain
 func TestGasPriceAverage(t *testing.T) {
@@ -1329,4 +1440,196 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 
 		assert.ErrorIs(t, blockchain.verifyBlockBody(block), errUnableToExecute)
 	})
+
+	t.Run("Transaction not allowed by the whitelists", func(t *testing.T) {
+		t.Parallel()
+
+		sender := types.StringToAddress("1")
+
+		// Set up the chain config callback
+		chainCallback := func(config *chain.Chain) {
+			config.Params.Whitelists = &chain.Whitelists{
+				Denylist: []types.Address{sender},
+			}
+		}
+
+		blockchain, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+			ChainCallback: chainCallback,
+		})
+		if err != nil {
+			t.Fatalf("unable to instantiate new blockchain, %v", err)
+		}
+
+		txs := []*types.Transaction{
+			{
+				From:  sender,
+				Value: big.NewInt(0),
+			},
+		}
+
+		block := &types.Block{
+			Header: &types.Header{
+				Sha3Uncles: types.EmptyUncleHash,
+				TxRoot:     buildroot.CalculateTransactionsRoot(txs),
+			},
+			Transactions: txs,
+		}
+
+		assert.ErrorIs(t, blockchain.verifyBlockBody(block), chain.ErrAddressDenied)
+	})
+}
+
+func TestBlockchain_RecentStateRoots(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	for i, header := range headers {
+		header.StateRoot = types.BytesToHash([]byte{byte(i + 1)})
+
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash
+		}
+
+		header.ComputeHash()
+	}
+
+	b := NewTestBlockchain(t, headers)
+
+	cases := []struct {
+		name     string
+		n        uint64
+		expected []types.Hash
+	}{
+		{
+			name:     "no blocks",
+			n:        0,
+			expected: []types.Hash{},
+		},
+		{
+			name: "last three blocks",
+			n:    3,
+			expected: []types.Hash{
+				headers[9].StateRoot,
+				headers[8].StateRoot,
+				headers[7].StateRoot,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.expected, b.RecentStateRoots(c.n))
+		})
+	}
+}
+
+func TestAnchorAncestorsFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		number    uint64
+		epochSize uint64
+		from      uint64
+	}{
+		{"within the first epoch", 50, 100, 0},
+		{"within the BLOCKHASH window", 1000, 0, 744},
+		{"end of the previous epoch", 1000, 600, 599},
+		{"end of the previous epoch within the window", 1000, 900, 744},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.from, AnchorAncestorsFrom(tt.number, tt.epochSize))
+		})
+	}
+}
+
+func TestBlockchain_WriteAnchor(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	anchorTD := big.NewInt(100)
+
+	newGenesisChain := func(t *testing.T) *Blockchain {
+		t.Helper()
+
+		b := NewTestBlockchain(t, nil)
+		assert.NoError(t, b.writeGenesisImpl(headers[0]))
+
+		return b
+	}
+
+	t.Run("should write the anchor and its ancestors", func(t *testing.T) {
+		t.Parallel()
+
+		b := newGenesisChain(t)
+
+		assert.NoError(t, b.WriteAnchor(headers[3:9], &types.Block{Header: headers[9]}, nil, anchorTD))
+
+		assert.Equal(t, headers[9].Hash, b.Header().Hash)
+		assert.Equal(t, anchorTD, b.CurrentTD())
+
+		header, ok := b.GetHeaderByNumber(5)
+		assert.True(t, ok)
+		assert.Equal(t, headers[5].Hash, header.Hash)
+
+		// the difficulty of the anchor is 9
+		td, ok := b.GetTD(headers[8].Hash)
+		assert.True(t, ok)
+		assert.Equal(t, big.NewInt(91), td)
+
+		block, ok := b.GetBlockByNumber(9, true)
+		assert.True(t, ok)
+		assert.Equal(t, headers[9].Hash, block.Hash())
+
+		// the history before the ancestors is unknown
+		_, ok = b.GetHeaderByNumber(2)
+		assert.False(t, ok)
+
+		assert.ErrorIs(t, b.WriteAnchor(nil, &types.Block{Header: headers[9]}, nil, anchorTD), ErrChainNotEmpty)
+	})
+
+	t.Run("should write the anchor following the genesis", func(t *testing.T) {
+		t.Parallel()
+
+		b := newGenesisChain(t)
+
+		assert.NoError(t, b.WriteAnchor(headers[:3], &types.Block{Header: headers[3]}, nil, anchorTD))
+		assert.Equal(t, headers[3].Hash, b.Header().Hash)
+	})
+
+	t.Run("should fail if the ancestors are not in sequence", func(t *testing.T) {
+		t.Parallel()
+
+		b := newGenesisChain(t)
+
+		assert.ErrorIs(
+			t,
+			b.WriteAnchor(headers[3:8], &types.Block{Header: headers[9]}, nil, anchorTD),
+			ErrInvalidBlockSequence,
+		)
+		assert.Equal(t, uint64(0), b.Header().Number)
+	})
+
+	t.Run("should fail if the body does not match the anchor", func(t *testing.T) {
+		t.Parallel()
+
+		b := newGenesisChain(t)
+
+		anchor := &types.Block{
+			Header:       headers[9],
+			Transactions: []*types.Transaction{{Nonce: 1}},
+		}
+
+		assert.ErrorIs(t, b.WriteAnchor(headers[3:9], anchor, nil, anchorTD), ErrInvalidTxRoot)
+	})
 }
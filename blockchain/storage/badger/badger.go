@@ -0,0 +1,113 @@
+package badger
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Factory creates a badger storage
+func Factory(config map[string]interface{}, logger hclog.Logger) (storage.Storage, error) {
+	path, ok := config["path"]
+	if !ok {
+		return nil, fmt.Errorf("path not found")
+	}
+
+	pathStr, ok := path.(string)
+	if !ok {
+		return nil, fmt.Errorf("path is not a string")
+	}
+
+	return NewBadgerStorage(pathStr, logger)
+}
+
+// NewBadgerStorage creates the new storage reference with badger
+func NewBadgerStorage(path string, logger hclog.Logger) (storage.Storage, error) {
+	db, err := OpenDB(path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &badgerKV{db}
+
+	return storage.NewKeyValueStorage(logger.Named("badger"), kv), nil
+}
+
+// OpenDB opens the badger database in the given directory
+func OpenDB(path string, logger hclog.Logger) (*badger.DB, error) {
+	return badger.Open(
+		badger.DefaultOptions(path).WithLogger(&badgerLogger{logger.Named("badger")}),
+	)
+}
+
+// badgerKV is the badger implementation of the kv storage
+type badgerKV struct {
+	db *badger.DB
+}
+
+// Set sets the key-value pair in badger storage
+func (b *badgerKV) Set(k []byte, v []byte) error {
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(k, v)
+	})
+}
+
+// Get retrieves the key-value pair in badger storage
+func (b *badgerKV) Get(k []byte) ([]byte, bool, error) {
+	var data []byte
+
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(k)
+		if err != nil {
+			return err
+		}
+
+		data, err = item.ValueCopy(nil)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+// Close closes the badger storage instance
+func (b *badgerKV) Close() error {
+	return b.db.Close()
+}
+
+// badgerLogger writes the logs of badger to the node logger
+type badgerLogger struct {
+	logger hclog.Logger
+}
+
+func (l *badgerLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Error(formatLog(format, args...))
+}
+
+func (l *badgerLogger) Warningf(format string, args ...interface{}) {
+	l.logger.Warn(formatLog(format, args...))
+}
+
+func (l *badgerLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(formatLog(format, args...))
+}
+
+func (l *badgerLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Trace(formatLog(format, args...))
+}
+
+// formatLog formats the log message of badger, which ends with a new line
+func formatLog(format string, args ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+}
@@ -0,0 +1,96 @@
+package pebble
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Factory creates a pebble storage
+func Factory(config map[string]interface{}, logger hclog.Logger) (storage.Storage, error) {
+	path, ok := config["path"]
+	if !ok {
+		return nil, fmt.Errorf("path not found")
+	}
+
+	pathStr, ok := path.(string)
+	if !ok {
+		return nil, fmt.Errorf("path is not a string")
+	}
+
+	return NewPebbleStorage(pathStr, logger)
+}
+
+// NewPebbleStorage creates the new storage reference with pebble
+func NewPebbleStorage(path string, logger hclog.Logger) (storage.Storage, error) {
+	db, err := OpenDB(path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &pebbleKV{db}
+
+	return storage.NewKeyValueStorage(logger.Named("pebble"), kv), nil
+}
+
+// OpenDB opens the pebble database in the given directory
+func OpenDB(path string, logger hclog.Logger) (*pebble.DB, error) {
+	return pebble.Open(path, &pebble.Options{
+		Logger: &pebbleLogger{logger.Named("pebble")},
+	})
+}
+
+// pebbleKV is the pebble implementation of the kv storage
+type pebbleKV struct {
+	db *pebble.DB
+}
+
+// Set sets the key-value pair in pebble storage
+func (p *pebbleKV) Set(k []byte, v []byte) error {
+	return p.db.Set(k, v, pebble.NoSync)
+}
+
+// Get retrieves the key-value pair in pebble storage
+func (p *pebbleKV) Get(k []byte) ([]byte, bool, error) {
+	data, closer, err := p.db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	// the data is only valid until the closer is closed
+	value := append([]byte{}, data...)
+
+	if err := closer.Close(); err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+// Close closes the pebble storage instance
+func (p *pebbleKV) Close() error {
+	return p.db.Close()
+}
+
+// pebbleLogger writes the logs of pebble to the node logger
+type pebbleLogger struct {
+	logger hclog.Logger
+}
+
+func (l *pebbleLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.logger.Error(fmt.Sprintf(format, args...))
+
+	os.Exit(1)
+}
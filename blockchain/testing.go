@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/blockchain/storage/memory"
 
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/state"
@@ -343,7 +344,12 @@ func newBlockChain(config *chain.Chain, executor Executor) (*Blockchain, error)
 		executor = &mockExecutor{}
 	}
 
-	b, err := NewBlockchain(hclog.NewNullLogger(), "", config, &MockVerifier{}, executor, &mockSigner{})
+	db, err := memory.NewMemoryStorage(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := NewBlockchain(hclog.NewNullLogger(), db, config, &MockVerifier{}, executor, &mockSigner{})
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,17 @@ var (
 
 	// GenesisDifficulty is the default difficulty of the Genesis block.
 	GenesisDifficulty = big.NewInt(131072)
+
+	// GenesisBaseFee is the default base fee of the first London block.
+	GenesisBaseFee uint64 = 1000000000
+)
+
+const (
+	// BaseFeeChangeDenom bounds the amount the base fee can change between blocks
+	BaseFeeChangeDenom = 8
+
+	// ElasticityMultiplier bounds the maximum gas limit an EIP-1559 block may have
+	ElasticityMultiplier = 2
 )
 
 // Chain is the blockchain chain configuration
@@ -40,6 +51,7 @@ type Genesis struct {
 	Mixhash    types.Hash                        `json:"mixHash"`
 	Coinbase   types.Address                     `json:"coinbase"`
 	Alloc      map[types.Address]*GenesisAccount `json:"alloc,omitempty"`
+	BaseFee    uint64                            `json:"baseFee"`
 
 	// Override
 	StateRoot types.Hash
@@ -67,6 +79,7 @@ func (g *Genesis) GenesisHeader() *types.Header {
 		GasLimit:     g.GasLimit,
 		GasUsed:      g.GasUsed,
 		Difficulty:   g.Difficulty,
+		BaseFee:      g.BaseFee,
 		MixHash:      g.Mixhash,
 		Miner:        g.Coinbase.Bytes(),
 		StateRoot:    stateRoot,
@@ -106,6 +119,7 @@ func (g *Genesis) MarshalJSON() ([]byte, error) {
 		Mixhash    types.Hash                  `json:"mixHash"`
 		Coinbase   types.Address               `json:"coinbase"`
 		Alloc      *map[string]*GenesisAccount `json:"alloc,omitempty"`
+		BaseFee    *string                     `json:"baseFee,omitempty"`
 		Number     *string                     `json:"number,omitempty"`
 		GasUsed    *string                     `json:"gasUsed,omitempty"`
 		ParentHash types.Hash                  `json:"parentHash"`
@@ -132,6 +146,10 @@ func (g *Genesis) MarshalJSON() ([]byte, error) {
 		enc.Alloc = &alloc
 	}
 
+	if g.BaseFee != 0 {
+		enc.BaseFee = types.EncodeUint64(g.BaseFee)
+	}
+
 	enc.Number = types.EncodeUint64(g.Number)
 	enc.GasUsed = types.EncodeUint64(g.GasUsed)
 	enc.ParentHash = g.ParentHash
@@ -150,6 +168,7 @@ func (g *Genesis) UnmarshalJSON(data []byte) error {
 		Mixhash    *types.Hash                `json:"mixHash"`
 		Coinbase   *types.Address             `json:"coinbase"`
 		Alloc      map[string]*GenesisAccount `json:"alloc"`
+		BaseFee    *string                    `json:"baseFee"`
 		Number     *string                    `json:"number"`
 		GasUsed    *string                    `json:"gasUsed"`
 		ParentHash *types.Hash                `json:"parentHash"`
@@ -214,6 +233,11 @@ func (g *Genesis) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	g.BaseFee, subErr = types.ParseUint64orHex(dec.BaseFee)
+	if subErr != nil {
+		parseError("basefee", subErr)
+	}
+
 	g.Number, subErr = types.ParseUint64orHex(dec.Number)
 	if subErr != nil {
 		parseError("number", subErr)
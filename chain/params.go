@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/LaChain/polygon-edge/types"
@@ -13,6 +14,18 @@ type Params struct {
 	Engine         map[string]interface{} `json:"engine"`
 	Whitelists     *Whitelists            `json:"whitelists,omitempty"`
 	BlockGasTarget uint64                 `json:"blockGasTarget"`
+
+	// BurnContract is the address the base fee is sent to after London.
+	// If it is not set, the base fee is burned
+	BurnContract *types.Address `json:"burnContract,omitempty"`
+
+	// AccessControlContract is the address of the access control contract
+	// granting the roles to send transactions and deploy contracts, if set
+	AccessControlContract *types.Address `json:"accessControlContract,omitempty"`
+
+	// AccessControlInternalCreates applies the deployer role of the access control contract
+	// to the contracts created by contracts as well, not only by transactions
+	AccessControlInternalCreates bool `json:"accessControlInternalCreates,omitempty"`
 }
 
 func (p *Params) GetEngine() string {
@@ -24,9 +37,118 @@ func (p *Params) GetEngine() string {
 	return ""
 }
 
-// Whitelists specifies supported whitelists
+var (
+	ErrDeploymentRestricted = errors.New("smart contract deployment restricted")
+	ErrSenderRestricted     = errors.New("sender not in the sender whitelist")
+	ErrAddressDenied        = errors.New("address in the denylist")
+	ErrCallTargetRestricted = errors.New("call target not in the call target whitelist")
+)
+
+// Whitelists specifies supported whitelists.
+// An empty whitelist allows any address, while the denylist
+// rejects the addresses in it
 type Whitelists struct {
 	Deployment []types.Address `json:"deployment,omitempty"`
+	Sender     []types.Address `json:"sender,omitempty"`
+	Denylist   []types.Address `json:"denylist,omitempty"`
+	CallTarget []types.Address `json:"callTarget,omitempty"`
+
+	// InternalCreates applies the deployment whitelist and the denylist
+	// to the contracts created by contracts as well, not only by transactions
+	InternalCreates bool `json:"internalCreates,omitempty"`
+
+	// Forks replace all the lists from the given block
+	Forks []*WhitelistsFork `json:"forks,omitempty"`
+}
+
+// WhitelistsFork specifies the whitelists active from a block
+type WhitelistsFork struct {
+	Block      uint64      `json:"block"`
+	Whitelists *Whitelists `json:"whitelists"`
+}
+
+// At returns the whitelists active at the given block
+func (w *Whitelists) At(block uint64) *Whitelists {
+	if w == nil {
+		return nil
+	}
+
+	active, activeBlock := w, uint64(0)
+
+	for _, fork := range w.Forks {
+		if fork.Block <= block && fork.Block >= activeBlock {
+			active, activeBlock = fork.Whitelists, fork.Block
+		}
+	}
+
+	return active
+}
+
+// ValidateTx checks if the transaction from the sender to the recipient
+// is allowed by the whitelists, the recipient is nil for contract creations
+func (w *Whitelists) ValidateTx(from types.Address, to *types.Address) error {
+	if w == nil {
+		return nil
+	}
+
+	if containsAddress(w.Denylist, from) {
+		return ErrAddressDenied
+	}
+
+	if len(w.Sender) != 0 && !containsAddress(w.Sender, from) {
+		return ErrSenderRestricted
+	}
+
+	if to == nil {
+		return w.ValidateCreate(from)
+	}
+
+	if containsAddress(w.Denylist, *to) {
+		return ErrAddressDenied
+	}
+
+	if len(w.CallTarget) != 0 && !containsAddress(w.CallTarget, *to) {
+		return ErrCallTargetRestricted
+	}
+
+	return nil
+}
+
+// ValidateCreate checks if the creator is allowed to deploy contracts
+func (w *Whitelists) ValidateCreate(creator types.Address) error {
+	if w == nil {
+		return nil
+	}
+
+	if containsAddress(w.Denylist, creator) {
+		return ErrAddressDenied
+	}
+
+	if len(w.Deployment) != 0 && !containsAddress(w.Deployment, creator) {
+		return ErrDeploymentRestricted
+	}
+
+	return nil
+}
+
+// ValidateInternalCreate checks if the contract is allowed to deploy contracts,
+// which is only restricted if the whitelists apply to the internal creates
+func (w *Whitelists) ValidateInternalCreate(creator types.Address) error {
+	if w == nil || !w.InternalCreates {
+		return nil
+	}
+
+	return w.ValidateCreate(creator)
+}
+
+func containsAddress(addresses []types.Address, addr types.Address) bool {
+	for _, a := range addresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Forks specifies when each fork is activated
@@ -39,6 +161,10 @@ type Forks struct {
 	EIP150         *Fork `json:"EIP150,omitempty"`
 	EIP158         *Fork `json:"EIP158,omitempty"`
 	EIP155         *Fork `json:"EIP155,omitempty"`
+	Berlin         *Fork `json:"berlin,omitempty"`
+	London         *Fork `json:"london,omitempty"`
+	Shanghai       *Fork `json:"shanghai,omitempty"`
+	Cancun         *Fork `json:"cancun,omitempty"`
 }
 
 func (f *Forks) active(ff *Fork, block uint64) bool {
@@ -77,6 +203,22 @@ func (f *Forks) IsEIP155(block uint64) bool {
 	return f.active(f.EIP155, block)
 }
 
+func (f *Forks) IsBerlin(block uint64) bool {
+	return f.active(f.Berlin, block)
+}
+
+func (f *Forks) IsLondon(block uint64) bool {
+	return f.active(f.London, block)
+}
+
+func (f *Forks) IsShanghai(block uint64) bool {
+	return f.active(f.Shanghai, block)
+}
+
+func (f *Forks) IsCancun(block uint64) bool {
+	return f.active(f.Cancun, block)
+}
+
 func (f *Forks) At(block uint64) ForksInTime {
 	return ForksInTime{
 		Homestead:      f.active(f.Homestead, block),
@@ -87,6 +229,10 @@ func (f *Forks) At(block uint64) ForksInTime {
 		EIP150:         f.active(f.EIP150, block),
 		EIP158:         f.active(f.EIP158, block),
 		EIP155:         f.active(f.EIP155, block),
+		Berlin:         f.active(f.Berlin, block),
+		London:         f.active(f.London, block),
+		Shanghai:       f.active(f.Shanghai, block),
+		Cancun:         f.active(f.Cancun, block),
 	}
 }
 
@@ -114,7 +260,11 @@ type ForksInTime struct {
 	Istanbul,
 	EIP150,
 	EIP158,
-	EIP155 bool
+	EIP155,
+	Berlin,
+	London,
+	Shanghai,
+	Cancun bool
 }
 
 var AllForksEnabled = &Forks{
@@ -126,4 +276,8 @@ var AllForksEnabled = &Forks{
 	Constantinople: NewFork(0),
 	Petersburg:     NewFork(0),
 	Istanbul:       NewFork(0),
+	Berlin:         NewFork(0),
+	London:         NewFork(0),
+	Shanghai:       NewFork(0),
+	Cancun:         NewFork(0),
 }
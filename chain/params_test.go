@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParamsForks(t *testing.T) {
@@ -54,3 +57,76 @@ func TestParamsForksInTime(t *testing.T) {
 	expect("constantinople", ff.Constantinople, false)
 	expect("eip150", ff.EIP150, false)
 }
+
+func TestWhitelistsAt(t *testing.T) {
+	var (
+		genesis = &Whitelists{Deployment: []types.Address{types.StringToAddress("1")}}
+		fork10  = &Whitelists{Denylist: []types.Address{types.StringToAddress("2")}}
+		fork20  = &Whitelists{}
+	)
+
+	w := &Whitelists{
+		Deployment: genesis.Deployment,
+		Forks: []*WhitelistsFork{
+			{Block: 20, Whitelists: fork20},
+			{Block: 10, Whitelists: fork10},
+		},
+	}
+
+	assert.Nil(t, (*Whitelists)(nil).At(10))
+	assert.Equal(t, genesis.Deployment, w.At(9).Deployment)
+	assert.Equal(t, fork10, w.At(10))
+	assert.Equal(t, fork10, w.At(19))
+	assert.Equal(t, fork20, w.At(20))
+}
+
+func TestWhitelistsValidateTx(t *testing.T) {
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+	)
+
+	cases := []struct {
+		whitelists *Whitelists
+		from       types.Address
+		to         *types.Address
+		err        error
+	}{
+		{nil, addr1, nil, nil},
+		{&Whitelists{Deployment: []types.Address{addr2}}, addr1, &addr2, nil},
+		{&Whitelists{Deployment: []types.Address{addr2}}, addr1, nil, ErrDeploymentRestricted},
+		{&Whitelists{Sender: []types.Address{addr2}}, addr1, &addr2, ErrSenderRestricted},
+		{&Whitelists{Denylist: []types.Address{addr1}}, addr1, &addr2, ErrAddressDenied},
+		{&Whitelists{Denylist: []types.Address{addr2}}, addr1, &addr2, ErrAddressDenied},
+		{&Whitelists{CallTarget: []types.Address{addr1}}, addr1, &addr2, ErrCallTargetRestricted},
+		{&Whitelists{CallTarget: []types.Address{addr1}}, addr1, nil, nil},
+	}
+
+	for _, c := range cases {
+		assert.ErrorIs(t, c.whitelists.ValidateTx(c.from, c.to), c.err)
+	}
+}
+
+func TestWhitelistsValidateInternalCreate(t *testing.T) {
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+	)
+
+	cases := []struct {
+		whitelists *Whitelists
+		creator    types.Address
+		err        error
+	}{
+		{nil, addr1, nil},
+		{&Whitelists{Deployment: []types.Address{addr2}}, addr1, nil},
+		{&Whitelists{Denylist: []types.Address{addr1}}, addr1, nil},
+		{&Whitelists{Deployment: []types.Address{addr2}, InternalCreates: true}, addr1, ErrDeploymentRestricted},
+		{&Whitelists{Deployment: []types.Address{addr2}, InternalCreates: true}, addr2, nil},
+		{&Whitelists{Denylist: []types.Address{addr1}, InternalCreates: true}, addr1, ErrAddressDenied},
+	}
+
+	for _, c := range cases {
+		assert.ErrorIs(t, c.whitelists.ValidateInternalCreate(c.creator), c.err)
+	}
+}
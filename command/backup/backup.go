@@ -1,6 +1,9 @@
 package backup
 
 import (
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/archive"
 	"github.com/LaChain/polygon-edge/command"
 	"github.com/spf13/cobra"
 
@@ -44,6 +47,25 @@ func setFlags(cmd *cobra.Command) {
 		"",
 		"the end height of the chain in backup",
 	)
+
+	cmd.Flags().StringVar(
+		&params.compressionRaw,
+		compressionFlag,
+		string(archive.CompressionGzip),
+		fmt.Sprintf(
+			"the compression of the backup chunks. Available compressions: %s, %s, %s",
+			archive.CompressionNone,
+			archive.CompressionGzip,
+			archive.CompressionZstd,
+		),
+	)
+
+	cmd.Flags().BoolVar(
+		&params.resume,
+		resumeFlag,
+		false,
+		"continue the existing backup from its last fully written block, with the compression of the backup",
+	)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
@@ -11,9 +11,11 @@ import (
 )
 
 const (
-	outFlag  = "out"
-	fromFlag = "from"
-	toFlag   = "to"
+	outFlag         = "out"
+	fromFlag        = "from"
+	toFlag          = "to"
+	compressionFlag = "compression"
+	resumeFlag      = "resume"
 )
 
 var (
@@ -34,6 +36,10 @@ type backupParams struct {
 	from uint64
 	to   *uint64
 
+	compressionRaw string
+	compression    archive.Compression
+	resume         bool
+
 	resFrom uint64
 	resTo   uint64
 }
@@ -59,6 +65,10 @@ func (p *backupParams) validateFlags() error {
 		p.to = &parsedTo
 	}
 
+	if p.compression, parseErr = archive.ParseCompression(p.compressionRaw); parseErr != nil {
+		return parseErr
+	}
+
 	return nil
 }
 
@@ -86,6 +96,8 @@ func (p *backupParams) createBackup(grpcAddress string) error {
 		p.from,
 		p.to,
 		p.out,
+		p.compression,
+		p.resume,
 	)
 	if err != nil {
 		return err
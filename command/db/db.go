@@ -0,0 +1,23 @@
+package db
+
+import (
+	"github.com/LaChain/polygon-edge/command/db/migrate"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Top level command for managing the databases of a stopped node. Only accepts subcommands.",
+	}
+
+	registerSubcommands(dbCmd)
+
+	return dbCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		migrate.GetCommand(),
+	)
+}
@@ -0,0 +1,56 @@
+package migrate
+
+import (
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Copies the blockchain and the state of a stopped node into databases of another engine. " +
+			"The previous databases are kept as backups",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(migrateCmd)
+
+	return migrateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawEngine,
+		dbEngineFlag,
+		"",
+		"the database engine to migrate to: \"leveldb\", \"pebble\" or \"badger\"",
+	)
+
+	_ = cmd.MarkFlagRequired(dataDirFlag)
+	_ = cmd.MarkFlagRequired(dbEngineFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.migrate(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
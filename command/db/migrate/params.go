@@ -0,0 +1,133 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	dataDirFlag  = "data-dir"
+	dbEngineFlag = "db-engine"
+)
+
+var (
+	params = &migrateParams{}
+)
+
+var (
+	errDatabasesNotFound = errors.New("no database found, is the data directory initialized?")
+	errBackupExists      = errors.New("the backup of the database already exists")
+)
+
+// databases are the directories of the databases in the data directory
+var databases = []string{"blockchain", "trie"}
+
+type migrateParams struct {
+	dataDir   string
+	rawEngine string
+
+	engine   dbengine.Engine
+	migrated []*MigratedDatabase
+}
+
+func (p *migrateParams) validateFlags() error {
+	var err error
+
+	p.engine, err = dbengine.Parse(p.rawEngine)
+
+	return err
+}
+
+func (p *migrateParams) migrate() error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "migrate",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	found := false
+
+	for _, name := range databases {
+		path := filepath.Join(p.dataDir, name)
+
+		engine, exists, err := dbengine.Detect(path)
+		if err != nil {
+			return fmt.Errorf("unable to detect the engine of %s: %w", path, err)
+		}
+
+		if !exists {
+			continue
+		}
+
+		found = true
+
+		// the database has been migrated already
+		if engine == p.engine {
+			continue
+		}
+
+		migrated, err := migrateDatabase(path, engine, p.engine, logger)
+		if err != nil {
+			return fmt.Errorf("unable to migrate %s: %w", path, err)
+		}
+
+		p.migrated = append(p.migrated, migrated)
+	}
+
+	if !found {
+		return errDatabasesNotFound
+	}
+
+	return nil
+}
+
+// migrateDatabase copies the database into a new one with the engine, which replaces it.
+// The previous database is moved next to it
+func migrateDatabase(path string, from, to dbengine.Engine, logger hclog.Logger) (*MigratedDatabase, error) {
+	var (
+		tmpPath    = fmt.Sprintf("%s.%s", path, to)
+		backupPath = fmt.Sprintf("%s.%s.bak", path, from)
+	)
+
+	if _, err := os.Stat(backupPath); err == nil {
+		return nil, fmt.Errorf("%w: %s", errBackupExists, backupPath)
+	}
+
+	// remove the leftovers of an interrupted migration
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return nil, err
+	}
+
+	logger.Info("copying the database", "path", path, "from", from, "to", to)
+
+	entries, err := dbengine.Copy(path, tmpPath, to, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(path, backupPath); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, err
+	}
+
+	return &MigratedDatabase{
+		Path:    path,
+		Entries: entries,
+		Backup:  backupPath,
+	}, nil
+}
+
+func (p *migrateParams) getResult() command.CommandResult {
+	return &MigrateResult{
+		Engine:    string(p.engine),
+		Databases: p.migrated,
+	}
+}
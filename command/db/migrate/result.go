@@ -0,0 +1,44 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/command/helper"
+)
+
+type MigratedDatabase struct {
+	Path    string `json:"path"`
+	Entries int    `json:"entries"`
+	Backup  string `json:"backup"`
+}
+
+type MigrateResult struct {
+	Engine    string              `json:"engine"`
+	Databases []*MigratedDatabase `json:"databases"`
+}
+
+func (r *MigrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB MIGRATE]\n")
+
+	if len(r.Databases) == 0 {
+		buffer.WriteString(fmt.Sprintf("The databases already use %s\n", r.Engine))
+
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("Migrated the databases to %s successfully:\n", r.Engine))
+
+	for _, db := range r.Databases {
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Database|%s", db.Path),
+			fmt.Sprintf("Copied entries|%d", db.Entries),
+			fmt.Sprintf("Backup|%s", db.Backup),
+		}))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
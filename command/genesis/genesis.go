@@ -148,6 +148,24 @@ func setFlags(cmd *cobra.Command) {
 			"the maximum number of validators in the validator set for PoS",
 		)
 	}
+
+	// Access control
+	{
+		cmd.Flags().StringArrayVar(
+			&params.accessControlAdminsRaw,
+			accessControlAdminFlag,
+			[]string{},
+			"the admin addresses of the access control contract, granting the roles to send transactions "+
+				"and deploy contracts. The contract is predeployed only if admins are provided",
+		)
+
+		cmd.Flags().BoolVar(
+			&params.accessControlInternalCreates,
+			accessControlInternalCreatesFlag,
+			false,
+			"the flag indicating whether the deployer role applies to the contracts created by contracts as well",
+		)
+	}
 }
 
 // setLegacyFlags sets the legacy flags to preserve backwards compatibility
@@ -10,7 +10,9 @@ import (
 	"github.com/LaChain/polygon-edge/consensus/ibft"
 	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
 	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
 	"github.com/LaChain/polygon-edge/contracts/staking"
+	accessControlHelper "github.com/LaChain/polygon-edge/helper/accesscontrol"
 	stakingHelper "github.com/LaChain/polygon-edge/helper/staking"
 	"github.com/LaChain/polygon-edge/server"
 	"github.com/LaChain/polygon-edge/types"
@@ -27,6 +29,9 @@ const (
 	posFlag           = "pos"
 	minValidatorCount = "min-validator-count"
 	maxValidatorCount = "max-validator-count"
+
+	accessControlAdminFlag           = "access-control-admin"
+	accessControlInternalCreatesFlag = "access-control-internal-creates"
 )
 
 // Legacy flags that need to be preserved for running clients
@@ -63,6 +68,9 @@ type genesisParams struct {
 	minNumValidators uint64
 	maxNumValidators uint64
 
+	accessControlAdminsRaw       []string
+	accessControlInternalCreates bool
+
 	rawIBFTValidatorType string
 	ibftValidatorType    validators.ValidatorType
 
@@ -312,6 +320,20 @@ func (p *genesisParams) initGenesisConfig() error {
 		chainConfig.Genesis.Alloc[staking.AddrStakingContract] = stakingAccount
 	}
 
+	// Predeploy access control smart contract if needed
+	if p.shouldPredeployAccessControlSC() {
+		accessControlAccount, err := p.predeployAccessControlSC()
+		if err != nil {
+			return err
+		}
+
+		accessControlAddr := accesscontrol.AddrAccessControlContract
+
+		chainConfig.Genesis.Alloc[accessControlAddr] = accessControlAccount
+		chainConfig.Params.AccessControlContract = &accessControlAddr
+		chainConfig.Params.AccessControlInternalCreates = p.accessControlInternalCreates
+	}
+
 	if err := fillPremineMap(chainConfig.Genesis.Alloc, p.premine); err != nil {
 		return err
 	}
@@ -341,6 +363,22 @@ func (p *genesisParams) predeployStakingSC() (*chain.GenesisAccount, error) {
 	return stakingAccount, nil
 }
 
+func (p *genesisParams) shouldPredeployAccessControlSC() bool {
+	return len(p.accessControlAdminsRaw) != 0
+}
+
+func (p *genesisParams) predeployAccessControlSC() (*chain.GenesisAccount, error) {
+	admins := make([]types.Address, len(p.accessControlAdminsRaw))
+
+	for i, raw := range p.accessControlAdminsRaw {
+		if err := admins[i].UnmarshalText([]byte(raw)); err != nil {
+			return nil, fmt.Errorf("invalid access control admin address %s: %w", raw, err)
+		}
+	}
+
+	return accessControlHelper.PredeployAccessControlSC(admins)
+}
+
 func (p *genesisParams) getResult() command.CommandResult {
 	return &GenesisResult{
 		Message: fmt.Sprintf("Genesis written to %s\n", p.genesisPath),
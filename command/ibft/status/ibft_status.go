@@ -13,7 +13,7 @@ import (
 func GetCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "status",
-		Short: "Returns the current validator key of the IBFT client",
+		Short: "Returns the current validator key of the IBFT client and the liveness of the validators in the epoch",
 		Run:   runCommand,
 	}
 }
@@ -29,9 +29,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	outputter.SetCommandResult(&IBFTStatusResult{
-		ValidatorKey: statusResponse.Key,
-	})
+	outputter.SetCommandResult(newIBFTStatusResult(statusResponse))
 }
 
 func getIBFTStatus(grpcAddress string) (*ibftOp.IbftStatusResp, error) {
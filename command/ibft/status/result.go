@@ -5,10 +5,43 @@ import (
 	"fmt"
 
 	"github.com/LaChain/polygon-edge/command/helper"
+	ibftOp "github.com/LaChain/polygon-edge/consensus/ibft/proto"
 )
 
+type ValidatorLiveness struct {
+	Address         string `json:"address"`
+	Proposed        uint64 `json:"proposed"`
+	MissedProposals uint64 `json:"missed_proposals"`
+	Signed          uint64 `json:"signed"`
+	MissedSeals     uint64 `json:"missed_seals"`
+	Jailed          bool   `json:"jailed"`
+}
+
 type IBFTStatusResult struct {
-	ValidatorKey string `json:"validator_key"`
+	ValidatorKey string              `json:"validator_key"`
+	Epoch        uint64              `json:"epoch"`
+	Liveness     []ValidatorLiveness `json:"liveness"`
+}
+
+func newIBFTStatusResult(resp *ibftOp.IbftStatusResp) *IBFTStatusResult {
+	res := &IBFTStatusResult{
+		ValidatorKey: resp.Key,
+		Epoch:        resp.Epoch,
+		Liveness:     make([]ValidatorLiveness, len(resp.Liveness)),
+	}
+
+	for i, l := range resp.Liveness {
+		res.Liveness[i] = ValidatorLiveness{
+			Address:         l.Address,
+			Proposed:        l.Proposed,
+			MissedProposals: l.MissedProposals,
+			Signed:          l.Signed,
+			MissedSeals:     l.MissedSeals,
+			Jailed:          l.Jailed,
+		}
+	}
+
+	return res
 }
 
 func (r *IBFTStatusResult) GetOutput() string {
@@ -17,8 +50,37 @@ func (r *IBFTStatusResult) GetOutput() string {
 	buffer.WriteString("\n[VALIDATOR STATUS]\n")
 	buffer.WriteString(helper.FormatKV([]string{
 		fmt.Sprintf("Validator key|%s", r.ValidatorKey),
+		fmt.Sprintf("Epoch|%d", r.Epoch),
 	}))
 	buffer.WriteString("\n")
 
+	r.writeLivenessData(&buffer)
+
 	return buffer.String()
 }
+
+func (r *IBFTStatusResult) writeLivenessData(buffer *bytes.Buffer) {
+	numValidators := len(r.Liveness)
+	liveness := make([]string, numValidators+1)
+	liveness[0] = "No validators found"
+
+	if numValidators > 0 {
+		liveness[0] = "ADDRESS|PROPOSED|MISSED PROPOSALS|SIGNED|MISSED SEALS|JAILED"
+
+		for i, l := range r.Liveness {
+			liveness[i+1] = fmt.Sprintf(
+				"%s|%d|%d|%d|%d|%t",
+				l.Address,
+				l.Proposed,
+				l.MissedProposals,
+				l.Signed,
+				l.MissedSeals,
+				l.Jailed,
+			)
+		}
+	}
+
+	buffer.WriteString("\n[LIVENESS]\n")
+	buffer.WriteString(helper.FormatList(liveness))
+	buffer.WriteString("\n")
+}
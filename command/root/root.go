@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/LaChain/polygon-edge/command/backup"
+	"github.com/LaChain/polygon-edge/command/db"
 	"github.com/LaChain/polygon-edge/command/genesis"
 	"github.com/LaChain/polygon-edge/command/helper"
 	"github.com/LaChain/polygon-edge/command/ibft"
@@ -13,6 +14,7 @@ import (
 	"github.com/LaChain/polygon-edge/command/peers"
 	"github.com/LaChain/polygon-edge/command/secrets"
 	"github.com/LaChain/polygon-edge/command/server"
+	"github.com/LaChain/polygon-edge/command/state"
 	"github.com/LaChain/polygon-edge/command/status"
 	"github.com/LaChain/polygon-edge/command/txpool"
 	"github.com/LaChain/polygon-edge/command/version"
@@ -51,6 +53,8 @@ func (rc *RootCommand) registerSubCommands() {
 		genesis.GetCommand(),
 		server.GetCommand(),
 		whitelist.GetCommand(),
+		state.GetCommand(),
+		db.GetCommand(),
 		license.GetCommand(),
 	)
 }
@@ -50,10 +50,11 @@ func setFlags(cmd *cobra.Command) {
 		typeFlag,
 		string(secrets.HashicorpVault),
 		fmt.Sprintf(
-			"the type of the secrets manager. Available types: %s, %s and %s",
+			"the type of the secrets manager. Available types: %s, %s, %s and %s",
 			secrets.HashicorpVault,
 			secrets.AWSSSM,
 			secrets.GCPSSM,
+			secrets.EncryptedLocal,
 		),
 	)
 
@@ -75,7 +76,8 @@ func setFlags(cmd *cobra.Command) {
 		&params.extra,
 		extraFlag,
 		"",
-		"Specifies the extra fields map in string format 'key1=val1,key2=val2'",
+		"Specifies the extra fields map in string format 'key1=val1,key2=val2', "+
+			"such as the passphrase-file or passphrase-env of the encrypted-local type",
 	)
 }
 
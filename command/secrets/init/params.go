@@ -15,12 +15,17 @@ const (
 	blsFlag     = "bls"
 	networkFlag = "network"
 	numFlag     = "num"
+
+	encryptedFlag      = "encrypted"
+	passphraseFileFlag = "passphrase-file"
 )
 
 var (
 	errInvalidConfig   = errors.New("invalid secrets configuration")
 	errInvalidParams   = errors.New("no config file or data directory passed in")
 	errUnsupportedType = errors.New("unsupported secrets manager")
+
+	errPassphraseFileNotEncrypted = errors.New("passphrase file passed in without the encrypted flag")
 )
 
 type initParams struct {
@@ -29,9 +34,20 @@ type initParams struct {
 	generatesECDSA   bool
 	generatesBLS     bool
 	generatesNetwork bool
+	encrypted        bool
+	passphraseFile   string
 
 	secretsManager secrets.SecretsManager
 	secretsConfig  *secrets.SecretsManagerConfig
+
+	// migrated are the plaintext secrets encrypted by the secrets manager
+	migrated []string
+}
+
+// secretsMigrator is implemented by the secrets managers
+// able to migrate the secrets of the local FS secrets manager
+type secretsMigrator interface {
+	Migrate() ([]string, error)
 }
 
 func (ip *initParams) validateFlags() error {
@@ -39,6 +55,10 @@ func (ip *initParams) validateFlags() error {
 		return errInvalidParams
 	}
 
+	if ip.passphraseFile != "" && !ip.encrypted {
+		return errPassphraseFileNotEncrypted
+	}
+
 	return nil
 }
 
@@ -47,6 +67,10 @@ func (ip *initParams) initSecrets() error {
 		return err
 	}
 
+	if err := ip.migrateSecrets(); err != nil {
+		return err
+	}
+
 	if err := ip.initValidatorKey(); err != nil {
 		return err
 	}
@@ -89,7 +113,17 @@ func (ip *initParams) parseConfig() error {
 }
 
 func (ip *initParams) initLocalSecretsManager() error {
-	local, err := helper.SetupLocalSecretsManager(ip.dataDir)
+	var (
+		local secrets.SecretsManager
+		err   error
+	)
+
+	if ip.encrypted {
+		local, err = helper.SetupEncryptedLocalSecretsManager(ip.dataDir, ip.passphraseFile)
+	} else {
+		local, err = helper.SetupLocalSecretsManager(ip.dataDir)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -99,16 +133,41 @@ func (ip *initParams) initLocalSecretsManager() error {
 	return nil
 }
 
+// migrateSecrets encrypts the plaintext secrets found by an encrypted secrets manager,
+// the migrated secrets are not generated again
+func (ip *initParams) migrateSecrets() error {
+	migrator, ok := ip.secretsManager.(secretsMigrator)
+	if !ok {
+		return nil
+	}
+
+	var err error
+
+	ip.migrated, err = migrator.Migrate()
+
+	return err
+}
+
+func (ip *initParams) isMigrated(name string) bool {
+	for _, migrated := range ip.migrated {
+		if migrated == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (ip *initParams) initValidatorKey() error {
 	var err error
 
-	if ip.generatesECDSA {
+	if ip.generatesECDSA && !ip.isMigrated(secrets.ValidatorKey) {
 		if _, err = helper.InitECDSAValidatorKey(ip.secretsManager); err != nil {
 			return err
 		}
 	}
 
-	if ip.generatesBLS {
+	if ip.generatesBLS && !ip.isMigrated(secrets.ValidatorBLSKey) {
 		if _, err = helper.InitBLSValidatorKey(ip.secretsManager); err != nil {
 			return err
 		}
@@ -118,7 +177,7 @@ func (ip *initParams) initValidatorKey() error {
 }
 
 func (ip *initParams) initNetworkingKey() error {
-	if ip.generatesNetwork {
+	if ip.generatesNetwork && !ip.isMigrated(secrets.NetworkKey) {
 		if _, err := helper.InitNetworkingPrivateKey(ip.secretsManager); err != nil {
 			return err
 		}
@@ -130,7 +189,9 @@ func (ip *initParams) initNetworkingKey() error {
 // getResult gets keys from secret manager and return result to display
 func (ip *initParams) getResult() (command.CommandResult, error) {
 	var (
-		res = &SecretsInitResult{}
+		res = &SecretsInitResult{
+			Migrated: ip.migrated,
+		}
 		err error
 	)
 
@@ -3,6 +3,7 @@ package init
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/LaChain/polygon-edge/command"
 
@@ -26,6 +27,7 @@ type SecretsInitResult struct {
 	Address   types.Address `json:"address"`
 	BLSPubkey string        `json:"bls_pubkey"`
 	NodeID    string        `json:"node_id"`
+	Migrated  []string      `json:"migrated,omitempty"`
 }
 
 func (r *SecretsInitResult) GetOutput() string {
@@ -47,6 +49,13 @@ func (r *SecretsInitResult) GetOutput() string {
 
 	vals = append(vals, fmt.Sprintf("Node ID|%s", r.NodeID))
 
+	if len(r.Migrated) > 0 {
+		vals = append(
+			vals,
+			fmt.Sprintf("Encrypted plaintext secrets|%s", strings.Join(r.Migrated, ", ")),
+		)
+	}
+
 	buffer.WriteString("\n[SECRETS INIT]\n")
 	buffer.WriteString(helper.FormatKV(vals))
 	buffer.WriteString("\n")
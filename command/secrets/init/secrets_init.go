@@ -64,6 +64,26 @@ func setFlags(cmd *cobra.Command) {
 	// num flag should be used with data-dir flag only so it should not be used with config flag.
 	cmd.MarkFlagsMutuallyExclusive(numFlag, configFlag)
 
+	cmd.Flags().BoolVar(
+		&basicParams.encrypted,
+		encryptedFlag,
+		false,
+		"the flag indicating whether the secrets in the local FS are encrypted with a passphrase, "+
+			"existing plaintext secrets are encrypted",
+	)
+
+	cmd.Flags().StringVar(
+		&basicParams.passphraseFile,
+		passphraseFileFlag,
+		"",
+		"the file holding the passphrase of the encrypted secrets, "+
+			"if omitted, it is read from the environment or prompted for",
+	)
+
+	// encrypted and passphrase-file flags are about the local FS only
+	cmd.MarkFlagsMutuallyExclusive(encryptedFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passphraseFileFlag, configFlag)
+
 	cmd.Flags().BoolVar(
 		&basicParams.generatesECDSA,
 		ecdsaFlag,
@@ -135,6 +155,8 @@ func newParamsList(params initParams, num int) []initParams {
 			generatesECDSA:   params.generatesECDSA,
 			generatesBLS:     params.generatesBLS,
 			generatesNetwork: params.generatesNetwork,
+			encrypted:        params.encrypted,
+			passphraseFile:   params.passphraseFile,
 		}
 	}
 
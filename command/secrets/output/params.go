@@ -19,6 +19,9 @@ const (
 	validatorFlag = "validator"
 	blsFlag       = "bls"
 	nodeIDFlag    = "node-id"
+
+	encryptedFlag      = "encrypted"
+	passphraseFileFlag = "passphrase-file"
 )
 
 var (
@@ -29,11 +32,15 @@ var (
 	errInvalidConfig   = errors.New("invalid secrets configuration")
 	errInvalidParams   = errors.New("no config file or data directory passed in")
 	errUnsupportedType = errors.New("unsupported secrets manager")
+
+	errPassphraseFileNotEncrypted = errors.New("passphrase file passed in without the encrypted flag")
 )
 
 type outputParams struct {
-	dataDir    string
-	configPath string
+	dataDir        string
+	configPath     string
+	encrypted      bool
+	passphraseFile string
 
 	outputNodeID    bool
 	outputValidator bool
@@ -53,6 +60,10 @@ func (op *outputParams) validateFlags() error {
 		return errInvalidParams
 	}
 
+	if op.passphraseFile != "" && !op.encrypted {
+		return errPassphraseFileNotEncrypted
+	}
+
 	return nil
 }
 
@@ -134,7 +145,17 @@ func (op *outputParams) initLocalSecretsManager() error {
 		return fmt.Errorf(strings.Join(errs, "\n"))
 	}
 
-	local, err := helper.SetupLocalSecretsManager(op.dataDir)
+	var (
+		local secrets.SecretsManager
+		err   error
+	)
+
+	if op.encrypted {
+		local, err = helper.SetupEncryptedLocalSecretsManager(op.dataDir, op.passphraseFile)
+	} else {
+		local, err = helper.SetupLocalSecretsManager(op.dataDir)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -34,6 +34,21 @@ func setFlags(cmd *cobra.Command) {
 			"if omitted, the local FS secrets manager is used",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.encrypted,
+		encryptedFlag,
+		false,
+		"the flag indicating whether the secrets in the local FS are encrypted with a passphrase",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passphraseFile,
+		passphraseFileFlag,
+		"",
+		"the file holding the passphrase of the encrypted secrets, "+
+			"if omitted, it is read from the environment or prompted for",
+	)
+
 	cmd.Flags().BoolVar(
 		&params.outputBLS,
 		blsFlag,
@@ -59,6 +74,8 @@ func setFlags(cmd *cobra.Command) {
 	)
 
 	cmd.MarkFlagsMutuallyExclusive(dataDirFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(encryptedFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passphraseFileFlag, configFlag)
 	cmd.MarkFlagsMutuallyExclusive(nodeIDFlag, validatorFlag, blsFlag)
 }
 
@@ -25,12 +25,19 @@ type Config struct {
 	TxPool                   *TxPool    `json:"tx_pool" yaml:"tx_pool"`
 	LogLevel                 string     `json:"log_level" yaml:"log_level"`
 	RestoreFile              string     `json:"restore_file" yaml:"restore_file"`
+	StateSnapshotFile        string     `json:"state_snapshot_file" yaml:"state_snapshot_file"`
+	StateSnapshotHash        string     `json:"state_snapshot_hash" yaml:"state_snapshot_hash"`
 	BlockTime                uint64     `json:"block_time_s" yaml:"block_time_s"`
 	Headers                  *Headers   `json:"headers" yaml:"headers"`
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCGasPriceBlocks    uint64     `json:"json_rpc_gas_price_blocks" yaml:"json_rpc_gas_price_blocks"`
+	JSONRPCGasPricePercent   uint64     `json:"json_rpc_gas_price_percentile" yaml:"json_rpc_gas_price_percentile"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
+	StateRetention           uint64     `json:"state_retention" yaml:"state_retention"`
+	SyncMode                 string     `json:"sync_mode" yaml:"sync_mode"`
+	DBEngine                 string     `json:"db_engine" yaml:"db_engine"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -54,6 +61,7 @@ type TxPool struct {
 	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
 	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
 	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	PriceBump          uint64 `json:"price_bump" yaml:"price_bump"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -75,6 +83,20 @@ const (
 	// DefaultJSONRPCBlockRangeLimit maximum block range allowed for json_rpc
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
+
+	// DefaultJSONRPCGasPriceBlocks number of recent blocks sampled
+	// by the gas price oracle of eth_gasPrice and eth_maxPriorityFeePerGas
+	DefaultJSONRPCGasPriceBlocks uint64 = 20
+
+	// DefaultJSONRPCGasPricePercentile percentile of the tips paid in the sampled blocks
+	// suggested by the gas price oracle
+	DefaultJSONRPCGasPricePercentile uint64 = 60
+
+	// DefaultSyncMode is the way the node catches up with the chain, by executing all the blocks
+	DefaultSyncMode = "full"
+
+	// DefaultDBEngine is the database engine the blockchain and the state are stored with
+	DefaultDBEngine = "leveldb"
 )
 
 // DefaultConfig returns the default server configuration
@@ -101,16 +123,23 @@ func DefaultConfig() *Config {
 			PriceLimit:         0,
 			MaxSlots:           4096,
 			MaxAccountEnqueued: 128,
+			PriceBump:          10,
 		},
-		LogLevel:    "INFO",
-		RestoreFile: "",
-		BlockTime:   DefaultBlockTime,
+		LogLevel:          "INFO",
+		RestoreFile:       "",
+		StateSnapshotFile: "",
+		StateSnapshotHash: "",
+		BlockTime:         DefaultBlockTime,
 		Headers: &Headers{
 			AccessControlAllowOrigins: []string{"*"},
 		},
 		LogFilePath:              "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		JSONRPCGasPriceBlocks:    DefaultJSONRPCGasPriceBlocks,
+		JSONRPCGasPricePercent:   DefaultJSONRPCGasPricePercentile,
+		SyncMode:                 DefaultSyncMode,
+		DBEngine:                 DefaultDBEngine,
 	}
 }
 
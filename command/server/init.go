@@ -12,15 +12,21 @@ import (
 
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/command/helper"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
+	"github.com/LaChain/polygon-edge/helper/hex"
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/server"
+	"github.com/LaChain/polygon-edge/syncer"
 	"github.com/LaChain/polygon-edge/types"
 )
 
 var (
 	errInvalidBlockTime       = errors.New("invalid block time specified")
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errInvalidGasPricePercent = errors.New("invalid gas price percentile specified")
+	errNoStateSnapshotHash    = errors.New("the hash of the anchor block of the state snapshot is not specified")
+	errInvalidStateSnapshot   = errors.New("invalid hash of the anchor block of the state snapshot")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -54,6 +60,22 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initGasPricePercentile(); err != nil {
+		return err
+	}
+
+	if err := p.initSyncMode(); err != nil {
+		return err
+	}
+
+	if err := p.initDBEngine(); err != nil {
+		return err
+	}
+
+	if err := p.initStateSnapshotHash(); err != nil {
+		return err
+	}
+
 	if p.isDevMode {
 		p.initDevMode()
 	}
@@ -72,6 +94,55 @@ func (p *serverParams) initBlockTime() error {
 	return nil
 }
 
+func (p *serverParams) initGasPricePercentile() error {
+	if p.rawConfig.JSONRPCGasPricePercent > 100 {
+		return errInvalidGasPricePercent
+	}
+
+	return nil
+}
+
+func (p *serverParams) initSyncMode() error {
+	var parseErr error
+
+	if p.syncMode, parseErr = syncer.ParseSyncMode(p.rawConfig.SyncMode); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
+func (p *serverParams) initDBEngine() error {
+	var parseErr error
+
+	if p.dbEngine, parseErr = dbengine.Parse(p.rawConfig.DBEngine); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
+// initStateSnapshotHash parses the trusted hash of the anchor block,
+// which the state snapshot can't be imported without
+func (p *serverParams) initStateSnapshotHash() error {
+	if p.rawConfig.StateSnapshotFile == "" {
+		return nil
+	}
+
+	if p.rawConfig.StateSnapshotHash == "" {
+		return errNoStateSnapshotHash
+	}
+
+	hash, err := hex.DecodeHex(p.rawConfig.StateSnapshotHash)
+	if err != nil || len(hash) != types.HashLength {
+		return fmt.Errorf("%w: %s", errInvalidStateSnapshot, p.rawConfig.StateSnapshotHash)
+	}
+
+	p.stateSnapshotHash = types.BytesToHash(hash)
+
+	return nil
+}
+
 func (p *serverParams) initDataDirLocation() error {
 	if p.rawConfig.DataDir == "" {
 		return errDataDirectoryUndefined
@@ -6,9 +6,12 @@ import (
 
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/command/server/config"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/server"
+	"github.com/LaChain/polygon-edge/syncer"
+	"github.com/LaChain/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/multiformats/go-multiaddr"
 )
@@ -28,16 +31,24 @@ const (
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCGasPriceBlocksFlag    = "json-rpc-gas-price-blocks"
+	jsonRPCGasPricePercentFlag   = "json-rpc-gas-price-percentile"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	priceBumpFlag                = "price-bump"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
 	restoreFlag                  = "restore"
+	stateSnapshotFlag            = "state-snapshot"
+	stateSnapshotHashFlag        = "state-snapshot-hash"
 	blockTimeFlag                = "block-time"
 	devIntervalFlag              = "dev-interval"
 	devFlag                      = "dev"
 	corsOriginFlag               = "access-control-allow-origins"
 	logFileLocationFlag          = "log-to"
+	pruneFlag                    = "prune"
+	syncModeFlag                 = "sync-mode"
+	dbEngineFlag                 = "db-engine"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -87,6 +98,11 @@ type serverParams struct {
 	secretsConfig *secrets.SecretsManagerConfig
 
 	logFileLocation string
+
+	syncMode syncer.SyncMode
+	dbEngine dbengine.Engine
+
+	stateSnapshotHash types.Hash
 }
 
 func (p *serverParams) isMaxPeersSet() bool {
@@ -130,6 +146,14 @@ func (p *serverParams) getRestoreFilePath() *string {
 	return nil
 }
 
+func (p *serverParams) getStateSnapshotFilePath() *string {
+	if p.rawConfig.StateSnapshotFile != "" {
+		return &p.rawConfig.StateSnapshotFile
+	}
+
+	return nil
+}
+
 func (p *serverParams) setRawGRPCAddress(grpcAddress string) {
 	p.rawConfig.GRPCAddr = grpcAddress
 }
@@ -150,6 +174,8 @@ func (p *serverParams) generateConfig() *server.Config {
 			AccessControlAllowOrigin: p.corsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			GasPriceBlocks:           p.rawConfig.JSONRPCGasPriceBlocks,
+			GasPricePercentile:       p.rawConfig.JSONRPCGasPricePercent,
 		},
 		GRPCAddr:   p.grpcAddress,
 		LibP2PAddr: p.libp2pAddress,
@@ -172,11 +198,17 @@ func (p *serverParams) generateConfig() *server.Config {
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
+		PriceBump:          p.rawConfig.TxPool.PriceBump,
 		SecretsManager:     p.secretsConfig,
 		RestoreFile:        p.getRestoreFilePath(),
+		StateSnapshotFile:  p.getStateSnapshotFilePath(),
+		StateSnapshotHash:  p.stateSnapshotHash,
 		BlockTime:          p.rawConfig.BlockTime,
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
 		JSONLogFormat:      p.rawConfig.JSONLogFormat,
 		LogFilePath:        p.logFileLocation,
+		StateRetention:     p.rawConfig.StateRetention,
+		SyncMode:           p.syncMode,
+		DBEngine:           p.dbEngine,
 	}
 }
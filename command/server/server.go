@@ -107,7 +107,8 @@ func setFlags(cmd *cobra.Command) {
 		&params.rawConfig.SecretsConfigPath,
 		secretsConfigFlag,
 		"",
-		"the path to the SecretsManager config file. Used for Hashicorp Vault. "+
+		"the path to the SecretsManager config file. Used for Hashicorp Vault, "+
+			"or for a remote signer holding the validator keys (remote-signer-* extra fields). "+
 			"If omitted, the local FS secrets manager is used",
 	)
 
@@ -115,7 +116,23 @@ func setFlags(cmd *cobra.Command) {
 		&params.rawConfig.RestoreFile,
 		restoreFlag,
 		"",
-		"the path to the archive blockchain data to restore on initialization",
+		"the comma separated paths to the archive blockchain data to restore in order on initialization",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.StateSnapshotFile,
+		stateSnapshotFlag,
+		"",
+		"the path to the state snapshot to bootstrap the node from on initialization, "+
+			"ignored if the chain already has blocks",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.StateSnapshotHash,
+		stateSnapshotHashFlag,
+		"",
+		"the hash of the anchor block of the state snapshot, trusted to be the canonical block at its height. "+
+			"Required with the state snapshot",
 	)
 
 	cmd.Flags().BoolVar(
@@ -185,6 +202,13 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PriceBump,
+		priceBumpFlag,
+		defaultConfig.TxPool.PriceBump,
+		"minimum price bump percentage to replace a transaction with the same nonce in the pool",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.BlockTime,
 		blockTimeFlag,
@@ -214,6 +238,21 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCGasPriceBlocks,
+		jsonRPCGasPriceBlocksFlag,
+		defaultConfig.JSONRPCGasPriceBlocks,
+		"number of recent blocks sampled when suggesting gas prices (eth_gasPrice, eth_maxPriorityFeePerGas)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCGasPricePercent,
+		jsonRPCGasPricePercentFlag,
+		defaultConfig.JSONRPCGasPricePercent,
+		"percentile of the tips paid in the sampled blocks used as suggested tip, "+
+			"suggested gas prices are never lower than the price limit",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -221,6 +260,31 @@ func setFlags(cmd *cobra.Command) {
 		"write all logs to the file at specified location instead of writing them to console",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.StateRetention,
+		pruneFlag,
+		defaultConfig.StateRetention,
+		"number of recent blocks whose state is kept, older state is pruned. "+
+			"Value of 0 keeps the state of all the blocks",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.SyncMode,
+		syncModeFlag,
+		defaultConfig.SyncMode,
+		"the way the node catches up with the chain: \"full\" executes all the blocks, "+
+			"\"snap\" downloads the state of a recent block from the peers first when the chain is empty, "+
+			"\"light\" verifies only the headers and fetches the rest from the peers on demand",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.DBEngine,
+		dbEngineFlag,
+		defaultConfig.DBEngine,
+		"the database engine the blockchain and the state are stored with: \"leveldb\", \"pebble\" or \"badger\". "+
+			"Use the db migrate command to convert an existing data directory",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
@@ -0,0 +1,125 @@
+package prune
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	dataDirFlag   = "data-dir"
+	retentionFlag = "retention"
+)
+
+const (
+	defaultRetention uint64 = 128
+)
+
+var (
+	params = &pruneParams{}
+)
+
+var (
+	errInvalidRetention = errors.New("retention must be greater than 0")
+	errHeadNotFound     = errors.New("head block not found, is the data directory initialized?")
+)
+
+type pruneParams struct {
+	dataDir   string
+	retention uint64
+
+	head    uint64
+	deleted int
+}
+
+func (p *pruneParams) validateFlags() error {
+	if p.retention == 0 {
+		return errInvalidRetention
+	}
+
+	return nil
+}
+
+func (p *pruneParams) pruneState() error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "prune",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	roots, err := p.readRecentStateRoots(logger)
+	if err != nil {
+		return err
+	}
+
+	stateStorage, err := dbengine.OpenExistingStateStorage(filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("unable to open the state storage: %w", err)
+	}
+
+	defer stateStorage.Close()
+
+	if p.deleted, err = itrie.NewState(stateStorage).Prune(roots); err != nil {
+		return fmt.Errorf("unable to prune the state: %w", err)
+	}
+
+	return nil
+}
+
+// readRecentStateRoots reads the state roots of the last blocks of the canonical chain
+func (p *pruneParams) readRecentStateRoots(logger hclog.Logger) ([]types.Hash, error) {
+	db, err := dbengine.OpenExistingBlockchainStorage(filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the blockchain storage: %w", err)
+	}
+
+	defer db.Close()
+
+	head, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, errHeadNotFound
+	}
+
+	p.head = head
+
+	roots := make([]types.Hash, 0, p.retention)
+
+	for i := uint64(0); i < p.retention && i <= head; i++ {
+		header, err := readCanonicalHeader(db, head-i)
+		if err != nil {
+			return nil, err
+		}
+
+		roots = append(roots, header.StateRoot)
+	}
+
+	return roots, nil
+}
+
+func readCanonicalHeader(db storage.Storage, number uint64) (*types.Header, error) {
+	hash, ok := db.ReadCanonicalHash(number)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of block %d not found", number)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header of block %d: %w", number, err)
+	}
+
+	return header, nil
+}
+
+func (p *pruneParams) getResult() command.CommandResult {
+	return &PruneResult{
+		Head:      p.head,
+		Retention: p.retention,
+		Deleted:   p.deleted,
+	}
+}
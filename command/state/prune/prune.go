@@ -0,0 +1,54 @@
+package prune
+
+import (
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	pruneCmd := &cobra.Command{
+		Use:     "prune",
+		Short:   "Removes the state of the old blocks from the data directory of a stopped node",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(pruneCmd)
+
+	return pruneCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.retention,
+		retentionFlag,
+		defaultRetention,
+		"number of recent blocks whose state is kept",
+	)
+
+	_ = cmd.MarkFlagRequired(dataDirFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.pruneState(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,28 @@
+package prune
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/command/helper"
+)
+
+type PruneResult struct {
+	Head      uint64 `json:"head"`
+	Retention uint64 `json:"retention"`
+	Deleted   int    `json:"deleted"`
+}
+
+func (r *PruneResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[STATE PRUNE]\n")
+	buffer.WriteString("Pruned the state successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Head block|%d", r.Head),
+		fmt.Sprintf("Retained blocks|%d", r.Retention),
+		fmt.Sprintf("Deleted nodes|%d", r.Deleted),
+	}))
+
+	return buffer.String()
+}
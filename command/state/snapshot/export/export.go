@@ -0,0 +1,67 @@
+package export
+
+import (
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/LaChain/polygon-edge/consensus/ibft"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use: "export",
+		Short: "Exports the state at a block from the data directory of a stopped node into a file, " +
+			"which can be imported by a new node on startup",
+		Run: runCommand,
+	}
+
+	setFlags(exportCmd)
+
+	return exportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		"",
+		"the path of the snapshot file",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.block,
+		blockFlag,
+		0,
+		"the block whose state is exported (default: the head block)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.epochSize,
+		epochSizeFlag,
+		ibft.DefaultEpochSize,
+		"the epoch size of the chain, used to export the state at the end of the previous epoch "+
+			"holding the validators. 0 to export the state at the block only",
+	)
+
+	_ = cmd.MarkFlagRequired(dataDirFlag)
+	_ = cmd.MarkFlagRequired(outFlag)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.exportSnapshot(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,104 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/LaChain/polygon-edge/archive"
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	dataDirFlag   = "data-dir"
+	outFlag       = "out"
+	blockFlag     = "block"
+	epochSizeFlag = "epoch-size"
+)
+
+var (
+	params = &exportParams{}
+)
+
+var (
+	errHeadNotFound  = errors.New("head block not found, is the data directory initialized?")
+	errGenesisExport = errors.New("the state of the genesis block can't be exported, start the node from the genesis instead")
+)
+
+type exportParams struct {
+	dataDir   string
+	out       string
+	block     uint64
+	epochSize uint64
+
+	metadata *archive.SnapshotMetadata
+	nodes    uint64
+	codes    uint64
+}
+
+func (p *exportParams) exportSnapshot() error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "snapshot",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	db, err := dbengine.OpenExistingBlockchainStorage(filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return fmt.Errorf("unable to open the blockchain storage: %w", err)
+	}
+
+	defer db.Close()
+
+	number := p.block
+	if number == 0 {
+		head, ok := db.ReadHeadNumber()
+		if !ok {
+			return errHeadNotFound
+		}
+
+		number = head
+	}
+
+	if number == 0 {
+		return errGenesisExport
+	}
+
+	stateStorage, err := dbengine.OpenExistingStateStorage(filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("unable to open the state storage: %w", err)
+	}
+
+	defer stateStorage.Close()
+
+	p.metadata, p.nodes, p.codes, err = archive.ExportStateSnapshot(
+		db,
+		stateStorage,
+		logger,
+		number,
+		p.epochSize,
+		p.out,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export the state snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (p *exportParams) getResult() command.CommandResult {
+	roots := make([]string, len(p.metadata.StateRoots))
+	for i, root := range p.metadata.StateRoots {
+		roots[i] = root.String()
+	}
+
+	return &ExportResult{
+		Number:     p.metadata.Number,
+		Hash:       p.metadata.Hash.String(),
+		StateRoots: roots,
+		Nodes:      p.nodes,
+		Codes:      p.codes,
+		Out:        p.out,
+	}
+}
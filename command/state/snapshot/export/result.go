@@ -0,0 +1,35 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/LaChain/polygon-edge/command/helper"
+)
+
+type ExportResult struct {
+	Number     uint64   `json:"number"`
+	Hash       string   `json:"hash"`
+	StateRoots []string `json:"stateRoots"`
+	Nodes      uint64   `json:"nodes"`
+	Codes      uint64   `json:"codes"`
+	Out        string   `json:"out"`
+}
+
+func (r *ExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[STATE SNAPSHOT EXPORT]\n")
+	buffer.WriteString("Exported the state snapshot successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block|%d", r.Number),
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("State roots|%s", strings.Join(r.StateRoots, ", ")),
+		fmt.Sprintf("Trie nodes|%d", r.Nodes),
+		fmt.Sprintf("Contract codes|%d", r.Codes),
+		fmt.Sprintf("File|%s", r.Out),
+	}))
+
+	return buffer.String()
+}
@@ -0,0 +1,23 @@
+package snapshot
+
+import (
+	"github.com/LaChain/polygon-edge/command/state/snapshot/export"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Top level command for the state snapshots used to bootstrap new nodes. Only accepts subcommands.",
+	}
+
+	registerSubcommands(snapshotCmd)
+
+	return snapshotCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		export.GetCommand(),
+	)
+}
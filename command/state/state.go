@@ -0,0 +1,25 @@
+package state
+
+import (
+	"github.com/LaChain/polygon-edge/command/state/prune"
+	"github.com/LaChain/polygon-edge/command/state/snapshot"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	stateCmd := &cobra.Command{
+		Use:   "state",
+		Short: "Top level command for managing the state of a stopped node. Only accepts subcommands.",
+	}
+
+	registerSubcommands(stateCmd)
+
+	return stateCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		prune.GetCommand(),
+		snapshot.GetCommand(),
+	)
+}
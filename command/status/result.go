@@ -12,6 +12,8 @@ type StatusResult struct {
 	CurrentBlockNumber int64  `json:"current_block_number"`
 	CurrentBlockHash   string `json:"current_block_hash"`
 	LibP2PAddress      string `json:"libp2p_address"`
+	Mode               string `json:"mode"`
+	OldestState        int64  `json:"oldest_state"`
 }
 
 func (r *StatusResult) GetOutput() string {
@@ -23,6 +25,8 @@ func (r *StatusResult) GetOutput() string {
 		fmt.Sprintf("Current Block Number (base 10)|%d", r.CurrentBlockNumber),
 		fmt.Sprintf("Current Block Hash|%s", r.CurrentBlockHash),
 		fmt.Sprintf("Libp2p Address|%s", r.LibP2PAddress),
+		fmt.Sprintf("Mode|%s", r.Mode),
+		fmt.Sprintf("Oldest Available State (base 10)|%d", r.OldestState),
 	}))
 
 	return buffer.String()
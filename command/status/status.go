@@ -40,6 +40,8 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		CurrentBlockNumber: statusResponse.Current.Number,
 		CurrentBlockHash:   statusResponse.Current.Hash,
 		LibP2PAddress:      statusResponse.P2PAddr,
+		Mode:               statusResponse.Mode,
+		OldestState:        statusResponse.OldestState,
 	})
 }
 
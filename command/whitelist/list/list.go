@@ -0,0 +1,92 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/spf13/cobra"
+)
+
+// GetCommands returns the commands updating each of the whitelists
+func GetCommands() []*cobra.Command {
+	commands := make([]*cobra.Command, len(whitelists))
+
+	for i, whitelist := range whitelists {
+		commands[i] = getCommand(whitelist)
+	}
+
+	return commands
+}
+
+func getCommand(whitelist *whitelist) *cobra.Command {
+	params := &listParams{
+		whitelist: whitelist,
+	}
+
+	listCmd := &cobra.Command{
+		Use:   whitelist.name,
+		Short: fmt.Sprintf("Updates the %s", whitelist.description),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			params.setForkBlock = cmd.Flags().Changed(forkBlockFlag)
+
+			return params.initRawParams()
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			runCommand(cmd, params)
+		},
+	}
+
+	setFlags(listCmd, params)
+
+	return listCmd
+}
+
+func setFlags(cmd *cobra.Command, params *listParams) {
+	cmd.Flags().StringVar(
+		&params.genesisPath,
+		chainFlag,
+		fmt.Sprintf("./%s", command.DefaultGenesisFileName),
+		"the genesis file to update",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.addAddressRaw,
+		addAddressFlag,
+		[]string{},
+		fmt.Sprintf("adds a new address to the %s", params.whitelist.description),
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.removeAddressRaw,
+		removeAddressFlag,
+		[]string{},
+		fmt.Sprintf("removes an address from the %s", params.whitelist.description),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.forkBlock,
+		forkBlockFlag,
+		0,
+		"the block from which the updated whitelists are active. "+
+			"If not set, the whitelists active from the genesis are updated",
+	)
+}
+
+func runCommand(cmd *cobra.Command, params *listParams) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.updateGenesisConfig(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.overrideGenesisConfig(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
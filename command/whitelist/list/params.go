@@ -0,0 +1,254 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/command"
+	"github.com/LaChain/polygon-edge/command/helper"
+	"github.com/LaChain/polygon-edge/helper/config"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	chainFlag         = "chain"
+	addAddressFlag    = "add"
+	removeAddressFlag = "remove"
+	forkBlockFlag     = "fork-block"
+)
+
+// whitelist is one of the address lists in the whitelists
+type whitelist struct {
+	name        string
+	description string
+
+	// addresses returns a pointer to the list in the whitelists
+	addresses func(w *chain.Whitelists) *[]types.Address
+}
+
+var whitelists = []*whitelist{
+	{
+		name:        "deployment",
+		description: "contract deployment whitelist",
+		addresses: func(w *chain.Whitelists) *[]types.Address {
+			return &w.Deployment
+		},
+	},
+	{
+		name:        "sender",
+		description: "transaction sender whitelist",
+		addresses: func(w *chain.Whitelists) *[]types.Address {
+			return &w.Sender
+		},
+	},
+	{
+		name:        "denylist",
+		description: "denylist of senders and recipients",
+		addresses: func(w *chain.Whitelists) *[]types.Address {
+			return &w.Denylist
+		},
+	},
+	{
+		name:        "call-target",
+		description: "call target whitelist",
+		addresses: func(w *chain.Whitelists) *[]types.Address {
+			return &w.CallTarget
+		},
+	},
+}
+
+type listParams struct {
+	whitelist *whitelist
+
+	// raw addresses, entered by CLI commands
+	addAddressRaw    []string
+	removeAddressRaw []string
+
+	// addresses, converted from raw addresses
+	addAddresses    []types.Address
+	removeAddresses []types.Address
+
+	// block of the whitelists fork to update, if set
+	forkBlock    uint64
+	setForkBlock bool
+
+	// genesis file
+	genesisPath   string
+	genesisConfig *chain.Chain
+
+	// updated list from genesis configuration
+	list []types.Address
+}
+
+func (p *listParams) initRawParams() error {
+	// convert raw addresses to appropriate format
+	p.initRawAddresses()
+
+	// init genesis configuration
+	if err := p.initChain(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *listParams) initRawAddresses() {
+	// convert addresses to be added from string to type.Address
+	p.addAddresses = unmarshallRawAddresses(p.addAddressRaw)
+
+	// convert addresses to be removed from string to type.Address
+	p.removeAddresses = unmarshallRawAddresses(p.removeAddressRaw)
+}
+
+func (p *listParams) initChain() error {
+	// import genesis configuration
+	cc, err := chain.Import(p.genesisPath)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to load chain config from %s: %w",
+			p.genesisPath,
+			err,
+		)
+	}
+
+	// set genesis configuration
+	p.genesisConfig = cc
+
+	return nil
+}
+
+func (p *listParams) updateGenesisConfig() error {
+	// Fetch whitelists from genesis config, if not init
+	whitelistConfig := config.GetWhitelist(p.genesisConfig)
+
+	if whitelistConfig == nil {
+		whitelistConfig = &chain.Whitelists{}
+	}
+
+	// Update the whitelists active from the fork block or from the genesis
+	updated := whitelistConfig
+	if p.setForkBlock {
+		updated = getOrAddFork(whitelistConfig, p.forkBlock)
+	}
+
+	list := p.whitelist.addresses(updated)
+	*list = updateAddresses(*list, p.addAddresses, p.removeAddresses)
+
+	p.genesisConfig.Params.Whitelists = whitelistConfig
+
+	// Save list for result
+	p.list = *list
+
+	return nil
+}
+
+func (p *listParams) overrideGenesisConfig() error {
+	// Remove the current genesis configuration from the disk
+	if err := os.Remove(p.genesisPath); err != nil {
+		return err
+	}
+
+	// Save the new genesis configuration
+	if err := helper.WriteGenesisConfigToDisk(
+		p.genesisConfig,
+		p.genesisPath,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *listParams) getResult() command.CommandResult {
+	result := &ListResult{
+		Description:     p.whitelist.description,
+		AddAddresses:    p.addAddresses,
+		RemoveAddresses: p.removeAddresses,
+		List:            p.list,
+	}
+
+	if p.setForkBlock {
+		result.ForkBlock = &p.forkBlock
+	}
+
+	return result
+}
+
+// getOrAddFork returns the whitelists of the fork at the given block.
+// If there is no such fork, it is added with a copy of the whitelists active at the block
+func getOrAddFork(w *chain.Whitelists, block uint64) *chain.Whitelists {
+	for _, fork := range w.Forks {
+		if fork.Block == block {
+			if fork.Whitelists == nil {
+				fork.Whitelists = &chain.Whitelists{}
+			}
+
+			return fork.Whitelists
+		}
+	}
+
+	active := &chain.Whitelists{}
+	if current := w.At(block); current != nil {
+		active = &chain.Whitelists{
+			Deployment: append([]types.Address{}, current.Deployment...),
+			Sender:     append([]types.Address{}, current.Sender...),
+			Denylist:   append([]types.Address{}, current.Denylist...),
+			CallTarget: append([]types.Address{}, current.CallTarget...),
+
+			InternalCreates: current.InternalCreates,
+		}
+	}
+
+	w.Forks = append(w.Forks, &chain.WhitelistsFork{
+		Block:      block,
+		Whitelists: active,
+	})
+
+	sort.Slice(w.Forks, func(i, j int) bool {
+		return w.Forks[i].Block < w.Forks[j].Block
+	})
+
+	return active
+}
+
+// updateAddresses returns the list with the added addresses appended
+// and the removed addresses dropped, keeping the order of the list
+func updateAddresses(list, add, remove []types.Address) []types.Address {
+	doesExist := map[types.Address]bool{}
+	updated := make([]types.Address, 0, len(list)+len(add))
+
+	for _, addresses := range [][]types.Address{list, add} {
+		for _, a := range addresses {
+			if !doesExist[a] {
+				doesExist[a] = true
+				updated = append(updated, a)
+			}
+		}
+	}
+
+	for _, a := range remove {
+		doesExist[a] = false
+	}
+
+	result := make([]types.Address, 0, len(updated))
+
+	for _, a := range updated {
+		if doesExist[a] {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
+
+func unmarshallRawAddresses(addresses []string) []types.Address {
+	marshalledAddresses := make([]types.Address, len(addresses))
+
+	for indx, address := range addresses {
+		marshalledAddresses[indx] = types.StringToAddress(address)
+	}
+
+	return marshalledAddresses
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/LaChain/polygon-edge/types"
+)
+
+type ListResult struct {
+	Description     string          `json:"-"`
+	ForkBlock       *uint64         `json:"forkBlock,omitempty"`
+	AddAddresses    []types.Address `json:"addAddress,omitempty"`
+	RemoveAddresses []types.Address `json:"removeAddress,omitempty"`
+	List            []types.Address `json:"whitelist"`
+}
+
+func (r *ListResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[%s]\n\n", strings.ToUpper(r.Description)))
+
+	if r.ForkBlock != nil {
+		buffer.WriteString(fmt.Sprintf("Fork block: %d,\n", *r.ForkBlock))
+	}
+
+	if len(r.AddAddresses) != 0 {
+		buffer.WriteString(fmt.Sprintf("Added addresses: %s,\n", r.AddAddresses))
+	}
+
+	if len(r.RemoveAddresses) != 0 {
+		buffer.WriteString(fmt.Sprintf("Removed addresses: %s,\n", r.RemoveAddresses))
+	}
+
+	buffer.WriteString(fmt.Sprintf("%s : %s,\n", capitalize(r.Description), r.List))
+
+	return buffer.String()
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/command"
 	"github.com/LaChain/polygon-edge/helper/config"
-	"github.com/LaChain/polygon-edge/types"
 )
 
 const (
@@ -21,12 +20,8 @@ type showParams struct {
 	// genesis file path
 	genesisPath string
 
-	// deployment whitelist
-	whitelists Whitelists
-}
-
-type Whitelists struct {
-	deployment []types.Address
+	// whitelists from genesis configuration
+	whitelists *chain.Whitelists
 }
 
 func (p *showParams) initRawParams() error {
@@ -49,15 +44,11 @@ func (p *showParams) initWhitelists() error {
 		)
 	}
 
-	// fetch whitelists
-	deploymentWhitelist, err := config.GetDeploymentWhitelist(genesisConfig)
-	if err != nil {
-		return err
-	}
+	// fetch whitelists, if not init
+	p.whitelists = config.GetWhitelist(genesisConfig)
 
-	// set whitelists
-	p.whitelists = Whitelists{
-		deployment: deploymentWhitelist,
+	if p.whitelists == nil {
+		p.whitelists = &chain.Whitelists{}
 	}
 
 	return nil
@@ -3,10 +3,12 @@ package show
 import (
 	"bytes"
 	"fmt"
+
+	"github.com/LaChain/polygon-edge/chain"
 )
 
 type ShowResult struct {
-	Whitelists Whitelists
+	Whitelists *chain.Whitelists `json:"whitelists"`
 }
 
 func (r *ShowResult) GetOutput() string {
@@ -14,7 +16,25 @@ func (r *ShowResult) GetOutput() string {
 
 	buffer.WriteString("\n[WHITELISTS]\n\n")
 
-	buffer.WriteString(fmt.Sprintf("Contract deployment whitelist : %s,\n", r.Whitelists.deployment))
+	writeWhitelists(&buffer, r.Whitelists)
+
+	for _, fork := range r.Whitelists.Forks {
+		buffer.WriteString(fmt.Sprintf("\n[WHITELISTS FROM BLOCK %d]\n\n", fork.Block))
+
+		writeWhitelists(&buffer, fork.Whitelists)
+	}
 
 	return buffer.String()
 }
+
+func writeWhitelists(buffer *bytes.Buffer, w *chain.Whitelists) {
+	if w == nil {
+		w = &chain.Whitelists{}
+	}
+
+	buffer.WriteString(fmt.Sprintf("Contract deployment whitelist : %s,\n", w.Deployment))
+	buffer.WriteString(fmt.Sprintf("Transaction sender whitelist : %s,\n", w.Sender))
+	buffer.WriteString(fmt.Sprintf("Denylist : %s,\n", w.Denylist))
+	buffer.WriteString(fmt.Sprintf("Call target whitelist : %s,\n", w.CallTarget))
+	buffer.WriteString(fmt.Sprintf("Applied to internal creates : %t,\n", w.InternalCreates))
+}
@@ -1,7 +1,7 @@
 package whitelist
 
 import (
-	"github.com/LaChain/polygon-edge/command/whitelist/deployment"
+	"github.com/LaChain/polygon-edge/command/whitelist/list"
 	"github.com/LaChain/polygon-edge/command/whitelist/show"
 	"github.com/spf13/cobra"
 )
@@ -18,8 +18,6 @@ func GetCommand() *cobra.Command {
 }
 
 func registerSubcommands(baseCmd *cobra.Command) {
-	baseCmd.AddCommand(
-		deployment.GetCommand(),
-		show.GetCommand(),
-	)
+	baseCmd.AddCommand(list.GetCommands()...)
+	baseCmd.AddCommand(show.GetCommand())
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/syncer"
 	"github.com/LaChain/polygon-edge/txpool"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -69,7 +71,10 @@ type Params struct {
 	Grpc           *grpc.Server
 	Logger         hclog.Logger
 	SecretsManager secrets.SecretsManager
+	SecretsConfig  *secrets.SecretsManagerConfig
 	BlockTime      uint64
+	SyncMode       syncer.SyncMode
+	StateStorage   itrie.Storage
 }
 
 // Factory is the factory function to create a discovery consensus
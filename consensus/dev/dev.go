@@ -109,10 +109,10 @@ type transitionInterface interface {
 	Write(txn *types.Transaction) error
 }
 
-func (d *Dev) writeTransactions(gasLimit uint64, transition transitionInterface) []*types.Transaction {
+func (d *Dev) writeTransactions(gasLimit, baseFee uint64, transition transitionInterface) []*types.Transaction {
 	var successful []*types.Transaction
 
-	d.txpool.Prepare()
+	d.txpool.Prepare(baseFee)
 
 	for {
 		tx := d.txpool.Peek()
@@ -169,6 +169,9 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 
 	header.GasLimit = gasLimit
 
+	// calculate base fee based on parent header (EIP-1559)
+	header.BaseFee = d.blockchain.CalculateBaseFee(parent)
+
 	miner, err := d.GetBlockCreator(header)
 	if err != nil {
 		return err
@@ -180,7 +183,7 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		return err
 	}
 
-	txns := d.writeTransactions(gasLimit, transition)
+	txns := d.writeTransactions(gasLimit, header.BaseFee, transition)
 
 	// Commit the changes
 	_, root := transition.Commit()
@@ -0,0 +1,154 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+)
+
+var (
+	ErrUntrustedCommittedSeals = errors.New("the committed seals aren't signed by enough trusted validators")
+)
+
+// VerifyAnchor verifies the anchor block of the snap sync, which is written to the empty chain
+// without the blocks before it, the way a light client does. Starting from the validators of the genesis,
+// the first block of the epoch of the anchor and then the anchor have to be committed by a quorum
+// of their own validators, more than a third of whom were validators of the last verified block,
+// so that at least one of the signers is honest. If too many validators changed in between,
+// the block in the middle is verified first.
+// The headers of the blocks in between are fetched with getHeader
+func (i *backendIBFT) VerifyAnchor(anchor *types.Header, getHeader func(uint64) (*types.Header, error)) error {
+	genesis, ok := i.blockchain.GetHeaderByNumber(0)
+	if !ok {
+		return errors.New("genesis header not found")
+	}
+
+	return i.verifyAnchor(genesis, anchor, getHeader)
+}
+
+// verifyAnchor verifies the anchor block against the trusted genesis
+func (i *backendIBFT) verifyAnchor(
+	genesis, anchor *types.Header,
+	getHeader func(uint64) (*types.Header, error),
+) error {
+	genesisSigner, err := i.forkManager.GetSigner(0)
+	if err != nil {
+		return err
+	}
+
+	trusted, err := genesisSigner.GetValidators(genesis)
+	if err != nil {
+		return err
+	}
+
+	last := genesis
+
+	// the validators in the extra of the first block of the epoch are trusted by the snapshot of the epoch
+	if epochBegin := (anchor.Number / i.epochSize) * i.epochSize; epochBegin != 0 && epochBegin != anchor.Number {
+		header, err := getHeader(epochBegin)
+		if err != nil {
+			return err
+		}
+
+		if trusted, err = i.verifyAnchorHeader(last, trusted, header, getHeader); err != nil {
+			return fmt.Errorf("unable to verify block %d: %w", epochBegin, err)
+		}
+
+		last = header
+	}
+
+	if _, err := i.verifyAnchorHeader(last, trusted, anchor, getHeader); err != nil {
+		return fmt.Errorf("unable to verify block %d: %w", anchor.Number, err)
+	}
+
+	return nil
+}
+
+// verifyAnchorHeader verifies the header against the validators of the last verified header,
+// bisecting the blocks in between until the validators overlap enough,
+// and returns the validators of the header
+func (i *backendIBFT) verifyAnchorHeader(
+	last *types.Header,
+	trusted validators.Validators,
+	header *types.Header,
+	getHeader func(uint64) (*types.Header, error),
+) (validators.Validators, error) {
+	if header.Number <= last.Number {
+		return nil, fmt.Errorf("block %d isn't after the verified block %d", header.Number, last.Number)
+	}
+
+	vals, err := i.verifyCommittedHeader(header, trusted)
+	if !errors.Is(err, ErrUntrustedCommittedSeals) || header.Number-last.Number < 2 {
+		return vals, err
+	}
+
+	middle, err := getHeader((last.Number + header.Number) / 2)
+	if err != nil {
+		return nil, err
+	}
+
+	if trusted, err = i.verifyAnchorHeader(last, trusted, middle, getHeader); err != nil {
+		return nil, err
+	}
+
+	return i.verifyAnchorHeader(middle, trusted, header, getHeader)
+}
+
+// verifyCommittedHeader verifies the seals of the finalized header against its own validators
+// and checks more than a third of the trusted validators committed it
+func (i *backendIBFT) verifyCommittedHeader(
+	header *types.Header,
+	trusted validators.Validators,
+) (validators.Validators, error) {
+	if header.MixHash != signer.IstanbulDigest {
+		return nil, ErrInvalidMixHash
+	}
+
+	if header.Sha3Uncles != types.EmptyUncleHash {
+		return nil, ErrInvalidSha3Uncles
+	}
+
+	if header.Difficulty != header.Number {
+		return nil, ErrWrongDifficulty
+	}
+
+	headerSigner, err := i.forkManager.GetSigner(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := headerSigner.GetValidators(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyProposerSeal(header, headerSigner, vals); err != nil {
+		return nil, err
+	}
+
+	if err := headerSigner.VerifyCommittedSeals(header, vals, i.quorumSize(header.Number)(vals)); err != nil {
+		return nil, err
+	}
+
+	signers, err := headerSigner.GetCommittedSealSigners(header, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedSigners := 0
+
+	for _, addr := range signers {
+		if trusted.Includes(addr) {
+			trustedSigners++
+		}
+	}
+
+	if 3*trustedSigners <= trusted.Len() {
+		return nil, ErrUntrustedCommittedSeals
+	}
+
+	return vals, nil
+}
@@ -0,0 +1,171 @@
+package ibft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIBFTBackend_verifyAnchor(t *testing.T) {
+	t.Parallel()
+
+	const epochSize = 10
+
+	pool := newTesterAccountPool(t)
+	pool.add("A", "B", "C", "D", "E", "F", "G", "H", "X", "Y", "Z", "W")
+
+	newSigner := func(name string) signer.Signer {
+		key := pool.get(name).priv
+
+		return signer.NewSigner(signer.NewECDSAKeyManagerFromKey(key), signer.NewECDSAKeyManagerFromKey(key))
+	}
+
+	// newHeader returns the header with the given validators, proposed by the first of them
+	// and committed by the signers
+	newHeader := func(t *testing.T, number uint64, vals []string, signers []string) *types.Header {
+		t.Helper()
+
+		set := validators.NewECDSAValidatorSet()
+		for _, name := range vals {
+			assert.NoError(t, set.Add(&validators.ECDSAValidator{Address: pool.get(name).Address()}))
+		}
+
+		header := &types.Header{
+			Number:     number,
+			Difficulty: number,
+			MixHash:    signer.IstanbulDigest,
+			Sha3Uncles: types.EmptyUncleHash,
+		}
+
+		proposer := newSigner(vals[0])
+		proposer.InitIBFTExtra(header, set, nil)
+
+		header, err := proposer.WriteProposerSeal(header)
+		assert.NoError(t, err)
+
+		header.Hash, err = proposer.CalculateHeaderHash(header)
+		assert.NoError(t, err)
+
+		// the genesis isn't committed
+		if len(signers) == 0 {
+			return header
+		}
+
+		seals := make(map[types.Address][]byte, len(signers))
+
+		for _, name := range signers {
+			seal, err := newSigner(name).CreateCommittedSeal(header.Hash.Bytes())
+			assert.NoError(t, err)
+
+			seals[pool.get(name).Address()] = seal
+		}
+
+		header, err = proposer.WriteCommittedSeals(header, seals)
+		assert.NoError(t, err)
+
+		return header
+	}
+
+	i := &backendIBFT{
+		epochSize: epochSize,
+		forkManager: &mockForkManager{
+			GetSignerFunc: func(uint64) (signer.Signer, error) {
+				return newSigner("A"), nil
+			},
+		},
+	}
+
+	genesisVals := []string{"A", "B", "C", "D"}
+	genesis := newHeader(t, 0, genesisVals, nil)
+
+	// verify verifies the anchor against the chain of the given headers and returns the fetched blocks
+	verify := func(anchor *types.Header, chain map[uint64]*types.Header) ([]uint64, error) {
+		fetched := []uint64{}
+
+		err := i.verifyAnchor(genesis, anchor, func(number uint64) (*types.Header, error) {
+			fetched = append(fetched, number)
+
+			header, ok := chain[number]
+			if !ok {
+				return nil, errors.New("header not found")
+			}
+
+			return header, nil
+		})
+
+		return fetched, err
+	}
+
+	t.Run("should verify the first block of the epoch and the anchor", func(t *testing.T) {
+		t.Parallel()
+
+		chain := map[uint64]*types.Header{
+			10: newHeader(t, 10, genesisVals, []string{"A", "B", "C"}),
+		}
+
+		fetched, err := verify(newHeader(t, 15, genesisVals, []string{"B", "C", "D"}), chain)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{10}, fetched)
+	})
+
+	t.Run("should verify the blocks in between if the validators changed", func(t *testing.T) {
+		t.Parallel()
+
+		rotatedVals := []string{"E", "F", "G", "H"}
+
+		chain := map[uint64]*types.Header{
+			5:  newHeader(t, 5, []string{"C", "D", "E", "F"}, []string{"C", "D", "E"}),
+			10: newHeader(t, 10, rotatedVals, []string{"E", "F", "G"}),
+		}
+
+		fetched, err := verify(newHeader(t, 15, rotatedVals, []string{"F", "G", "H"}), chain)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{10, 5}, fetched)
+	})
+
+	t.Run("should reject the anchor committed by untrusted validators", func(t *testing.T) {
+		t.Parallel()
+
+		chain := map[uint64]*types.Header{
+			10: newHeader(t, 10, genesisVals, []string{"A", "B", "C"}),
+			12: newHeader(t, 12, genesisVals, []string{"A", "B", "C"}),
+			13: newHeader(t, 13, genesisVals, []string{"A", "B", "C"}),
+			14: newHeader(t, 14, genesisVals, []string{"A", "B", "C"}),
+		}
+
+		forgedVals := []string{"X", "Y", "Z", "W"}
+
+		_, err := verify(newHeader(t, 15, forgedVals, forgedVals), chain)
+		assert.ErrorIs(t, err, ErrUntrustedCommittedSeals)
+	})
+
+	t.Run("should reject the anchor without a quorum of committed seals", func(t *testing.T) {
+		t.Parallel()
+
+		chain := map[uint64]*types.Header{
+			10: newHeader(t, 10, genesisVals, []string{"A", "B", "C"}),
+		}
+
+		_, err := verify(newHeader(t, 15, genesisVals, []string{"A", "B"}), chain)
+		assert.ErrorIs(t, err, signer.ErrNotEnoughCommittedSeals)
+	})
+
+	t.Run("should reject the forged first block of the epoch", func(t *testing.T) {
+		t.Parallel()
+
+		chain := map[uint64]*types.Header{
+			5:  newHeader(t, 5, genesisVals, []string{"A", "B", "C"}),
+			7:  newHeader(t, 7, genesisVals, []string{"A", "B", "C"}),
+			8:  newHeader(t, 8, genesisVals, []string{"A", "B", "C"}),
+			9:  newHeader(t, 9, genesisVals, []string{"A", "B", "C"}),
+			10: newHeader(t, 10, []string{"X", "Y", "Z", "W"}, []string{"X", "Y", "Z"}),
+		}
+
+		_, err := verify(newHeader(t, 15, []string{"X", "Y", "Z", "W"}, []string{"X", "Y", "Z"}), chain)
+		assert.ErrorIs(t, err, ErrUntrustedCommittedSeals)
+	})
+}
@@ -179,12 +179,15 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 
 	header.GasLimit = gasLimit
 
+	// calculate base fee based on parent header (EIP-1559)
+	header.BaseFee = i.blockchain.CalculateBaseFee(parent)
+
 	if err := i.currentHooks.ModifyHeader(header, i.currentSigner.Address()); err != nil {
 		return nil, err
 	}
 
 	// Set the header timestamp
-	potentialTimestamp := i.calcHeaderTimestamp(parent.Timestamp, time.Now())
+	potentialTimestamp := calcHeaderTimestamp(parent.Timestamp, time.Now(), i.getBlockTime(header.Number))
 	header.Timestamp = uint64(potentialTimestamp.Unix())
 
 	parentCommittedSeals, err := i.extractParentCommittedSeals(parent)
@@ -207,6 +210,7 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 		writeCtx,
 		gasLimit,
 		header.Number,
+		header.BaseFee,
 		transition,
 	)
 
@@ -244,10 +248,10 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 
 // calcHeaderTimestamp calculates the new block timestamp, based
 // on the block time and parent timestamp
-func (i *backendIBFT) calcHeaderTimestamp(parentUnix uint64, currentTime time.Time) time.Time {
+func calcHeaderTimestamp(parentUnix uint64, currentTime time.Time, blockTime time.Duration) time.Time {
 	var (
 		parentTimestamp    = time.Unix(int64(parentUnix), 0)
-		potentialTimestamp = parentTimestamp.Add(i.blockTime)
+		potentialTimestamp = parentTimestamp.Add(blockTime)
 	)
 
 	if potentialTimestamp.Before(currentTime) {
@@ -255,7 +259,7 @@ func (i *backendIBFT) calcHeaderTimestamp(parentUnix uint64, currentTime time.Ti
 		// has passed, round it to the nearest
 		// multiple of block time
 		// t........t+blockT...x (t+blockT.x; now).....t+blockT (potential)
-		potentialTimestamp = roundUpTime(currentTime, i.blockTime)
+		potentialTimestamp = roundUpTime(currentTime, blockTime)
 	}
 
 	return potentialTimestamp
@@ -288,7 +292,8 @@ type transitionInterface interface {
 func (i *backendIBFT) writeTransactions(
 	writeCtx context.Context,
 	gasLimit,
-	blockNumber uint64,
+	blockNumber,
+	baseFee uint64,
 	transition transitionInterface,
 ) (executed []*types.Transaction) {
 	executed = make([]*types.Transaction, 0)
@@ -313,7 +318,7 @@ func (i *backendIBFT) writeTransactions(
 		)
 	}()
 
-	i.txpool.Prepare()
+	i.txpool.Prepare(baseFee)
 
 write:
 	for {
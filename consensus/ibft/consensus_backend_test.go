@@ -45,16 +45,13 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			i := &backendIBFT{
-				blockTime: time.Duration(testCase.blockTime) * time.Second,
-			}
-
 			assert.Equal(
 				t,
 				testCase.expectedTimestamp.Unix(),
-				i.calcHeaderTimestamp(
+				calcHeaderTimestamp(
 					uint64(testCase.parentTimestamp),
 					testCase.currentTime,
+					time.Duration(testCase.blockTime)*time.Second,
 				).Unix(),
 			)
 		})
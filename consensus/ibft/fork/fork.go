@@ -3,8 +3,11 @@ package fork
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/LaChain/polygon-edge/helper/common"
+	"github.com/LaChain/polygon-edge/types"
 	"github.com/LaChain/polygon-edge/validators"
 )
 
@@ -16,7 +19,15 @@ const (
 )
 
 var (
-	ErrUndefinedIBFTConfig = errors.New("IBFT config is not defined")
+	ErrUndefinedIBFTConfig      = errors.New("IBFT config is not defined")
+	ErrInvalidFeeShares         = errors.New("the shares of the fees for the treasury and the burn exceed 100 percent")
+	ErrUndefinedTreasury        = errors.New("treasury is not defined for its share of the fees")
+	ErrInvalidBlockTime         = errors.New("block time must be greater than zero")
+	ErrInvalidGasLimit          = errors.New("block gas limit must be greater than zero")
+	ErrLivenessPolicyNotPoA     = errors.New("liveness policy is only supported by PoA")
+	ErrInvalidLivenessAction    = errors.New("invalid liveness action")
+	ErrStakeWeightedNotPoS      = errors.New("stake-weighted proposer selection is only supported by PoS")
+	ErrInvalidProposerSelection = errors.New("invalid proposer selection")
 )
 
 // IBFT Fork represents setting in params.engine.ibft of genesis.json
@@ -33,6 +44,23 @@ type IBFTFork struct {
 	// PoS
 	MaxValidatorCount *common.JSONNumber `json:"maxValidatorCount,omitempty"`
 	MinValidatorCount *common.JSONNumber `json:"minValidatorCount,omitempty"`
+
+	// Rewards
+	Rewards *Rewards `json:"rewards,omitempty"`
+
+	// Block production, the values of the node are used if they are not defined
+	// BlockTime is the minimum time between the blocks in seconds
+	BlockTime *common.JSONNumber `json:"blockTime,omitempty"`
+	// BlockGasLimit is the gas limit of every block
+	BlockGasLimit *common.JSONNumber `json:"blockGasLimit,omitempty"`
+	// RoundTimeout is added to the timeout of every round in seconds, it's the block time by default
+	RoundTimeout *common.JSONNumber `json:"roundTimeout,omitempty"`
+
+	// Liveness is the policy against the validators missing too many blocks, PoA only
+	Liveness *LivenessPolicy `json:"liveness,omitempty"`
+
+	// ProposerSelection is the way the proposers are selected, round robin by default
+	ProposerSelection ProposerSelection `json:"proposerSelection,omitempty"`
 }
 
 func (f *IBFTFork) UnmarshalJSON(data []byte) error {
@@ -45,18 +73,52 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 		Validators        interface{}               `json:"validators,omitempty"`
 		MaxValidatorCount *common.JSONNumber        `json:"maxValidatorCount,omitempty"`
 		MinValidatorCount *common.JSONNumber        `json:"minValidatorCount,omitempty"`
+		Rewards           *Rewards                  `json:"rewards,omitempty"`
+		BlockTime         *common.JSONNumber        `json:"blockTime,omitempty"`
+		BlockGasLimit     *common.JSONNumber        `json:"blockGasLimit,omitempty"`
+		RoundTimeout      *common.JSONNumber        `json:"roundTimeout,omitempty"`
+		Liveness          *LivenessPolicy           `json:"liveness,omitempty"`
+		ProposerSelection ProposerSelection         `json:"proposerSelection,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
 
+	if raw.BlockTime != nil && raw.BlockTime.Value == 0 {
+		return ErrInvalidBlockTime
+	}
+
+	if raw.BlockGasLimit != nil && raw.BlockGasLimit.Value == 0 {
+		return ErrInvalidGasLimit
+	}
+
+	if raw.Liveness != nil && raw.Type != PoA {
+		return ErrLivenessPolicyNotPoA
+	}
+
+	switch raw.ProposerSelection {
+	case "", RoundRobin:
+	case StakeWeighted:
+		if raw.Type != PoS {
+			return ErrStakeWeightedNotPoS
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidProposerSelection, raw.ProposerSelection)
+	}
+
 	f.Type = raw.Type
 	f.Deployment = raw.Deployment
 	f.From = raw.From
 	f.To = raw.To
 	f.MaxValidatorCount = raw.MaxValidatorCount
 	f.MinValidatorCount = raw.MinValidatorCount
+	f.Rewards = raw.Rewards
+	f.BlockTime = raw.BlockTime
+	f.BlockGasLimit = raw.BlockGasLimit
+	f.RoundTimeout = raw.RoundTimeout
+	f.Liveness = raw.Liveness
+	f.ProposerSelection = raw.ProposerSelection
 
 	f.ValidatorType = validators.ECDSAValidatorType
 	if raw.ValidatorType != nil {
@@ -81,6 +143,122 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Rewards represents the block rewards and the distribution of the fees in the fork
+type Rewards struct {
+	// BlockReward is minted for every block
+	BlockReward *big.Int
+	// Treasury receives TreasuryPercent of the fees of every block
+	Treasury        types.Address
+	TreasuryPercent uint64
+	// BurnPercent of the fees of every block are burned, the proposer keeps the rest
+	BurnPercent uint64
+	// ShareWithSigners splits the block reward between the validators
+	// who signed the parent block instead of paying it to the proposer
+	ShareWithSigners bool
+}
+
+type rewardsJSON struct {
+	BlockReward      *string       `json:"blockReward,omitempty"`
+	Treasury         types.Address `json:"treasury"`
+	TreasuryPercent  uint64        `json:"treasuryPercent"`
+	BurnPercent      uint64        `json:"burnPercent"`
+	ShareWithSigners bool          `json:"shareWithSigners"`
+}
+
+func (r *Rewards) MarshalJSON() ([]byte, error) {
+	raw := rewardsJSON{
+		Treasury:         r.Treasury,
+		TreasuryPercent:  r.TreasuryPercent,
+		BurnPercent:      r.BurnPercent,
+		ShareWithSigners: r.ShareWithSigners,
+	}
+
+	if r.BlockReward != nil {
+		raw.BlockReward = types.EncodeBigInt(r.BlockReward)
+	}
+
+	return json.Marshal(raw)
+}
+
+func (r *Rewards) UnmarshalJSON(data []byte) error {
+	var raw rewardsJSON
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	blockReward, err := types.ParseUint256orHex(raw.BlockReward)
+	if err != nil {
+		return fmt.Errorf("invalid block reward: %w", err)
+	}
+
+	if raw.TreasuryPercent+raw.BurnPercent > 100 {
+		return ErrInvalidFeeShares
+	}
+
+	if raw.TreasuryPercent > 0 && raw.Treasury == types.ZeroAddress {
+		return ErrUndefinedTreasury
+	}
+
+	r.BlockReward = blockReward
+	r.Treasury = raw.Treasury
+	r.TreasuryPercent = raw.TreasuryPercent
+	r.BurnPercent = raw.BurnPercent
+	r.ShareWithSigners = raw.ShareWithSigners
+
+	return nil
+}
+
+// LivenessAction is the action taken against the validators missing too many blocks
+type LivenessAction string
+
+const (
+	// LivenessVote makes the validators vote for the removal of the jailed validators
+	LivenessVote LivenessAction = "vote"
+	// LivenessSkip skips the proposer slots of the jailed validators
+	LivenessSkip LivenessAction = "skip"
+)
+
+// LivenessPolicy jails the validators whose missed proposals and committed seals
+// within an epoch exceed the threshold
+type LivenessPolicy struct {
+	Threshold uint64         `json:"threshold"`
+	Action    LivenessAction `json:"action"`
+}
+
+func (p *LivenessPolicy) UnmarshalJSON(data []byte) error {
+	type policy LivenessPolicy
+
+	var raw policy
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.Action != LivenessVote && raw.Action != LivenessSkip {
+		return fmt.Errorf("%w: %s", ErrInvalidLivenessAction, raw.Action)
+	}
+
+	*p = LivenessPolicy(raw)
+
+	return nil
+}
+
+// IsJailed returns whether the validator missed more blocks than the threshold
+func (p *LivenessPolicy) IsJailed(missed uint64) bool {
+	return missed > p.Threshold
+}
+
+// ProposerSelection is the way the proposer of each round is selected
+type ProposerSelection string
+
+const (
+	// RoundRobin gives every validator the same number of proposer slots
+	RoundRobin ProposerSelection = "roundRobin"
+	// StakeWeighted gives the validators proposer slots in proportion to their stakes
+	StakeWeighted ProposerSelection = "stakeWeighted"
+)
+
 // GetIBFTForks returns IBFT fork configurations from chain config
 func GetIBFTForks(ibftConfig map[string]interface{}) (IBFTForks, error) {
 	// no fork, only specifying IBFT type in chain config
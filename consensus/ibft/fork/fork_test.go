@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/helper/common"
@@ -90,6 +91,176 @@ func TestIBFTForkUnmarshalJSON(t *testing.T) {
 				MinValidatorCount: nil,
 			},
 		},
+		{
+			name: "should parse rewards",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"rewards": {
+					"blockReward": "0xde0b6b3a7640000",
+					"treasury": "%s",
+					"treasuryPercent": 20,
+					"burnPercent": 30,
+					"shareWithSigners": true
+				}
+			}`, PoA, 0, types.StringToAddress("1")),
+			expected: &IBFTFork{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 0},
+				Rewards: &Rewards{
+					BlockReward:      big.NewInt(1e18),
+					Treasury:         types.StringToAddress("1"),
+					TreasuryPercent:  20,
+					BurnPercent:      30,
+					ShareWithSigners: true,
+				},
+			},
+		},
+		{
+			name: "should return error if the shares of the fees exceed 100 percent",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"rewards": {
+					"treasury": "%s",
+					"treasuryPercent": 60,
+					"burnPercent": 50
+				}
+			}`, PoA, 0, types.StringToAddress("1")),
+			expected: &IBFTFork{},
+			err:      ErrInvalidFeeShares,
+		},
+		{
+			name: "should return error if the treasury is not defined",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"rewards": {
+					"treasuryPercent": 10
+				}
+			}`, PoA, 0),
+			expected: &IBFTFork{},
+			err:      ErrUndefinedTreasury,
+		},
+		{
+			name: "should parse block time, gas limit and round timeout",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"blockTime": %d,
+				"blockGasLimit": "%s",
+				"roundTimeout": %d
+			}`, PoA, 10, 5, "0x1c9c380", 15),
+			expected: &IBFTFork{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 10},
+				BlockTime:     &common.JSONNumber{Value: 5},
+				BlockGasLimit: &common.JSONNumber{Value: 30000000},
+				RoundTimeout:  &common.JSONNumber{Value: 15},
+			},
+		},
+		{
+			name: "should return error if the block time is zero",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"blockTime": %d
+			}`, PoA, 0, 0),
+			expected: &IBFTFork{},
+			err:      ErrInvalidBlockTime,
+		},
+		{
+			name: "should return error if the block gas limit is zero",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"blockGasLimit": %d
+			}`, PoA, 0, 0),
+			expected: &IBFTFork{},
+			err:      ErrInvalidGasLimit,
+		},
+		{
+			name: "should parse liveness policy",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"liveness": {
+					"threshold": %d,
+					"action": "%s"
+				}
+			}`, PoA, 0, 5, LivenessSkip),
+			expected: &IBFTFork{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 0},
+				Liveness: &LivenessPolicy{
+					Threshold: 5,
+					Action:    LivenessSkip,
+				},
+			},
+		},
+		{
+			name: "should return error if the liveness policy is defined in PoS",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"liveness": {
+					"threshold": %d,
+					"action": "%s"
+				}
+			}`, PoS, 0, 5, LivenessVote),
+			expected: &IBFTFork{},
+			err:      ErrLivenessPolicyNotPoA,
+		},
+		{
+			name: "should return error if the liveness action is invalid",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"liveness": {
+					"threshold": %d,
+					"action": "%s"
+				}
+			}`, PoA, 0, 5, "jail"),
+			expected: &IBFTFork{},
+			err:      ErrInvalidLivenessAction,
+		},
+		{
+			name: "should parse stake-weighted proposer selection",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"proposerSelection": "%s"
+			}`, PoS, 0, StakeWeighted),
+			expected: &IBFTFork{
+				Type:              PoS,
+				ValidatorType:     validators.ECDSAValidatorType,
+				From:              common.JSONNumber{Value: 0},
+				ProposerSelection: StakeWeighted,
+			},
+		},
+		{
+			name: "should return error if stake-weighted proposer selection is defined in PoA",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"proposerSelection": "%s"
+			}`, PoA, 0, StakeWeighted),
+			expected: &IBFTFork{},
+			err:      ErrStakeWeightedNotPoS,
+		},
+		{
+			name: "should return error if the proposer selection is invalid",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"proposerSelection": "%s"
+			}`, PoS, 0, "random"),
+			expected: &IBFTFork{},
+			err:      ErrInvalidProposerSelection,
+		},
 	}
 
 	for _, test := range tests {
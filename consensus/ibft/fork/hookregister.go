@@ -2,6 +2,7 @@ package fork
 
 import (
 	"github.com/LaChain/polygon-edge/consensus/ibft/hook"
+	"github.com/LaChain/polygon-edge/types"
 )
 
 // PoAHookRegisterer that registers hooks for PoA mode
@@ -100,3 +101,27 @@ func (r *PoSHookRegister) RegisterHooks(hooks *hook.Hooks, height uint64) {
 		registerStakingContractDeploymentHooks(hooks, deploymentFork)
 	}
 }
+
+// RewardHookRegister registers hooks for the block rewards and the fee distribution
+type RewardHookRegister struct {
+	forks            IBFTForks
+	getParentSigners func(*types.Header) ([]types.Address, error)
+}
+
+// NewRewardHookRegister is a constructor of RewardHookRegister
+func NewRewardHookRegister(
+	forks IBFTForks,
+	getParentSigners func(*types.Header) ([]types.Address, error),
+) *RewardHookRegister {
+	return &RewardHookRegister{
+		forks:            forks,
+		getParentSigners: getParentSigners,
+	}
+}
+
+// RegisterHooks registers hooks to pay the rewards of the fork in which the height is
+func (r *RewardHookRegister) RegisterHooks(hooks *hook.Hooks, height uint64) {
+	if currentFork := r.forks.getFork(height); currentFork != nil && currentFork.Rewards != nil {
+		registerRewardHooks(hooks, currentFork.Rewards, r.getParentSigners)
+	}
+}
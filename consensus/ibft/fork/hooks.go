@@ -2,6 +2,8 @@ package fork
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/LaChain/polygon-edge/consensus/ibft/hook"
 	"github.com/LaChain/polygon-edge/contracts/staking"
@@ -117,6 +119,83 @@ func registerStakingContractDeploymentHooks(
 	}
 }
 
+// registerRewardHooks registers hooks to pay the block reward and distribute the fees
+// after the other changes of the state
+func registerRewardHooks(
+	hooks *hook.Hooks,
+	rewards *Rewards,
+	getParentSigners func(*types.Header) ([]types.Address, error),
+) {
+	preCommitState := hooks.PreCommitStateFunc
+
+	hooks.PreCommitStateFunc = func(header *types.Header, txn *state.Transition) error {
+		if preCommitState != nil {
+			if err := preCommitState(header, txn); err != nil {
+				return err
+			}
+		}
+
+		var signers []types.Address
+
+		if rewards.ShareWithSigners {
+			var err error
+
+			if signers, err = getParentSigners(header); err != nil {
+				return err
+			}
+		}
+
+		return distributeRewards(rewards, txn, signers)
+	}
+}
+
+// distributeRewards moves the shares of the fees paid to the proposer to the treasury and the burn,
+// and pays the block reward to the signers or the proposer
+func distributeRewards(rewards *Rewards, txn *state.Transition, signers []types.Address) error {
+	var (
+		proposer = txn.GetTxContext().Coinbase
+		fees     = txn.CollectedFees()
+		percent  = big.NewInt(100)
+	)
+
+	treasuryFee := new(big.Int).Mul(fees, new(big.Int).SetUint64(rewards.TreasuryPercent))
+	treasuryFee.Div(treasuryFee, percent)
+
+	burntFee := new(big.Int).Mul(fees, new(big.Int).SetUint64(rewards.BurnPercent))
+	burntFee.Div(burntFee, percent)
+
+	if err := txn.Txn().SubBalance(proposer, new(big.Int).Add(treasuryFee, burntFee)); err != nil {
+		return fmt.Errorf("unable to take the shares of the fees from the proposer: %w", err)
+	}
+
+	if treasuryFee.Sign() > 0 {
+		txn.Txn().AddBalance(rewards.Treasury, treasuryFee)
+	}
+
+	if rewards.BlockReward == nil || rewards.BlockReward.Sign() == 0 {
+		return nil
+	}
+
+	if len(signers) == 0 {
+		txn.Txn().AddSealingReward(proposer, rewards.BlockReward)
+
+		return nil
+	}
+
+	// the proposer gets the remainder of the split
+	share, remainder := new(big.Int).DivMod(rewards.BlockReward, big.NewInt(int64(len(signers))), new(big.Int))
+
+	for _, signer := range signers {
+		txn.Txn().AddSealingReward(signer, share)
+	}
+
+	if remainder.Sign() > 0 {
+		txn.Txn().AddSealingReward(proposer, remainder)
+	}
+
+	return nil
+}
+
 // getPreDeployParams returns PredeployParams for Staking Contract from IBFTFork
 func getPreDeployParams(fork *IBFTFork) stakingHelper.PredeployParams {
 	params := stakingHelper.PredeployParams{
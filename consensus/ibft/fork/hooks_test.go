@@ -2,6 +2,7 @@ package fork
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/chain"
@@ -352,6 +353,151 @@ func Test_registerStakingContractDeploymentHooks(t *testing.T) {
 	)
 }
 
+func Test_registerRewardHooks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		proposer = types.StringToAddress("1")
+		treasury = types.StringToAddress("2")
+		signers  = []types.Address{
+			types.StringToAddress("3"),
+			types.StringToAddress("4"),
+			types.StringToAddress("5"),
+		}
+
+		gasPrice = big.NewInt(10)
+		// the fee of a transfer
+		fees = new(big.Int).Mul(gasPrice, big.NewInt(21000))
+	)
+
+	// newTransitionWithFees returns a transition in which a transfer paid the fees to the proposer
+	newTransitionWithFees := func(t *testing.T) *state.Transition {
+		t.Helper()
+
+		key, err := crypto.GenerateECDSAKey()
+		assert.NoError(t, err)
+
+		sender := crypto.PubKeyToAddress(&key.PublicKey)
+
+		ex := state.NewExecutor(&chain.Params{
+			Forks: chain.AllForksEnabled,
+		}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+		rootHash := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+			sender: {Balance: big.NewInt(1e18)},
+		})
+		ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+			return func(i uint64) types.Hash {
+				return rootHash
+			}
+		}
+
+		transition, err := ex.BeginTxn(rootHash, &types.Header{GasLimit: 1e6}, proposer)
+		assert.NoError(t, err)
+
+		tx, err := crypto.NewLondonSigner(0).SignTx(&types.Transaction{
+			To:       &types.ZeroAddress,
+			Value:    big.NewInt(1),
+			Gas:      21000,
+			GasPrice: gasPrice,
+		}, key)
+		assert.NoError(t, err)
+
+		assert.NoError(t, transition.Write(tx))
+		assert.Equal(t, fees, transition.CollectedFees())
+
+		return transition
+	}
+
+	getParentSigners := func(*types.Header) ([]types.Address, error) {
+		return signers, nil
+	}
+
+	t.Run("should split the fees between the proposer, the treasury and the burn", func(t *testing.T) {
+		t.Parallel()
+
+		hooks := &hook.Hooks{}
+		txn := newTransitionWithFees(t)
+
+		registerRewardHooks(hooks, &Rewards{
+			Treasury:        treasury,
+			TreasuryPercent: 20,
+			BurnPercent:     30,
+		}, getParentSigners)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+
+		assert.Equal(t, big.NewInt(105000), txn.GetBalance(proposer))
+		assert.Equal(t, big.NewInt(42000), txn.GetBalance(treasury))
+	})
+
+	t.Run("should pay the block reward to the proposer", func(t *testing.T) {
+		t.Parallel()
+
+		hooks := &hook.Hooks{}
+		txn := newTransitionWithFees(t)
+
+		registerRewardHooks(hooks, &Rewards{
+			BlockReward: big.NewInt(1000),
+		}, getParentSigners)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+
+		assert.Equal(t, new(big.Int).Add(fees, big.NewInt(1000)), txn.GetBalance(proposer))
+
+		for _, signer := range signers {
+			assert.Equal(t, big.NewInt(0), txn.GetBalance(signer))
+		}
+	})
+
+	t.Run("should share the block reward between the signers of the parent block", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			hooks  = &hook.Hooks{}
+			txn    = newTransitionWithFees(t)
+			called = false
+		)
+
+		// the previous hook is kept
+		hooks.PreCommitStateFunc = func(*types.Header, *state.Transition) error {
+			called = true
+
+			return nil
+		}
+
+		registerRewardHooks(hooks, &Rewards{
+			BlockReward:      big.NewInt(1000),
+			ShareWithSigners: true,
+		}, getParentSigners)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+		assert.True(t, called)
+
+		// the proposer gets the remainder
+		assert.Equal(t, new(big.Int).Add(fees, big.NewInt(1)), txn.GetBalance(proposer))
+
+		for _, signer := range signers {
+			assert.Equal(t, big.NewInt(333), txn.GetBalance(signer))
+		}
+	})
+
+	t.Run("should return the error of the previous hook", func(t *testing.T) {
+		t.Parallel()
+
+		hooks := &hook.Hooks{}
+		errTest := errors.New("test")
+
+		hooks.PreCommitStateFunc = func(*types.Header, *state.Transition) error {
+			return errTest
+		}
+
+		registerRewardHooks(hooks, &Rewards{}, getParentSigners)
+
+		assert.ErrorIs(t, hooks.PreCommitState(&types.Header{}, newTestTransition(t)), errTest)
+	})
+}
+
 func Test_getPreDeployParams(t *testing.T) {
 	t.Parallel()
 
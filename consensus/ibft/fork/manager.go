@@ -2,6 +2,8 @@ package fork
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
 	"github.com/LaChain/polygon-edge/consensus/ibft/hook"
 	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
@@ -25,6 +27,7 @@ var (
 	ErrSignerNotFound         = errors.New("signer not found")
 	ErrValidatorStoreNotFound = errors.New("validator set not found")
 	ErrKeyManagerNotFound     = errors.New("key manager not found")
+	ErrStakesNotSupported     = errors.New("validator store doesn't support stakes")
 )
 
 // ValidatorStore is an interface that ForkManager calls for Validator Store
@@ -36,6 +39,12 @@ type ValidatorStore interface {
 	GetValidators(height, epochSize, forkFrom uint64) (validators.Validators, error)
 }
 
+// StakesGetter is an interface of the ValidatorStore returning the stakes of the validators
+type StakesGetter interface {
+	// GetValidatorStakes is a method to return the stakes of the validators at the given height
+	GetValidatorStakes(height, epochSize, forkFrom uint64) (map[types.Address]*big.Int, error)
+}
+
 // HookRegister is an interface that ForkManager calls for hook registrations
 type HooksRegister interface {
 	// RegisterHooks register hooks for the given block height
@@ -61,6 +70,7 @@ type ForkManager struct {
 	blockchain     store.HeaderGetter
 	executor       contract.Executor
 	secretsManager secrets.SecretsManager
+	remoteSigner   *signer.RemoteSignerConfig
 
 	// configuration
 	forks     IBFTForks
@@ -71,6 +81,9 @@ type ForkManager struct {
 	keyManagers     map[validators.ValidatorType]signer.KeyManager
 	validatorStores map[store.SourceType]ValidatorStore
 	hooksRegisters  map[IBFTType]HooksRegister
+	// rewardHooksRegister registers its hooks after the others,
+	// so that the rewards are paid after the other changes of the state
+	rewardHooksRegister HooksRegister
 }
 
 // NewForkManager is a constructor of ForkManager
@@ -79,6 +92,7 @@ func NewForkManager(
 	blockchain store.HeaderGetter,
 	executor contract.Executor,
 	secretManager secrets.SecretsManager,
+	remoteSigner *signer.RemoteSignerConfig,
 	filePath string,
 	epochSize uint64,
 	ibftConfig map[string]interface{},
@@ -93,6 +107,7 @@ func NewForkManager(
 		blockchain:      blockchain,
 		executor:        executor,
 		secretsManager:  secretManager,
+		remoteSigner:    remoteSigner,
 		filePath:        filePath,
 		epochSize:       epochSize,
 		forks:           forks,
@@ -195,9 +210,112 @@ func (m *ForkManager) GetHooks(height uint64) HooksInterface {
 		r.RegisterHooks(hooks, height)
 	}
 
+	if m.rewardHooksRegister != nil {
+		m.rewardHooksRegister.RegisterHooks(hooks, height)
+	}
+
 	return hooks
 }
 
+// GetBlockTime returns the block time of the fork at specified height, or false if the fork doesn't define it
+func (m *ForkManager) GetBlockTime(height uint64) (time.Duration, bool) {
+	fork := m.forks.getFork(height)
+	if fork == nil || fork.BlockTime == nil {
+		return 0, false
+	}
+
+	return time.Duration(fork.BlockTime.Value) * time.Second, true
+}
+
+// GetBlockGasLimit returns the gas limit of the fork at specified height, or false if the fork doesn't define it
+func (m *ForkManager) GetBlockGasLimit(height uint64) (uint64, bool) {
+	fork := m.forks.getFork(height)
+	if fork == nil || fork.BlockGasLimit == nil {
+		return 0, false
+	}
+
+	return fork.BlockGasLimit.Value, true
+}
+
+// GetRoundTimeout returns the additional round timeout of the fork at specified height,
+// which is its block time unless defined, or false if the fork defines neither of them
+func (m *ForkManager) GetRoundTimeout(height uint64) (time.Duration, bool) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return 0, false
+	}
+
+	if fork.RoundTimeout != nil {
+		return time.Duration(fork.RoundTimeout.Value) * time.Second, true
+	}
+
+	return m.GetBlockTime(height)
+}
+
+// GetLivenessPolicy returns the liveness policy of the fork at specified height, or nil if it's not defined
+func (m *ForkManager) GetLivenessPolicy(height uint64) *LivenessPolicy {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return nil
+	}
+
+	return fork.Liveness
+}
+
+// GetProposerSelection returns the proposer selection of the fork at specified height
+func (m *ForkManager) GetProposerSelection(height uint64) ProposerSelection {
+	fork := m.forks.getFork(height)
+	if fork == nil || fork.ProposerSelection == "" {
+		return RoundRobin
+	}
+
+	return fork.ProposerSelection
+}
+
+// GetValidatorStakes returns the stakes of the validators at specified height
+func (m *ForkManager) GetValidatorStakes(height uint64) (map[types.Address]*big.Int, error) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return nil, ErrForkNotFound
+	}
+
+	set := m.getValidatorStoreByIBFTFork(fork)
+	if set == nil {
+		return nil, ErrValidatorStoreNotFound
+	}
+
+	stakesGetter, ok := set.(StakesGetter)
+	if !ok {
+		return nil, ErrStakesNotSupported
+	}
+
+	return stakesGetter.GetValidatorStakes(
+		height,
+		m.epochSize,
+		fork.From.Value,
+	)
+}
+
+// GetParentCommittedSealSigners returns the validators who signed the parent committed seals in the header
+func (m *ForkManager) GetParentCommittedSealSigners(header *types.Header) ([]types.Address, error) {
+	// the genesis has no committed seals
+	if header.Number <= 1 {
+		return nil, nil
+	}
+
+	parentSigner, err := m.GetSigner(header.Number - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	parentValidators, err := m.GetValidators(header.Number - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return parentSigner.GetParentCommittedSealSigners(header, parentValidators)
+}
+
 func (m *ForkManager) getValidatorStoreByIBFTFork(fork *IBFTFork) ValidatorStore {
 	set, ok := m.validatorStores[ibftTypesToSourceType[fork.Type]]
 	if !ok {
@@ -238,7 +356,18 @@ func (m *ForkManager) initializeKeyManager(valType validators.ValidatorType) err
 		return nil
 	}
 
-	keyManager, err := signer.NewKeyManagerFromType(m.secretsManager, valType)
+	var (
+		keyManager signer.KeyManager
+		err        error
+	)
+
+	// the keys are held by the remote signer if any, instead of the secrets manager
+	if m.remoteSigner != nil {
+		keyManager, err = signer.NewRemoteKeyManager(m.remoteSigner, valType)
+	} else {
+		keyManager, err = signer.NewKeyManagerFromType(m.secretsManager, valType)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -303,6 +432,8 @@ func (m *ForkManager) initializeHooksRegisters() {
 	for _, fork := range m.forks {
 		m.initializeHooksRegister(fork.Type)
 	}
+
+	m.rewardHooksRegister = NewRewardHookRegister(m.forks, m.GetParentCommittedSealSigners)
 }
 
 // initializeHooksRegister initialize HookRegister by IBFTType
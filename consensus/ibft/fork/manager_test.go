@@ -2,8 +2,10 @@ package fork
 
 import (
 	"errors"
+	"math/big"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/LaChain/polygon-edge/consensus/ibft/hook"
 	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
@@ -34,6 +36,18 @@ func (m *mockValidatorStore) GetValidators(height, epoch, from uint64) (validato
 	return m.GetValidatorsFunc(height, epoch, from)
 }
 
+type mockStakesValidatorStore struct {
+	mockValidatorStore
+
+	GetValidatorStakesFunc func(uint64, uint64, uint64) (map[types.Address]*big.Int, error)
+}
+
+func (m *mockStakesValidatorStore) GetValidatorStakes(
+	height, epoch, from uint64,
+) (map[types.Address]*big.Int, error) {
+	return m.GetValidatorStakesFunc(height, epoch, from)
+}
+
 type mockHooksRegister struct {
 	RegisterHooksFunc func(hooks *hook.Hooks, height uint64)
 }
@@ -76,6 +90,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			"",
 			0,
 			map[string]interface{}{},
@@ -105,6 +120,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -153,6 +169,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -234,6 +251,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -279,6 +297,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -751,6 +770,185 @@ func TestForkManagerGetHooks(t *testing.T) {
 	assert.Equal(t, err2, hooks.VerifyBlock(&types.Block{}), nil)
 }
 
+func TestForkManagerGetBlockParams(t *testing.T) {
+	t.Parallel()
+
+	fm := &ForkManager{
+		forks: IBFTForks{
+			{
+				Type: PoA,
+				From: common.JSONNumber{Value: 0},
+				To:   &common.JSONNumber{Value: 9},
+			},
+			{
+				Type:          PoA,
+				From:          common.JSONNumber{Value: 10},
+				To:            &common.JSONNumber{Value: 19},
+				BlockTime:     &common.JSONNumber{Value: 5},
+				BlockGasLimit: &common.JSONNumber{Value: 30000000},
+			},
+			{
+				Type:         PoA,
+				From:         common.JSONNumber{Value: 20},
+				BlockTime:    &common.JSONNumber{Value: 2},
+				RoundTimeout: &common.JSONNumber{Value: 8},
+			},
+		},
+	}
+
+	tests := []struct {
+		height       uint64
+		blockTime    time.Duration
+		gasLimit     uint64
+		roundTimeout time.Duration
+		defined      bool
+	}{
+		{height: 5},
+		{height: 15, blockTime: 5 * time.Second, gasLimit: 30000000, roundTimeout: 5 * time.Second, defined: true},
+		{height: 25, blockTime: 2 * time.Second, roundTimeout: 8 * time.Second, defined: true},
+	}
+
+	for _, test := range tests {
+		blockTime, ok := fm.GetBlockTime(test.height)
+		assert.Equal(t, test.blockTime, blockTime)
+		assert.Equal(t, test.defined, ok)
+
+		gasLimit, ok := fm.GetBlockGasLimit(test.height)
+		assert.Equal(t, test.gasLimit, gasLimit)
+		assert.Equal(t, test.gasLimit != 0, ok)
+
+		roundTimeout, ok := fm.GetRoundTimeout(test.height)
+		assert.Equal(t, test.roundTimeout, roundTimeout)
+		assert.Equal(t, test.defined, ok)
+	}
+}
+
+func TestForkManagerGetLivenessPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &LivenessPolicy{Threshold: 3, Action: LivenessVote}
+
+	fm := &ForkManager{
+		forks: IBFTForks{
+			{
+				Type: PoA,
+				From: common.JSONNumber{Value: 0},
+				To:   &common.JSONNumber{Value: 9},
+			},
+			{
+				Type:     PoA,
+				From:     common.JSONNumber{Value: 10},
+				Liveness: policy,
+			},
+		},
+	}
+
+	assert.Nil(t, fm.GetLivenessPolicy(5))
+	assert.Equal(t, policy, fm.GetLivenessPolicy(15))
+}
+
+func TestForkManagerGetProposerSelection(t *testing.T) {
+	t.Parallel()
+
+	fm := &ForkManager{
+		forks: IBFTForks{
+			{
+				Type: PoS,
+				From: common.JSONNumber{Value: 0},
+				To:   &common.JSONNumber{Value: 9},
+			},
+			{
+				Type:              PoS,
+				From:              common.JSONNumber{Value: 10},
+				ProposerSelection: StakeWeighted,
+			},
+		},
+	}
+
+	assert.Equal(t, RoundRobin, fm.GetProposerSelection(5))
+	assert.Equal(t, StakeWeighted, fm.GetProposerSelection(15))
+}
+
+func TestForkManagerGetValidatorStakes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		epochSize uint64 = 10
+		stakes           = map[types.Address]*big.Int{
+			types.StringToAddress("1"): big.NewInt(1),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		validatorStores map[store.SourceType]ValidatorStore
+		height          uint64
+		expectedStakes  map[types.Address]*big.Int
+		expectedErr     error
+	}{
+		{
+			name:            "should return ErrForkNotFound if fork not found",
+			validatorStores: map[store.SourceType]ValidatorStore{},
+			height:          5,
+			expectedErr:     ErrForkNotFound,
+		},
+		{
+			name:            "should return ErrValidatorStoreNotFound if validator store not found",
+			validatorStores: map[store.SourceType]ValidatorStore{},
+			height:          15,
+			expectedErr:     ErrValidatorStoreNotFound,
+		},
+		{
+			name: "should return ErrStakesNotSupported if validator store doesn't have stakes",
+			validatorStores: map[store.SourceType]ValidatorStore{
+				store.Contract: &mockValidatorStore{},
+			},
+			height:      15,
+			expectedErr: ErrStakesNotSupported,
+		},
+		{
+			name: "should return stakes",
+			validatorStores: map[store.SourceType]ValidatorStore{
+				store.Contract: &mockStakesValidatorStore{
+					GetValidatorStakesFunc: func(u1, u2, u3 uint64) (map[types.Address]*big.Int, error) {
+						assert.Equal(t, uint64(15), u1) // height
+						assert.Equal(t, epochSize, u2)  // epochSize
+						assert.Equal(t, uint64(10), u3) // from
+
+						return stakes, nil
+					},
+				},
+			},
+			height:         15,
+			expectedStakes: stakes,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			fm := &ForkManager{
+				forks: IBFTForks{
+					{
+						Type: PoS,
+						From: common.JSONNumber{Value: 10},
+					},
+				},
+				validatorStores: test.validatorStores,
+				epochSize:       epochSize,
+			}
+
+			stakes, err := fm.GetValidatorStakes(test.height)
+
+			assert.Equal(t, test.expectedStakes, stakes)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestForkManager_initializeKeyManagers(t *testing.T) {
 	t.Parallel()
 
@@ -923,4 +1121,9 @@ func TestForkManager_initializeHooksRegisters(t *testing.T) {
 		t,
 		fm.hooksRegisters[PoS],
 	)
+
+	assert.NotNil(
+		t,
+		fm.rewardHooksRegister,
+	)
 }
@@ -1,9 +1,11 @@
 package fork
 
 import (
+	"math/big"
 	"path/filepath"
 
 	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/types"
 	"github.com/LaChain/polygon-edge/validators"
 	"github.com/LaChain/polygon-edge/validators/store"
 	"github.com/LaChain/polygon-edge/validators/store/contract"
@@ -142,6 +144,25 @@ func (w *ContractValidatorStoreWrapper) GetValidators(
 	)
 }
 
+// GetValidatorStakes gets and returns the stakes of the validators at the given height
+func (w *ContractValidatorStoreWrapper) GetValidatorStakes(
+	height, epochSize, forkFrom uint64,
+) (map[types.Address]*big.Int, error) {
+	signer, err := w.getSigner(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetValidatorStakesByHeight(
+		signer.Type(),
+		calculateContractStoreFetchingHeight(
+			height,
+			epochSize,
+			forkFrom,
+		),
+	)
+}
+
 // calculateContractStoreFetchingHeight calculates the block height at which ContractStore fetches validators
 // based on height, epoch, and fork beginning height
 func calculateContractStoreFetchingHeight(height, epochSize, forkFrom uint64) uint64 {
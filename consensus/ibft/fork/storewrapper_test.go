@@ -344,6 +344,55 @@ func TestNewContractValidatorStoreWrapperGetValidators(t *testing.T) {
 	})
 }
 
+func TestNewContractValidatorStoreWrapperGetValidatorStakes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return error if getSigner returns error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewContractValidatorStoreWrapper(
+			hclog.NewNullLogger(),
+			&store.MockBlockchain{},
+			&MockExecutor{},
+			func(u uint64) (signer.Signer, error) {
+				return nil, errTest
+			},
+		)
+
+		assert.NoError(t, err)
+
+		res, err := wrapper.GetValidatorStakes(0, 0, 0)
+		assert.Nil(t, res)
+		assert.ErrorIs(t, errTest, err)
+	})
+
+	t.Run("should fetch the stakes at the end of the previous epoch", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewContractValidatorStoreWrapper(
+			hclog.NewNullLogger(),
+			&store.MockBlockchain{
+				GetHeaderByNumberFn: func(u uint64) (*types.Header, bool) {
+					return nil, false
+				},
+			},
+			&MockExecutor{},
+			func(u uint64) (signer.Signer, error) {
+				return signer.NewSigner(
+					&signer.ECDSAKeyManager{},
+					nil,
+				), nil
+			},
+		)
+
+		assert.NoError(t, err)
+
+		res, err := wrapper.GetValidatorStakes(15, 10, 0)
+		assert.Nil(t, res)
+		assert.ErrorContains(t, err, "header not found at 9")
+	})
+}
+
 func Test_calculateContractStoreFetchingHeight(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package ibft
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/LaChain/polygon-edge/blockchain"
@@ -40,10 +41,13 @@ var (
 	ErrInvalidSha3Uncles            = errors.New("invalid sha3 uncles")
 	ErrWrongDifficulty              = errors.New("wrong difficulty")
 	ErrParentCommittedSealsNotFound = errors.New("parent committed seals not found")
+	ErrLightSyncPoS                 = errors.New("light sync is not supported by PoS, whose validators are in the state")
+	ErrInvalidBlockTimestamp        = errors.New("block timestamp is earlier than the block time of the fork allows")
+	ErrInvalidGasLimit              = errors.New("block gas limit doesn't match the gas limit of the fork")
 )
 
 type txPoolInterface interface {
-	Prepare()
+	Prepare(baseFee uint64)
 	Length() uint64
 	Peek() *types.Transaction
 	Pop(tx *types.Transaction)
@@ -60,6 +64,13 @@ type forkManagerInterface interface {
 	GetValidatorStore(uint64) (fork.ValidatorStore, error)
 	GetValidators(uint64) (validators.Validators, error)
 	GetHooks(uint64) fork.HooksInterface
+	GetBlockTime(uint64) (time.Duration, bool)
+	GetBlockGasLimit(uint64) (uint64, bool)
+	GetRoundTimeout(uint64) (time.Duration, bool)
+	GetLivenessPolicy(uint64) *fork.LivenessPolicy
+	GetParentCommittedSealSigners(*types.Header) ([]types.Address, error)
+	GetProposerSelection(uint64) fork.ProposerSelection
+	GetValidatorStakes(uint64) (map[types.Address]*big.Int, error)
 }
 
 // backendIBFT represents the IBFT consensus mechanism object
@@ -83,12 +94,15 @@ type backendIBFT struct {
 	currentSigner     signer.Signer         // Signer at current sequence
 	currentValidators validators.Validators // signer at current sequence
 	currentHooks      fork.HooksInterface   // Hooks at current sequence
+	liveness          *livenessTracker      // Liveness of the validators in the current epoch
+	proposers         *proposerSelector     // Stake-weighted proposer schedule of the current epoch
 
 	// Configurations
 	config             *consensus.Config // Consensus configuration
 	epochSize          uint64
 	quorumSizeBlockNum uint64
-	blockTime          time.Duration // Minimum block generation time in seconds
+	blockTime          time.Duration // Minimum block generation time in seconds, unless defined by the fork
+	syncMode           syncer.SyncMode
 
 	// Channels
 	closeCh chan struct{} // Channel for closing
@@ -122,13 +136,37 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		quorumSizeBlockNum = uint64(readBlockNum)
 	}
 
+	if params.SyncMode == syncer.LightSync {
+		forks, err := fork.GetIBFTForks(params.Config.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		// light nodes don't have the state to read the validators of PoS from
+		for _, f := range forks {
+			if f.Type == fork.PoS {
+				return nil, ErrLightSyncPoS
+			}
+		}
+	}
+
 	logger := params.Logger.Named("ibft")
 
+	remoteSigner, err := signer.NewRemoteSignerConfig(params.SecretsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if remoteSigner != nil {
+		logger.Info("validator keys held by the remote signer", "url", remoteSigner.URL)
+	}
+
 	forkManager, err := fork.NewForkManager(
 		logger,
 		params.Blockchain,
 		params.Executor,
 		params.SecretsManager,
+		remoteSigner,
 		params.Config.Path,
 		epochSize,
 		params.Config.Config,
@@ -150,16 +188,22 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 			params.Network,
 			params.Blockchain,
 			time.Duration(params.BlockTime)*3*time.Second,
+			params.StateStorage,
+			params.SyncMode,
+			epochSize,
 		),
 		secretsManager: params.SecretsManager,
 		Grpc:           params.Grpc,
 		forkManager:    forkManager,
+		liveness:       &livenessTracker{},
+		proposers:      &proposerSelector{},
 
 		// Configurations
 		config:             params.Config,
 		epochSize:          epochSize,
 		quorumSizeBlockNum: quorumSizeBlockNum,
 		blockTime:          time.Duration(params.BlockTime) * time.Second,
+		syncMode:           params.SyncMode,
 
 		// Channels
 		closeCh: make(chan struct{}),
@@ -178,9 +222,11 @@ func (i *backendIBFT) Initialize() error {
 		proto.RegisterIbftOperatorServer(i.Grpc, i.operator)
 	}
 
-	// start the transport protocol
-	if err := i.setupTransport(); err != nil {
-		return err
+	// start the transport protocol, light nodes don't take part in the consensus
+	if i.syncMode != syncer.LightSync {
+		if err := i.setupTransport(); err != nil {
+			return err
+		}
 	}
 
 	// initialize fork manager
@@ -200,9 +246,6 @@ func (i *backendIBFT) Initialize() error {
 		i,
 	)
 
-	// Ensure consensus takes into account user configured block production time
-	i.consensus.ExtendRoundTimeout(i.blockTime)
-
 	return nil
 }
 
@@ -217,7 +260,10 @@ func (i *backendIBFT) startSyncing() {
 			i.logger.Error("failed to update sub modules", "height", block.Number()+1, "err", err)
 		}
 
-		i.txpool.ResetWithHeaders(block.Header)
+		// light nodes don't have the state of the accounts in the pool
+		if i.syncMode != syncer.LightSync {
+			i.txpool.ResetWithHeaders(block.Header)
+		}
 
 		return false
 	}
@@ -239,6 +285,11 @@ func (i *backendIBFT) Start() error {
 	// Start syncing blocks from other peers
 	go i.startSyncing()
 
+	// light nodes only follow the verified headers
+	if i.syncMode == syncer.LightSync {
+		return nil
+	}
+
 	// Start the actual consensus protocol
 	go i.startConsensus()
 
@@ -304,6 +355,11 @@ func (i *backendIBFT) startConsensus() {
 		i.txpool.SetSealing(isValidator)
 
 		if isValidator {
+			i.proposeJailedRemovals(pending)
+
+			// Ensure consensus takes into account the block production time at the height
+			i.consensus.ExtendRoundTimeout(i.getRoundTimeout(pending))
+
 			sequenceCh = i.consensus.runSequence(pending)
 		}
 
@@ -373,6 +429,11 @@ func (i *backendIBFT) verifyHeaderImpl(
 		return err
 	}
 
+	// verify the block time and the gas limit of the fork
+	if err := i.verifyForkBlockParams(parent, header); err != nil {
+		return err
+	}
+
 	// verify the ProposerSeal
 	if err := verifyProposerSeal(
 		header,
@@ -398,6 +459,24 @@ func (i *backendIBFT) verifyHeaderImpl(
 	return nil
 }
 
+// verifyForkBlockParams verifies the timestamp and the gas limit of the header
+// against the block time and the gas limit defined by the fork
+func (i *backendIBFT) verifyForkBlockParams(parent, header *types.Header) error {
+	if blockTime, ok := i.forkManager.GetBlockTime(header.Number); ok {
+		minTimestamp := time.Unix(int64(parent.Timestamp), 0).Add(blockTime)
+
+		if time.Unix(int64(header.Timestamp), 0).Before(minTimestamp) {
+			return ErrInvalidBlockTimestamp
+		}
+	}
+
+	if gasLimit, ok := i.forkManager.GetBlockGasLimit(header.Number); ok && header.GasLimit != gasLimit {
+		return ErrInvalidGasLimit
+	}
+
+	return nil
+}
+
 // VerifyHeader wrapper for verifying headers
 func (i *backendIBFT) VerifyHeader(header *types.Header) error {
 	parent, ok := i.blockchain.GetHeaderByNumber(header.Number - 1)
@@ -482,6 +561,30 @@ func (i *backendIBFT) PreCommitState(header *types.Header, txn *state.Transition
 	return hooks.PreCommitState(header, txn)
 }
 
+// GetBlockGasLimit returns the gas limit of the block if the fork defines it
+func (i *backendIBFT) GetBlockGasLimit(number uint64) (uint64, bool) {
+	return i.forkManager.GetBlockGasLimit(number)
+}
+
+// getBlockTime returns the block time at the height, defined by the fork or the node
+func (i *backendIBFT) getBlockTime(height uint64) time.Duration {
+	if blockTime, ok := i.forkManager.GetBlockTime(height); ok {
+		return blockTime
+	}
+
+	return i.blockTime
+}
+
+// getRoundTimeout returns the additional timeout of the rounds at the height,
+// defined by the fork or the block time of the node
+func (i *backendIBFT) getRoundTimeout(height uint64) time.Duration {
+	if timeout, ok := i.forkManager.GetRoundTimeout(height); ok {
+		return timeout
+	}
+
+	return i.blockTime
+}
+
 // GetEpoch returns the current epoch
 func (i *backendIBFT) GetEpoch(number uint64) uint64 {
 	if number%i.epochSize == 0 {
@@ -491,6 +594,11 @@ func (i *backendIBFT) GetEpoch(number uint64) uint64 {
 	return number/i.epochSize + 1
 }
 
+// GetEpochSize returns the number of blocks of an epoch
+func (i *backendIBFT) GetEpochSize() uint64 {
+	return i.epochSize
+}
+
 // IsLastOfEpoch checks if the block number is the last of the epoch
 func (i *backendIBFT) IsLastOfEpoch(number uint64) bool {
 	return number > 0 && number%i.epochSize == 0
@@ -0,0 +1,117 @@
+package ibft
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
+	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockForkManager struct {
+	forkManagerInterface
+
+	GetBlockTimeFunc         func(uint64) (time.Duration, bool)
+	GetBlockGasLimitFunc     func(uint64) (uint64, bool)
+	GetProposerSelectionFunc func(uint64) fork.ProposerSelection
+	GetValidatorStakesFunc   func(uint64) (map[types.Address]*big.Int, error)
+	GetSignerFunc            func(uint64) (signer.Signer, error)
+
+	GetValidatorsFunc                 func(uint64) (validators.Validators, error)
+	GetLivenessPolicyFunc             func(uint64) *fork.LivenessPolicy
+	GetParentCommittedSealSignersFunc func(*types.Header) ([]types.Address, error)
+}
+
+func (m *mockForkManager) GetBlockTime(height uint64) (time.Duration, bool) {
+	return m.GetBlockTimeFunc(height)
+}
+
+func (m *mockForkManager) GetBlockGasLimit(height uint64) (uint64, bool) {
+	return m.GetBlockGasLimitFunc(height)
+}
+
+func (m *mockForkManager) GetProposerSelection(height uint64) fork.ProposerSelection {
+	return m.GetProposerSelectionFunc(height)
+}
+
+func (m *mockForkManager) GetValidatorStakes(height uint64) (map[types.Address]*big.Int, error) {
+	return m.GetValidatorStakesFunc(height)
+}
+
+func (m *mockForkManager) GetSigner(height uint64) (signer.Signer, error) {
+	return m.GetSignerFunc(height)
+}
+
+func (m *mockForkManager) GetValidators(height uint64) (validators.Validators, error) {
+	return m.GetValidatorsFunc(height)
+}
+
+func (m *mockForkManager) GetLivenessPolicy(height uint64) *fork.LivenessPolicy {
+	return m.GetLivenessPolicyFunc(height)
+}
+
+func (m *mockForkManager) GetParentCommittedSealSigners(header *types.Header) ([]types.Address, error) {
+	return m.GetParentCommittedSealSignersFunc(header)
+}
+
+func TestIBFTBackend_verifyForkBlockParams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		forkHeight = 10
+		blockTime  = 5 * time.Second
+		gasLimit   = 30000000
+	)
+
+	i := &backendIBFT{
+		forkManager: &mockForkManager{
+			GetBlockTimeFunc: func(height uint64) (time.Duration, bool) {
+				return blockTime, height >= forkHeight
+			},
+			GetBlockGasLimitFunc: func(height uint64) (uint64, bool) {
+				return gasLimit, height >= forkHeight
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		header *types.Header
+		err    error
+	}{
+		{
+			name:   "should accept any timestamp and gas limit before the fork",
+			header: &types.Header{Number: forkHeight - 1, Timestamp: 101, GasLimit: 1},
+		},
+		{
+			name:   "should accept the block time and the gas limit of the fork",
+			header: &types.Header{Number: forkHeight, Timestamp: 105, GasLimit: gasLimit},
+		},
+		{
+			name:   "should reject the timestamp earlier than the block time of the fork",
+			header: &types.Header{Number: forkHeight, Timestamp: 104, GasLimit: gasLimit},
+			err:    ErrInvalidBlockTimestamp,
+		},
+		{
+			name:   "should reject the gas limit different from the fork",
+			header: &types.Header{Number: forkHeight, Timestamp: 106, GasLimit: gasLimit + 1},
+			err:    ErrInvalidGasLimit,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			parent := &types.Header{Number: test.header.Number - 1, Timestamp: 100}
+
+			assert.ErrorIs(t, i.verifyForkBlockParams(parent, test.header), test.err)
+		})
+	}
+}
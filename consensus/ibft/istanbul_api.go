@@ -0,0 +1,78 @@
+package ibft
+
+import (
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/LaChain/polygon-edge/validators/store"
+)
+
+// GetBlockSigners returns the proposer of the block and the validators who signed its committed seals,
+// the genesis has neither of them
+func (i *backendIBFT) GetBlockSigners(header *types.Header) (types.Address, []types.Address, error) {
+	if header.Number == 0 {
+		return types.ZeroAddress, []types.Address{}, nil
+	}
+
+	signer, err := i.forkManager.GetSigner(header.Number)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	validators, err := i.forkManager.GetValidators(header.Number)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	proposer, err := signer.EcrecoverFromHeader(header)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	committers, err := signer.GetCommittedSealSigners(header, validators)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	return proposer, committers, nil
+}
+
+// GetValidatorsByHeight returns the validators of the block at the height
+func (i *backendIBFT) GetValidatorsByHeight(height uint64) (validators.Validators, error) {
+	return i.forkManager.GetValidators(height)
+}
+
+// GetVotes returns the votes in the validator store at the height,
+// or nil if the validator store doesn't have voting function
+func (i *backendIBFT) GetVotes(height uint64) ([]*store.Vote, error) {
+	validatorStore, err := i.forkManager.GetValidatorStore(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVotes(validatorStore, height)
+}
+
+// GetCandidates returns the candidates proposed by the node
+func (i *backendIBFT) GetCandidates() ([]*store.Candidate, error) {
+	votableSet, err := i.getVotableValidatorStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return votableSet.Candidates(), nil
+}
+
+// getVotableValidatorStore gets current validator set and convert its type to Votable
+func (i *backendIBFT) getVotableValidatorStore() (Votable, error) {
+	valSet, err := i.forkManager.GetValidatorStore(i.blockchain.Header().Number)
+	if err != nil {
+		return nil, err
+	}
+
+	votableValSet, ok := valSet.(Votable)
+	if !ok {
+		return nil, ErrVotingNotSupported
+	}
+
+	return votableValSet, nil
+}
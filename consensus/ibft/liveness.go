@@ -0,0 +1,323 @@
+package ibft
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+)
+
+// ValidatorLiveness is the participation of a validator in the blocks of an epoch
+type ValidatorLiveness struct {
+	Proposed        uint64
+	MissedProposals uint64
+	Signed          uint64
+	MissedSeals     uint64
+}
+
+// Missed returns the number of the missed proposals and committed seals
+func (l ValidatorLiveness) Missed() uint64 {
+	return l.MissedProposals + l.MissedSeals
+}
+
+// JailVotable is an interface of the ValidatorStore voting for the removal of the jailed validators
+type JailVotable interface {
+	ProposeJailed(validators.Validators, types.Address)
+}
+
+// livenessTracker accumulates the liveness of the validators in the blocks of an epoch.
+// The committed seals of a block are counted with its child, because the parent committed seals
+// are the same on all the nodes unlike the committed seals written by each node
+type livenessTracker struct {
+	sync.Mutex
+
+	epoch uint64
+	// next is the number of the next block to process
+	next uint64
+	// lastProposer is the proposer of the block before next
+	lastProposer types.Address
+	stats        map[types.Address]*ValidatorLiveness
+}
+
+// get returns the liveness of the validator
+func (t *livenessTracker) get(addr types.Address) *ValidatorLiveness {
+	liveness, ok := t.stats[addr]
+	if !ok {
+		liveness = &ValidatorLiveness{}
+		t.stats[addr] = liveness
+	}
+
+	return liveness
+}
+
+// update counts the proposal of a block and the committed seals of its parent
+func (t *livenessTracker) update(
+	proposer types.Address,
+	missedProposers []types.Address,
+	parentValidators validators.Validators,
+	parentSigners []types.Address,
+) {
+	t.get(proposer).Proposed++
+
+	for _, addr := range missedProposers {
+		t.get(addr).MissedProposals++
+	}
+
+	t.lastProposer = proposer
+
+	// the blocks without parent committed seals are not counted
+	if len(parentSigners) == 0 {
+		return
+	}
+
+	signed := make(map[types.Address]bool, len(parentSigners))
+	for _, addr := range parentSigners {
+		signed[addr] = true
+	}
+
+	for idx := 0; idx < parentValidators.Len(); idx++ {
+		addr := parentValidators.At(uint64(idx)).Addr()
+
+		if signed[addr] {
+			t.get(addr).Signed++
+		} else {
+			t.get(addr).MissedSeals++
+		}
+	}
+}
+
+// jailed returns the validators missing more blocks than the threshold of the policy
+func (t *livenessTracker) jailed(policy *fork.LivenessPolicy, vals validators.Validators) validators.Validators {
+	jailed := validators.NewValidatorSetFromType(vals.Type())
+
+	for idx := 0; idx < vals.Len(); idx++ {
+		validator := vals.At(uint64(idx))
+
+		if liveness, ok := t.stats[validator.Addr()]; ok && policy.IsJailed(liveness.Missed()) {
+			_ = jailed.Add(validator)
+		}
+	}
+
+	return jailed
+}
+
+// calcProposer returns the proposer of the round, skipping the jailed validators unless all of them are
+func calcProposer(
+	vals validators.Validators,
+	round uint64,
+	lastProposer types.Address,
+	jailed validators.Validators,
+) validators.Validator {
+	if jailed == nil || jailed.Len() == 0 || jailed.Len() >= vals.Len() {
+		return CalcProposer(vals, round, lastProposer)
+	}
+
+	active := vals.Copy()
+
+	for idx := 0; idx < jailed.Len(); idx++ {
+		_ = active.Del(jailed.At(uint64(idx)))
+	}
+
+	return CalcProposer(active, round, lastProposer)
+}
+
+// missedProposers returns the proposers of the rounds before the one in which the proposer proposed the block
+func missedProposers(
+	rounds int,
+	proposer types.Address,
+	proposerAt func(round uint64) (validators.Validator, error),
+) ([]types.Address, error) {
+	missed := make([]types.Address, 0)
+
+	for round := uint64(0); round < uint64(rounds); round++ {
+		expected, err := proposerAt(round)
+		if err != nil {
+			return nil, err
+		}
+
+		if expected.Addr() == proposer {
+			return missed, nil
+		}
+
+		missed = append(missed, expected.Addr())
+	}
+
+	// the round can't be determined
+	return nil, nil
+}
+
+// getLiveness returns the liveness of the validators in the blocks of the epoch before the height
+func (i *backendIBFT) getLiveness(height uint64) (map[types.Address]ValidatorLiveness, error) {
+	i.liveness.Lock()
+	defer i.liveness.Unlock()
+
+	if err := i.updateLiveness(height); err != nil {
+		return nil, err
+	}
+
+	stats := make(map[types.Address]ValidatorLiveness, len(i.liveness.stats))
+	for addr, liveness := range i.liveness.stats {
+		stats[addr] = *liveness
+	}
+
+	return stats, nil
+}
+
+// getJailedValidators returns the validators jailed at the height by the liveness policy with the given action
+func (i *backendIBFT) getJailedValidators(
+	height uint64,
+	vals validators.Validators,
+	action fork.LivenessAction,
+) (validators.Validators, error) {
+	policy := i.forkManager.GetLivenessPolicy(height)
+	if policy == nil || policy.Action != action {
+		return validators.NewValidatorSetFromType(vals.Type()), nil
+	}
+
+	i.liveness.Lock()
+	defer i.liveness.Unlock()
+
+	if err := i.updateLiveness(height); err != nil {
+		return nil, err
+	}
+
+	return i.liveness.jailed(policy, vals), nil
+}
+
+// proposeJailedRemovals makes the validator store vote for the removal of the jailed validators
+func (i *backendIBFT) proposeJailedRemovals(height uint64) {
+	validatorStore, err := i.forkManager.GetValidatorStore(height)
+	if err != nil {
+		return
+	}
+
+	votable, ok := validatorStore.(JailVotable)
+	if !ok {
+		return
+	}
+
+	jailed, err := i.getJailedValidators(height, i.currentValidators, fork.LivenessVote)
+	if err != nil {
+		i.logger.Error("failed to get the jailed validators", "height", height, "err", err)
+
+		return
+	}
+
+	votable.ProposeJailed(jailed, i.currentSigner.Address())
+}
+
+// updateLiveness processes the blocks of the epoch before the height, unsafe against concurrent access
+func (i *backendIBFT) updateLiveness(height uint64) error {
+	t := i.liveness
+	epoch := i.GetEpoch(height)
+
+	// start over at the beginning of another epoch
+	if t.stats == nil || t.epoch != epoch || t.next > height {
+		start := uint64(1)
+		if epoch > 1 {
+			start = (epoch-1)*i.epochSize + 1
+		}
+
+		lastHeader, ok := i.blockchain.GetHeaderByNumber(start - 1)
+		if !ok && height > start {
+			// the chain bootstrapped from an anchor block doesn't have the blocks before its ancestors,
+			// the blocks of the epoch are counted from the first one available until the next epoch
+			lastHeader, ok = i.firstLocalHeader(start, height-1)
+		}
+
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrHeaderNotFound, start-1)
+		}
+
+		start = lastHeader.Number + 1
+
+		lastProposer, err := i.extractProposer(lastHeader)
+		if err != nil {
+			return err
+		}
+
+		t.epoch = epoch
+		t.next = start
+		t.lastProposer = lastProposer
+		t.stats = make(map[types.Address]*ValidatorLiveness)
+	}
+
+	for ; t.next < height; t.next++ {
+		header, ok := i.blockchain.GetHeaderByNumber(t.next)
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrHeaderNotFound, t.next)
+		}
+
+		if err := i.processLiveness(header); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// firstLocalHeader returns the header of the first block between from and to found in the chain,
+// given that all the blocks after it are found too
+func (i *backendIBFT) firstLocalHeader(from, to uint64) (*types.Header, bool) {
+	idx := sort.Search(int(to-from+1), func(idx int) bool {
+		_, ok := i.blockchain.GetHeaderByNumber(from + uint64(idx))
+
+		return ok
+	})
+
+	return i.blockchain.GetHeaderByNumber(from + uint64(idx))
+}
+
+// processLiveness counts the proposal of the block and the committed seals of its parent
+func (i *backendIBFT) processLiveness(header *types.Header) error {
+	t := i.liveness
+
+	vals, err := i.forkManager.GetValidators(header.Number)
+	if err != nil {
+		return err
+	}
+
+	proposer, err := i.extractProposer(header)
+	if err != nil {
+		return err
+	}
+
+	jailed := validators.NewValidatorSetFromType(vals.Type())
+	if policy := i.forkManager.GetLivenessPolicy(header.Number); policy != nil && policy.Action == fork.LivenessSkip {
+		jailed = t.jailed(policy, vals)
+	}
+
+	var (
+		parentValidators validators.Validators
+		parentSigners    []types.Address
+	)
+
+	if header.Number > 1 {
+		if parentValidators, err = i.forkManager.GetValidators(header.Number - 1); err != nil {
+			return err
+		}
+
+		if parentSigners, err = i.forkManager.GetParentCommittedSealSigners(header); err != nil {
+			return err
+		}
+	}
+
+	missed, err := missedProposers(vals.Len(), proposer, func(round uint64) (validators.Validator, error) {
+		return i.calcProposerAt(header.Number, round, vals, t.lastProposer, jailed)
+	})
+	if err != nil {
+		return err
+	}
+
+	t.update(
+		proposer,
+		missed,
+		parentValidators,
+		parentSigners,
+	)
+
+	return nil
+}
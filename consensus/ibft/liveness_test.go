@@ -0,0 +1,229 @@
+package ibft
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
+	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	livenessAddr1 = types.StringToAddress("1")
+	livenessAddr2 = types.StringToAddress("2")
+	livenessAddr3 = types.StringToAddress("3")
+	livenessAddr4 = types.StringToAddress("4")
+
+	errTestProposer = errors.New("test proposer")
+)
+
+func newLivenessTestValidators() validators.Validators {
+	return validators.NewECDSAValidatorSet(
+		validators.NewECDSAValidator(livenessAddr1),
+		validators.NewECDSAValidator(livenessAddr2),
+		validators.NewECDSAValidator(livenessAddr3),
+		validators.NewECDSAValidator(livenessAddr4),
+	)
+}
+
+func TestCalcProposerSkipsJailed(t *testing.T) {
+	t.Parallel()
+
+	vals := newLivenessTestValidators()
+
+	tests := []struct {
+		name     string
+		round    uint64
+		jailed   validators.Validators
+		expected types.Address
+	}{
+		{
+			name:     "should pick the next validator without jailed validators",
+			round:    0,
+			jailed:   validators.NewECDSAValidatorSet(),
+			expected: livenessAddr2,
+		},
+		{
+			name:     "should skip the jailed validator",
+			round:    0,
+			jailed:   validators.NewECDSAValidatorSet(validators.NewECDSAValidator(livenessAddr2)),
+			expected: livenessAddr3,
+		},
+		{
+			name:     "should skip the jailed validator in the later rounds",
+			round:    2,
+			jailed:   validators.NewECDSAValidatorSet(validators.NewECDSAValidator(livenessAddr2)),
+			expected: livenessAddr1,
+		},
+		{
+			name:     "should not skip the validators if all of them are jailed",
+			round:    0,
+			jailed:   newLivenessTestValidators(),
+			expected: livenessAddr2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, calcProposer(vals, test.round, livenessAddr1, test.jailed).Addr())
+		})
+	}
+}
+
+func TestMissedProposers(t *testing.T) {
+	t.Parallel()
+
+	vals := newLivenessTestValidators()
+	noJailed := validators.NewECDSAValidatorSet()
+
+	roundRobin := func(jailed validators.Validators) func(uint64) (validators.Validator, error) {
+		return func(round uint64) (validators.Validator, error) {
+			return calcProposer(vals, round, livenessAddr1, jailed), nil
+		}
+	}
+
+	missed := func(proposer types.Address, jailed validators.Validators) []types.Address {
+		t.Helper()
+
+		res, err := missedProposers(vals.Len(), proposer, roundRobin(jailed))
+		assert.NoError(t, err)
+
+		return res
+	}
+
+	// the proposer of the first round proposed the block
+	assert.Equal(t, []types.Address{}, missed(livenessAddr2, noJailed))
+
+	// the proposers of the first two rounds missed their proposals
+	assert.Equal(t, []types.Address{livenessAddr2, livenessAddr3}, missed(livenessAddr4, noJailed))
+
+	// the jailed validator doesn't miss the proposals it's not expected to make
+	assert.Equal(
+		t,
+		[]types.Address{livenessAddr3},
+		missed(livenessAddr4, validators.NewECDSAValidatorSet(validators.NewECDSAValidator(livenessAddr2))),
+	)
+
+	// the round of a proposer who isn't a validator can't be determined
+	assert.Nil(t, missed(types.StringToAddress("5"), noJailed))
+
+	// the error of the proposer calculation is returned
+	_, err := missedProposers(vals.Len(), livenessAddr2, func(uint64) (validators.Validator, error) {
+		return nil, errTestProposer
+	})
+	assert.ErrorIs(t, err, errTestProposer)
+}
+
+func TestLivenessTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		vals    = newLivenessTestValidators()
+		tracker = &livenessTracker{
+			stats: make(map[types.Address]*ValidatorLiveness),
+		}
+	)
+
+	// the block without parent committed seals only counts the proposals
+	tracker.update(livenessAddr4, []types.Address{livenessAddr2, livenessAddr3}, vals, nil)
+
+	tracker.update(livenessAddr1, []types.Address{}, vals, []types.Address{livenessAddr1, livenessAddr2, livenessAddr4})
+
+	assert.Equal(t, livenessAddr1, tracker.lastProposer)
+	assert.Equal(t, map[types.Address]*ValidatorLiveness{
+		livenessAddr1: {Proposed: 1, Signed: 1},
+		livenessAddr2: {MissedProposals: 1, Signed: 1},
+		livenessAddr3: {MissedProposals: 1, MissedSeals: 1},
+		livenessAddr4: {Proposed: 1, Signed: 1},
+	}, tracker.stats)
+
+	// the validators missing more blocks than the threshold are jailed
+	jailed := tracker.jailed(&fork.LivenessPolicy{Threshold: 1, Action: fork.LivenessSkip}, vals)
+
+	assert.Equal(t, validators.NewECDSAValidatorSet(validators.NewECDSAValidator(livenessAddr3)), jailed)
+}
+
+// mockProposerSigner recovers the proposer of a header from its miner field
+type mockProposerSigner struct {
+	signer.Signer
+}
+
+func (m *mockProposerSigner) EcrecoverFromHeader(header *types.Header) (types.Address, error) {
+	return types.BytesToAddress(header.Miner), nil
+}
+
+func TestIBFTBackend_getLiveness_FromAnchor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize = 10
+		anchor    = 15
+	)
+
+	vals := newLivenessTestValidators()
+
+	// the block n is proposed by the validator n%4 in the round 0, except the anchor proposed in the round 1
+	headers := blockchain.NewTestHeaders(anchor + 1)
+	for n, header := range headers {
+		header.Miner = vals.At(uint64(n % vals.Len())).Addr().Bytes()
+		if n == anchor {
+			header.Miner = vals.At(uint64((n + 1) % vals.Len())).Addr().Bytes()
+		}
+
+		if n > 0 {
+			header.ParentHash = headers[n-1].Hash
+		}
+
+		header.ComputeHash()
+	}
+
+	// the chain is bootstrapped from the anchor in the middle of the epoch, with a few ancestors
+	chain := blockchain.NewTestBlockchain(t, nil)
+	assert.NoError(t, chain.WriteAnchor(headers[13:anchor], &types.Block{Header: headers[anchor]}, nil, big.NewInt(1)))
+
+	i := &backendIBFT{
+		epochSize:  epochSize,
+		blockchain: chain,
+		liveness:   &livenessTracker{},
+		forkManager: &mockForkManager{
+			GetSignerFunc: func(uint64) (signer.Signer, error) {
+				return &mockProposerSigner{}, nil
+			},
+			GetValidatorsFunc: func(uint64) (validators.Validators, error) {
+				return vals, nil
+			},
+			GetLivenessPolicyFunc: func(uint64) *fork.LivenessPolicy {
+				return &fork.LivenessPolicy{Threshold: 0, Action: fork.LivenessSkip}
+			},
+			GetProposerSelectionFunc: func(uint64) fork.ProposerSelection {
+				return fork.RoundRobin
+			},
+			GetParentCommittedSealSignersFunc: func(*types.Header) ([]types.Address, error) {
+				return []types.Address{livenessAddr1, livenessAddr2, livenessAddr3, livenessAddr4}, nil
+			},
+		},
+	}
+
+	// the blocks after the first one available are counted
+	stats, err := i.getLiveness(anchor + 1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[types.Address]ValidatorLiveness{
+		livenessAddr1: {Proposed: 1, Signed: 2},
+		livenessAddr2: {Signed: 2},
+		livenessAddr3: {Proposed: 1, Signed: 2},
+		livenessAddr4: {MissedProposals: 1, Signed: 2},
+	}, stats)
+
+	jailed, err := i.getJailedValidators(anchor+1, vals, fork.LivenessSkip)
+	assert.NoError(t, err)
+	assert.Equal(t, validators.NewECDSAValidatorSet(validators.NewECDSAValidator(livenessAddr4)), jailed)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
 	"github.com/LaChain/polygon-edge/consensus/ibft/proto"
 	"github.com/LaChain/polygon-edge/consensus/ibft/signer"
 	"github.com/LaChain/polygon-edge/crypto"
@@ -39,8 +40,26 @@ func (o *operator) Status(ctx context.Context, req *empty.Empty) (*proto.IbftSta
 		return nil, err
 	}
 
+	height := o.ibft.blockchain.Header().Number + 1
+
+	validators, err := o.ibft.forkManager.GetValidators(height)
+	if err != nil {
+		return nil, err
+	}
+
+	liveness, err := o.ibft.getLiveness(height)
+	if err != nil {
+		return nil, err
+	}
+
 	return &proto.IbftStatusResp{
-		Key: signer.Address().String(),
+		Key:   signer.Address().String(),
+		Epoch: o.ibft.GetEpoch(height),
+		Liveness: livenessToProtoLiveness(
+			validators,
+			liveness,
+			o.ibft.forkManager.GetLivenessPolicy(height),
+		),
 	}, nil
 }
 
@@ -108,13 +127,11 @@ func (o *operator) Propose(ctx context.Context, req *proto.Candidate) (*empty.Em
 
 // Candidates returns the validator candidates list
 func (o *operator) Candidates(ctx context.Context, req *empty.Empty) (*proto.CandidatesResp, error) {
-	votableValSet, err := o.getVotableValidatorStore()
+	candidates, err := o.ibft.GetCandidates()
 	if err != nil {
 		return nil, err
 	}
 
-	candidates := votableValSet.Candidates()
-
 	return &proto.CandidatesResp{
 		Candidates: candidatesToProtoCandidates(candidates),
 	}, nil
@@ -158,17 +175,7 @@ func (o *operator) parseCandidate(req *proto.Candidate) (validators.Validator, e
 
 // getVotableValidatorStore gets current validator set and convert its type to Votable
 func (o *operator) getVotableValidatorStore() (Votable, error) {
-	valSet, err := o.ibft.forkManager.GetValidatorStore(o.ibft.blockchain.Header().Number)
-	if err != nil {
-		return nil, err
-	}
-
-	votableValSet, ok := valSet.(Votable)
-	if !ok {
-		return nil, ErrVotingNotSupported
-	}
-
-	return votableValSet, nil
+	return o.ibft.getVotableValidatorStore()
 }
 
 // getLatestSigner gets the latest signer IBFT uses
@@ -197,6 +204,31 @@ func validatorsToProtoValidators(validators validators.Validators) []*proto.Snap
 	return protoValidators
 }
 
+// livenessToProtoLiveness converts the liveness of the validators to response of liveness
+func livenessToProtoLiveness(
+	validators validators.Validators,
+	liveness map[types.Address]ValidatorLiveness,
+	policy *fork.LivenessPolicy,
+) []*proto.IbftStatusResp_ValidatorLiveness {
+	protoLiveness := make([]*proto.IbftStatusResp_ValidatorLiveness, validators.Len())
+
+	for idx := 0; idx < validators.Len(); idx++ {
+		addr := validators.At(uint64(idx)).Addr()
+		stats := liveness[addr]
+
+		protoLiveness[idx] = &proto.IbftStatusResp_ValidatorLiveness{
+			Address:         addr.String(),
+			Proposed:        stats.Proposed,
+			MissedProposals: stats.MissedProposals,
+			Signed:          stats.Signed,
+			MissedSeals:     stats.MissedSeals,
+			Jailed:          policy != nil && policy.IsJailed(stats.Missed()),
+		}
+	}
+
+	return protoLiveness
+}
+
 // votesToProtoVotes converts votes to response of votes
 func votesToProtoVotes(votes []*store.Vote) []*proto.Snapshot_Vote {
 	protoVotes := make([]*proto.Snapshot_Vote, len(votes))
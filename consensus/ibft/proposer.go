@@ -0,0 +1,157 @@
+package ibft
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+)
+
+// stakeSchedule selects the proposers in proportion to the stakes of the validators
+// by the weighted round robin of Tendermint. Every step adds the stakes to the priorities,
+// then the validator with the highest priority proposes and its priority is reduced by the total stake
+type stakeSchedule struct {
+	period     uint64
+	validators validators.Validators
+	stakes     []*big.Int
+	total      *big.Int
+	priorities []*big.Int
+	// proposers are the indexes of the proposers in the steps computed so far
+	proposers []int
+}
+
+// newStakeSchedule creates the schedule of the period, starting with zero priorities
+func newStakeSchedule(
+	period uint64,
+	vals validators.Validators,
+	stakes map[types.Address]*big.Int,
+) *stakeSchedule {
+	s := &stakeSchedule{
+		period:     period,
+		validators: vals,
+		stakes:     make([]*big.Int, vals.Len()),
+		total:      big.NewInt(0),
+		priorities: make([]*big.Int, vals.Len()),
+	}
+
+	for idx := 0; idx < vals.Len(); idx++ {
+		stake := validatorStake(stakes, vals.At(uint64(idx)).Addr())
+
+		s.stakes[idx] = stake
+		s.total.Add(s.total, stake)
+		s.priorities[idx] = big.NewInt(0)
+	}
+
+	return s
+}
+
+// validatorStake returns the stake of the validator, which is zero unless known
+func validatorStake(stakes map[types.Address]*big.Int, addr types.Address) *big.Int {
+	if stake, ok := stakes[addr]; ok && stake.Sign() > 0 {
+		return stake
+	}
+
+	return big.NewInt(0)
+}
+
+// matches returns whether the schedule was created for the period, the validators and their stakes
+func (s *stakeSchedule) matches(
+	period uint64,
+	vals validators.Validators,
+	stakes map[types.Address]*big.Int,
+) bool {
+	if s.period != period || !s.validators.Equal(vals) {
+		return false
+	}
+
+	for idx, stake := range s.stakes {
+		if validatorStake(stakes, vals.At(uint64(idx)).Addr()).Cmp(stake) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// proposer returns the proposer in the step,
+// every validator takes its turn if none of them has a stake
+func (s *stakeSchedule) proposer(step uint64) validators.Validator {
+	if s.total.Sign() == 0 {
+		return s.validators.At(step % uint64(s.validators.Len()))
+	}
+
+	for uint64(len(s.proposers)) <= step {
+		s.proposers = append(s.proposers, s.next())
+	}
+
+	return s.validators.At(uint64(s.proposers[step]))
+}
+
+// next moves the priorities by a step and returns the index of its proposer,
+// the earliest validator in the set wins a tie
+func (s *stakeSchedule) next() int {
+	selected := 0
+
+	for idx, priority := range s.priorities {
+		priority.Add(priority, s.stakes[idx])
+
+		if priority.Cmp(s.priorities[selected]) > 0 {
+			selected = idx
+		}
+	}
+
+	s.priorities[selected].Sub(s.priorities[selected], s.total)
+
+	return selected
+}
+
+// proposerSelector holds the stake-weighted schedule of the latest period
+type proposerSelector struct {
+	sync.Mutex
+
+	schedule *stakeSchedule
+}
+
+// get returns the schedule of the period, creating it unless cached
+func (p *proposerSelector) get(
+	period uint64,
+	vals validators.Validators,
+	stakes map[types.Address]*big.Int,
+) *stakeSchedule {
+	if p.schedule == nil || !p.schedule.matches(period, vals, stakes) {
+		p.schedule = newStakeSchedule(period, vals, stakes)
+	}
+
+	return p.schedule
+}
+
+// calcProposerAt returns the proposer of the round at the height by the proposer selection of the fork.
+// The stake-weighted schedule starts over whenever the validator set is fetched, at the multiples of the epoch size.
+// It doesn't skip the jailed validators, as the liveness policies are only supported by PoA.
+// Every height and every round is a step of the schedule, so the round r at the height h
+// is proposed by the proposer of the round 0 at the height h+r. It is intended, the round changes
+// move to the next proposer of the schedule without tracking the rounds of the previous heights
+func (i *backendIBFT) calcProposerAt(
+	height, round uint64,
+	vals validators.Validators,
+	lastProposer types.Address,
+	jailed validators.Validators,
+) (validators.Validator, error) {
+	if i.forkManager.GetProposerSelection(height) != fork.StakeWeighted {
+		return calcProposer(vals, round, lastProposer, jailed), nil
+	}
+
+	stakes, err := i.forkManager.GetValidatorStakes(height)
+	if err != nil {
+		return nil, err
+	}
+
+	i.proposers.Lock()
+	defer i.proposers.Unlock()
+
+	schedule := i.proposers.get(height/i.epochSize, vals, stakes)
+
+	return schedule.proposer(height%i.epochSize + round), nil
+}
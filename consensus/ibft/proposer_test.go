@@ -0,0 +1,215 @@
+package ibft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStakeScheduleProposer(t *testing.T) {
+	t.Parallel()
+
+	vals := newLivenessTestValidators()
+
+	t.Run("should select the proposers in proportion to the stakes", func(t *testing.T) {
+		t.Parallel()
+
+		schedule := newStakeSchedule(0, vals, map[types.Address]*big.Int{
+			livenessAddr1: big.NewInt(4),
+			livenessAddr2: big.NewInt(2),
+			livenessAddr3: big.NewInt(1),
+			livenessAddr4: big.NewInt(1),
+		})
+
+		proposed := make(map[types.Address]int)
+		for step := uint64(0); step < 80; step++ {
+			proposed[schedule.proposer(step).Addr()]++
+		}
+
+		assert.Equal(t, map[types.Address]int{
+			livenessAddr1: 40,
+			livenessAddr2: 20,
+			livenessAddr3: 10,
+			livenessAddr4: 10,
+		}, proposed)
+
+		// the highest stake proposes first and the earliest validator wins a tie
+		assert.Equal(t, livenessAddr1, schedule.proposer(0).Addr())
+		assert.Equal(t, livenessAddr2, schedule.proposer(1).Addr())
+		assert.Equal(t, livenessAddr1, schedule.proposer(2).Addr())
+		assert.Equal(t, livenessAddr3, schedule.proposer(3).Addr())
+	})
+
+	t.Run("should be deterministic regardless of the order of the queries", func(t *testing.T) {
+		t.Parallel()
+
+		stakes := map[types.Address]*big.Int{
+			livenessAddr1: big.NewInt(3),
+			livenessAddr2: big.NewInt(5),
+			livenessAddr3: big.NewInt(7),
+			livenessAddr4: big.NewInt(11),
+		}
+
+		sequential := newStakeSchedule(0, vals, stakes)
+		for step := uint64(0); step < 30; step++ {
+			sequential.proposer(step)
+		}
+
+		random := newStakeSchedule(0, vals, stakes)
+		for _, step := range []uint64{29, 3, 17, 0, 11} {
+			assert.Equal(t, sequential.proposer(step), random.proposer(step))
+		}
+	})
+
+	t.Run("should never select the validator without stake", func(t *testing.T) {
+		t.Parallel()
+
+		schedule := newStakeSchedule(0, vals, map[types.Address]*big.Int{
+			livenessAddr1: big.NewInt(1),
+			livenessAddr2: big.NewInt(1),
+			livenessAddr3: big.NewInt(1),
+		})
+
+		for step := uint64(0); step < 12; step++ {
+			assert.NotEqual(t, livenessAddr4, schedule.proposer(step).Addr())
+		}
+	})
+
+	t.Run("should take turns if none of the validators has a stake", func(t *testing.T) {
+		t.Parallel()
+
+		schedule := newStakeSchedule(0, vals, map[types.Address]*big.Int{})
+
+		assert.Equal(t, livenessAddr2, schedule.proposer(1).Addr())
+		assert.Equal(t, livenessAddr1, schedule.proposer(4).Addr())
+	})
+}
+
+func TestIBFTBackend_calcProposerAt(t *testing.T) {
+	t.Parallel()
+
+	var (
+		vals   = newLivenessTestValidators()
+		stakes = map[types.Address]*big.Int{
+			livenessAddr1: big.NewInt(1),
+			livenessAddr2: big.NewInt(1),
+			livenessAddr3: big.NewInt(1),
+			livenessAddr4: big.NewInt(5),
+		}
+		stakesQueries = 0
+	)
+
+	i := &backendIBFT{
+		epochSize: 10,
+		proposers: &proposerSelector{},
+		forkManager: &mockForkManager{
+			GetProposerSelectionFunc: func(height uint64) fork.ProposerSelection {
+				if height < 10 {
+					return fork.RoundRobin
+				}
+
+				return fork.StakeWeighted
+			},
+			GetValidatorStakesFunc: func(height uint64) (map[types.Address]*big.Int, error) {
+				stakesQueries++
+
+				if height >= 20 {
+					return nil, errTestProposer
+				}
+
+				return stakes, nil
+			},
+		},
+	}
+
+	noJailed := validators.NewECDSAValidatorSet()
+
+	// round robin before the fork
+	proposer, err := i.calcProposerAt(5, 0, vals, livenessAddr1, noJailed)
+	assert.NoError(t, err)
+	assert.Equal(t, livenessAddr2, proposer.Addr())
+	assert.Equal(t, 0, stakesQueries)
+
+	// the schedule starts over at the beginning of the epoch
+	proposer, err = i.calcProposerAt(10, 0, vals, livenessAddr1, noJailed)
+	assert.NoError(t, err)
+	assert.Equal(t, livenessAddr4, proposer.Addr())
+
+	// the next round is the next step of the schedule
+	proposer, err = i.calcProposerAt(10, 1, vals, livenessAddr1, noJailed)
+	assert.NoError(t, err)
+	assert.Equal(t, livenessAddr1, proposer.Addr())
+
+	proposer, err = i.calcProposerAt(12, 0, vals, livenessAddr1, noJailed)
+	assert.NoError(t, err)
+	assert.Equal(t, livenessAddr4, proposer.Addr())
+
+	// the schedule of the epoch is cached
+	assert.Equal(t, i.proposers.schedule, i.proposers.get(1, vals, stakes))
+
+	_, err = i.calcProposerAt(20, 0, vals, livenessAddr1, noJailed)
+	assert.ErrorIs(t, err, errTestProposer)
+}
+
+func TestIBFTBackend_calcProposerAt_Rounds(t *testing.T) {
+	t.Parallel()
+
+	var (
+		vals   = newLivenessTestValidators()
+		stakes = map[types.Address]*big.Int{
+			livenessAddr1: big.NewInt(1),
+			livenessAddr2: big.NewInt(2),
+			livenessAddr3: big.NewInt(3),
+			livenessAddr4: big.NewInt(4),
+		}
+		noJailed = validators.NewECDSAValidatorSet()
+	)
+
+	newBackend := func() *backendIBFT {
+		return &backendIBFT{
+			epochSize: 100,
+			proposers: &proposerSelector{},
+			forkManager: &mockForkManager{
+				GetProposerSelectionFunc: func(uint64) fork.ProposerSelection {
+					return fork.StakeWeighted
+				},
+				GetValidatorStakesFunc: func(uint64) (map[types.Address]*big.Int, error) {
+					return stakes, nil
+				},
+			},
+		}
+	}
+
+	i := newBackend()
+	schedule := newStakeSchedule(0, vals, stakes)
+
+	for height := uint64(1); height < 10; height++ {
+		for round := uint64(0); round < 5; round++ {
+			proposer, err := i.calcProposerAt(height, round, vals, livenessAddr1, noJailed)
+			assert.NoError(t, err)
+
+			// the round r at the height h is the round 0 at the height h+r
+			next, err := newBackend().calcProposerAt(height+round, 0, vals, livenessAddr1, noJailed)
+			assert.NoError(t, err)
+
+			assert.Equal(t, schedule.proposer(height+round), proposer)
+			assert.Equal(t, next, proposer)
+		}
+	}
+
+	// every validator proposes in the rounds of a height in time
+	proposed := make(map[types.Address]bool)
+
+	for round := uint64(0); round < 10; round++ {
+		proposer, err := i.calcProposerAt(1, round, vals, livenessAddr1, noJailed)
+		assert.NoError(t, err)
+
+		proposed[proposer.Addr()] = true
+	}
+
+	assert.Len(t, proposed, vals.Len())
+}
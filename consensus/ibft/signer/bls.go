@@ -150,6 +150,39 @@ func (s *BLSKeyManager) VerifyCommittedSeals(
 	return verifyBLSCommittedSealsImpl(committedSeal, message, vals)
 }
 
+// CommittedSealSigners returns the validators in the bitmap of the CommittedSeals,
+// whose aggregated signature is expected to be verified already
+func (s *BLSKeyManager) CommittedSealSigners(
+	rawCommittedSeal Seals,
+	_ []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*AggregatedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	if committedSeal.Bitmap == nil {
+		return nil, nil
+	}
+
+	signers := make([]types.Address, 0, vals.Len())
+
+	for idx := 0; idx < committedSeal.Bitmap.BitLen(); idx++ {
+		if committedSeal.Bitmap.Bit(idx) == 0 {
+			continue
+		}
+
+		if idx >= vals.Len() {
+			return nil, ErrValidatorNotFound
+		}
+
+		signers = append(signers, vals.At(uint64(idx)).Addr())
+	}
+
+	return signers, nil
+}
+
 func (s *BLSKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.ecdsaKey, msg)
 }
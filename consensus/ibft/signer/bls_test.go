@@ -587,6 +587,70 @@ func TestBLSKeyManagerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestBLSKeyManagerCommittedSealSigners(t *testing.T) {
+	t.Parallel()
+
+	blsKeyManager1, _, _ := newTestBLSKeyManager(t)
+	blsKeyManager2, _, _ := newTestBLSKeyManager(t)
+	blsKeyManager3, _, _ := newTestBLSKeyManager(t)
+
+	vals := validators.NewBLSValidatorSet(
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager1),
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager2),
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager3),
+	)
+
+	tests := []struct {
+		name              string
+		rawCommittedSeals Seals
+		expectedRes       []types.Address
+		expectedErr       error
+	}{
+		{
+			name:              "should return ErrInvalidCommittedSealType if rawCommittedSeal is not *AggregatedSeal",
+			rawCommittedSeals: &SerializedSeal{},
+			expectedRes:       nil,
+			expectedErr:       ErrInvalidCommittedSealType,
+		},
+		{
+			name: "should return ErrValidatorNotFound if the bitmap is out of the validators",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: big.NewInt(0).SetBit(new(big.Int), 3, 1),
+			},
+			expectedRes: nil,
+			expectedErr: ErrValidatorNotFound,
+		},
+		{
+			name: "should return the validators in the bitmap",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: big.NewInt(0b101),
+			},
+			expectedRes: []types.Address{
+				blsKeyManager1.Address(),
+				blsKeyManager3.Address(),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := blsKeyManager1.CommittedSealSigners(
+				test.rawCommittedSeals,
+				nil,
+				vals,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestBLSKeyManagerSignIBFTMessageAndEcrecover(t *testing.T) {
 	t.Parallel()
 
@@ -129,6 +129,34 @@ func (s *ECDSAKeyManager) VerifyCommittedSeals(
 	return s.verifyCommittedSealsImpl(committedSeal, digest, vals)
 }
 
+func (s *ECDSAKeyManager) CommittedSealSigners(
+	rawCommittedSeal Seals,
+	digest []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*SerializedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	signers := make([]types.Address, 0, committedSeal.Num())
+
+	for _, seal := range *committedSeal {
+		addr, err := s.Ecrecover(seal, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if !vals.Includes(addr) {
+			return nil, ErrNonValidatorCommittedSeal
+		}
+
+		signers = append(signers, addr)
+	}
+
+	return signers, nil
+}
+
 func (s *ECDSAKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.key, msg)
 }
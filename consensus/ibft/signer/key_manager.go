@@ -25,6 +25,8 @@ type KeyManager interface {
 	GenerateCommittedSeals(sealsByValidator map[types.Address][]byte, vals validators.Validators) (Seals, error)
 	// VerifyCommittedSeals verifies CommittedSeals
 	VerifyCommittedSeals(seals Seals, hash []byte, vals validators.Validators) (int, error)
+	// CommittedSealSigners returns the addresses of the validators who signed the CommittedSeals
+	CommittedSealSigners(seals Seals, hash []byte, vals validators.Validators) ([]types.Address, error)
 	// SignIBFTMessage signs for arbitrary bytes message
 	SignIBFTMessage(msg []byte) ([]byte, error)
 	// Ecrecover recovers address from signature and message
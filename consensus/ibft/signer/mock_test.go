@@ -37,6 +37,7 @@ type MockKeyManager struct {
 	VerifyCommittedSealFunc    func(validators.Validators, types.Address, []byte, []byte) error
 	GenerateCommittedSealsFunc func(map[types.Address][]byte, validators.Validators) (Seals, error)
 	VerifyCommittedSealsFunc   func(Seals, []byte, validators.Validators) (int, error)
+	CommittedSealSignersFunc   func(Seals, []byte, validators.Validators) ([]types.Address, error)
 	SignIBFTMessageFunc        func([]byte) ([]byte, error)
 	EcrecoverFunc              func([]byte, []byte) (types.Address, error)
 }
@@ -79,6 +80,14 @@ func (m *MockKeyManager) VerifyCommittedSeals(seals Seals, hash []byte, vals val
 	return m.VerifyCommittedSealsFunc(seals, hash, vals)
 }
 
+func (m *MockKeyManager) CommittedSealSigners(
+	seals Seals,
+	hash []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	return m.CommittedSealSignersFunc(seals, hash, vals)
+}
+
 func (m *MockKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return m.SignIBFTMessageFunc(msg)
 }
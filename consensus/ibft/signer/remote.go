@@ -0,0 +1,365 @@
+package signer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/secrets"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+)
+
+// DefaultRemoteSignerTimeout is the default timeout of the requests to the remote signer
+const DefaultRemoteSignerTimeout = 5 * time.Second
+
+// Endpoints of the remote signer, following the Web3Signer layout.
+// The keys are identified by their hex encoded public keys,
+// and the given data is signed as is, without being hashed again
+const (
+	ecdsaPublicKeysPath = "/api/v1/eth1/publicKeys"
+	ecdsaSignPath       = "/api/v1/eth1/sign/"
+	blsPublicKeysPath   = "/api/v1/eth2/publicKeys"
+	blsSignPath         = "/api/v1/eth2/sign/"
+)
+
+var (
+	ErrRemoteKeyNotFound      = errors.New("key not found in the remote signer")
+	ErrRemoteKeyNotSpecified  = errors.New("the remote signer holds several keys, the key to use is not specified")
+	ErrRemoteSignatureInvalid = errors.New("the remote signer returned an invalid signature")
+)
+
+// RemoteSignerConfig is the configuration of the remote signing service holding the validator keys
+type RemoteSignerConfig struct {
+	// URL is the base URL of the signing service
+	URL string
+	// Address is the address of the ECDSA key, required if the service holds several ECDSA keys
+	Address *types.Address
+	// BLSPublicKey is the BLS public key, required if the service holds several BLS keys
+	BLSPublicKey []byte
+	// Timeout is the timeout of each request to the signing service
+	Timeout time.Duration
+}
+
+// NewRemoteSignerConfig reads the remote signer configuration from the extra fields
+// of the secrets manager configuration. It returns nil if no remote signer is configured
+func NewRemoteSignerConfig(secretsConfig *secrets.SecretsManagerConfig) (*RemoteSignerConfig, error) {
+	if secretsConfig == nil {
+		return nil, nil
+	}
+
+	url, _ := secretsConfig.Extra[secrets.RemoteSignerURL].(string)
+	if url == "" {
+		return nil, nil
+	}
+
+	config := &RemoteSignerConfig{
+		URL:     strings.TrimSuffix(url, "/"),
+		Timeout: DefaultRemoteSignerTimeout,
+	}
+
+	if raw, ok := secretsConfig.Extra[secrets.RemoteSignerAddress].(string); ok && raw != "" {
+		address := types.Address{}
+		if err := address.UnmarshalText([]byte(raw)); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", secrets.RemoteSignerAddress, err)
+		}
+
+		config.Address = &address
+	}
+
+	if raw, ok := secretsConfig.Extra[secrets.RemoteSignerBLSPublicKey].(string); ok && raw != "" {
+		pubkey, err := hex.DecodeHex(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", secrets.RemoteSignerBLSPublicKey, err)
+		}
+
+		config.BLSPublicKey = pubkey
+	}
+
+	if raw, ok := secretsConfig.Extra[secrets.RemoteSignerTimeout].(string); ok && raw != "" {
+		timeout, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", secrets.RemoteSignerTimeout, err)
+		}
+
+		config.Timeout = timeout
+	}
+
+	return config, nil
+}
+
+// RemoteKeyManager is a module that delegates the signing to a remote signing service,
+// so that the validator keys are never held by the node
+type RemoteKeyManager struct {
+	// KeyManager of the validator type, used for everything but signing
+	KeyManager
+
+	client *remoteSignerClient
+
+	address types.Address
+	// ecdsaKeyID and blsKeyID are the identifiers of the keys in the remote signer
+	ecdsaKeyID   string
+	blsKeyID     string
+	blsPublicKey []byte
+}
+
+// NewRemoteKeyManager initializes RemoteKeyManager for the given validator type,
+// after checking the remote signer holds the required keys
+func NewRemoteKeyManager(
+	config *RemoteSignerConfig,
+	validatorType validators.ValidatorType,
+) (KeyManager, error) {
+	m := &RemoteKeyManager{
+		client: newRemoteSignerClient(config.URL, config.Timeout),
+	}
+
+	if err := m.initECDSAKey(config.Address); err != nil {
+		return nil, err
+	}
+
+	switch validatorType {
+	case validators.ECDSAValidatorType:
+		m.KeyManager = &ECDSAKeyManager{address: m.address}
+	case validators.BLSValidatorType:
+		if err := m.initBLSKey(config.BLSPublicKey); err != nil {
+			return nil, err
+		}
+
+		m.KeyManager = &BLSKeyManager{address: m.address}
+	default:
+		return nil, fmt.Errorf("unsupported validator type: %s", validatorType)
+	}
+
+	return m, nil
+}
+
+// Address returns the address of the remote ECDSA key
+func (m *RemoteKeyManager) Address() types.Address {
+	return m.address
+}
+
+// SignProposerSeal signs the given message by the remote ECDSA key for ProposerSeal
+func (m *RemoteKeyManager) SignProposerSeal(message []byte) ([]byte, error) {
+	return m.signECDSA(message)
+}
+
+// SignCommittedSeal signs the given message for committed seal,
+// by the remote BLS key if the validators are BLS validators or by the remote ECDSA key otherwise
+func (m *RemoteKeyManager) SignCommittedSeal(message []byte) ([]byte, error) {
+	if m.Type() == validators.BLSValidatorType {
+		return m.signBLS(message)
+	}
+
+	return m.signECDSA(message)
+}
+
+// SignIBFTMessage signs the given message by the remote ECDSA key
+func (m *RemoteKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
+	return m.signECDSA(msg)
+}
+
+// initECDSAKey looks up the ECDSA key with the given address in the remote signer,
+// or its only key if no address is given
+func (m *RemoteKeyManager) initECDSAKey(address *types.Address) error {
+	keyIDs, err := m.client.publicKeys(ecdsaPublicKeysPath)
+	if err != nil {
+		return err
+	}
+
+	if address == nil && len(keyIDs) > 1 {
+		return ErrRemoteKeyNotSpecified
+	}
+
+	for _, keyID := range keyIDs {
+		raw, err := hex.DecodeHex(keyID)
+		if err != nil {
+			return fmt.Errorf("invalid ECDSA public key %s in the remote signer: %w", keyID, err)
+		}
+
+		// the uncompressed public keys may be returned without their prefix
+		if len(raw) == 64 {
+			raw = append([]byte{0x04}, raw...)
+		}
+
+		pubkey, err := crypto.ParsePublicKey(raw)
+		if err != nil {
+			return fmt.Errorf("invalid ECDSA public key %s in the remote signer: %w", keyID, err)
+		}
+
+		keyAddress := crypto.PubKeyToAddress(pubkey)
+
+		if address == nil || *address == keyAddress {
+			m.address, m.ecdsaKeyID = keyAddress, keyID
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("ECDSA %w", ErrRemoteKeyNotFound)
+}
+
+// initBLSKey looks up the given BLS public key in the remote signer,
+// or its only key if no public key is given
+func (m *RemoteKeyManager) initBLSKey(publicKey []byte) error {
+	keyIDs, err := m.client.publicKeys(blsPublicKeysPath)
+	if err != nil {
+		return err
+	}
+
+	if publicKey == nil && len(keyIDs) > 1 {
+		return ErrRemoteKeyNotSpecified
+	}
+
+	for _, keyID := range keyIDs {
+		raw, err := hex.DecodeHex(keyID)
+		if err != nil {
+			return fmt.Errorf("invalid BLS public key %s in the remote signer: %w", keyID, err)
+		}
+
+		if publicKey != nil && !bytes.Equal(publicKey, raw) {
+			continue
+		}
+
+		if _, err := crypto.UnmarshalBLSPublicKey(raw); err != nil {
+			return fmt.Errorf("invalid BLS public key %s in the remote signer: %w", keyID, err)
+		}
+
+		m.blsKeyID, m.blsPublicKey = keyID, raw
+
+		return nil
+	}
+
+	return fmt.Errorf("BLS %w", ErrRemoteKeyNotFound)
+}
+
+// signECDSA signs the digest by the remote ECDSA key
+// and checks the signature was made by the key
+func (m *RemoteKeyManager) signECDSA(digest []byte) ([]byte, error) {
+	sig, err := m.client.sign(ecdsaSignPath, m.ecdsaKeyID, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sig) != IstanbulExtraSeal {
+		return nil, fmt.Errorf("%w: wrong length %d", ErrRemoteSignatureInvalid, len(sig))
+	}
+
+	// the recovery id may be returned in the Ethereum format
+	if sig[len(sig)-1] >= 27 {
+		sig[len(sig)-1] -= 27
+	}
+
+	signer, err := ecrecover(sig, digest)
+	if err != nil || signer != m.address {
+		return nil, ErrRemoteSignatureInvalid
+	}
+
+	return sig, nil
+}
+
+// signBLS signs the message by the remote BLS key
+// and checks the signature was made by the key
+func (m *RemoteKeyManager) signBLS(message []byte) ([]byte, error) {
+	sig, err := m.client.sign(blsSignPath, m.blsKeyID, message)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := crypto.VerifyBLSSignatureFromBytes(m.blsPublicKey, sig, message); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRemoteSignatureInvalid, err)
+	}
+
+	return sig, nil
+}
+
+// remoteSignerClient is the HTTP client of the remote signer
+type remoteSignerClient struct {
+	url    string
+	client *http.Client
+}
+
+func newRemoteSignerClient(url string, timeout time.Duration) *remoteSignerClient {
+	return &remoteSignerClient{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// publicKeys returns the hex encoded public keys held by the remote signer
+func (c *remoteSignerClient) publicKeys(path string) ([]string, error) {
+	body, err := c.do(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, fmt.Errorf("invalid public keys returned by the remote signer: %w", err)
+	}
+
+	return keys, nil
+}
+
+// sign returns the signature of the data by the key with the given identifier
+func (c *remoteSignerClient) sign(path, keyID string, data []byte) ([]byte, error) {
+	request, err := json.Marshal(map[string]string{
+		"data": hex.EncodeToHex(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(http.MethodPost, path+keyID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := hex.DecodeHex(strings.Trim(strings.TrimSpace(string(body)), `"`))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRemoteSignatureInvalid, err)
+	}
+
+	return sig, nil
+}
+
+func (c *remoteSignerClient) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"remote signer request failed with status %d: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(resBody)),
+		)
+	}
+
+	return resBody, nil
+}
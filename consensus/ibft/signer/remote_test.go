@@ -0,0 +1,437 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/secrets"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/coinbase/kryptology/pkg/signatures/bls/bls_sig"
+	"github.com/stretchr/testify/assert"
+)
+
+// testRemoteSigner is an in-process stand-in of the remote signing service
+type testRemoteSigner struct {
+	ecdsaKeys map[string]*ecdsa.PrivateKey
+	blsKeys   map[string]*bls_sig.SecretKey
+
+	// signingKey overrides the ECDSA key signing the requests if set
+	signingKey *ecdsa.PrivateKey
+	// delay is the delay of the responses
+	delay time.Duration
+
+	lock sync.Mutex
+}
+
+func (s *testRemoteSigner) addECDSAKey(key *ecdsa.PrivateKey) string {
+	// the public keys are returned without the uncompressed prefix, as Web3Signer does
+	keyID := hex.EncodeToHex(crypto.MarshalPublicKey(&key.PublicKey)[1:])
+
+	s.lock.Lock()
+	s.ecdsaKeys[keyID] = key
+	s.lock.Unlock()
+
+	return keyID
+}
+
+func (s *testRemoteSigner) addBLSKey(t *testing.T, key *bls_sig.SecretKey) []byte {
+	t.Helper()
+
+	pubkey, err := crypto.BLSSecretKeyToPubkeyBytes(key)
+	assert.NoError(t, err)
+
+	s.lock.Lock()
+	s.blsKeys[hex.EncodeToHex(pubkey)] = key
+	s.lock.Unlock()
+
+	return pubkey
+}
+
+func (s *testRemoteSigner) setSigningKey(key *ecdsa.PrivateKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.signingKey = key
+}
+
+func (s *testRemoteSigner) setDelay(delay time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.delay = delay
+}
+
+func (s *testRemoteSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	delay := s.delay
+	s.lock.Unlock()
+
+	time.Sleep(delay)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == ecdsaPublicKeysPath:
+		keys := []string{}
+		for keyID := range s.ecdsaKeys {
+			keys = append(keys, keyID)
+		}
+
+		_ = json.NewEncoder(w).Encode(keys)
+	case r.Method == http.MethodGet && r.URL.Path == blsPublicKeysPath:
+		keys := []string{}
+		for keyID := range s.blsKeys {
+			keys = append(keys, keyID)
+		}
+
+		_ = json.NewEncoder(w).Encode(keys)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, ecdsaSignPath):
+		key, ok := s.ecdsaKeys[strings.TrimPrefix(r.URL.Path, ecdsaSignPath)]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		if s.signingKey != nil {
+			key = s.signingKey
+		}
+
+		sig, err := crypto.Sign(key, s.readData(r))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		// the recovery id is returned in the Ethereum format
+		sig[len(sig)-1] += 27
+
+		_, _ = w.Write([]byte(hex.EncodeToHex(sig)))
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, blsSignPath):
+		key, ok := s.blsKeys[strings.TrimPrefix(r.URL.Path, blsSignPath)]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		sig, err := crypto.SignByBLS(key, s.readData(r))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(hex.EncodeToHex(sig)))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *testRemoteSigner) readData(r *http.Request) []byte {
+	var req struct {
+		Data string `json:"data"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil
+	}
+
+	data, _ := hex.DecodeHex(req.Data)
+
+	return data
+}
+
+func newTestRemoteSigner(t *testing.T) (*testRemoteSigner, *RemoteSignerConfig) {
+	t.Helper()
+
+	remoteSigner := &testRemoteSigner{
+		ecdsaKeys: map[string]*ecdsa.PrivateKey{},
+		blsKeys:   map[string]*bls_sig.SecretKey{},
+	}
+
+	server := httptest.NewServer(remoteSigner)
+	t.Cleanup(server.Close)
+
+	return remoteSigner, &RemoteSignerConfig{
+		URL:     server.URL,
+		Timeout: DefaultRemoteSignerTimeout,
+	}
+}
+
+func TestNewRemoteSignerConfig(t *testing.T) {
+	t.Parallel()
+
+	address := types.StringToAddress("1")
+
+	tests := []struct {
+		name     string
+		extra    map[string]interface{}
+		expected *RemoteSignerConfig
+		err      bool
+	}{
+		{
+			name:  "should return nil without remote signer URL",
+			extra: map[string]interface{}{},
+		},
+		{
+			name: "should return the configuration with the default timeout",
+			extra: map[string]interface{}{
+				secrets.RemoteSignerURL: "http://127.0.0.1:9000/",
+			},
+			expected: &RemoteSignerConfig{
+				URL:     "http://127.0.0.1:9000",
+				Timeout: DefaultRemoteSignerTimeout,
+			},
+		},
+		{
+			name: "should return the configuration with the keys and timeout",
+			extra: map[string]interface{}{
+				secrets.RemoteSignerURL:          "http://127.0.0.1:9000",
+				secrets.RemoteSignerAddress:      address.String(),
+				secrets.RemoteSignerBLSPublicKey: "0x0102",
+				secrets.RemoteSignerTimeout:      "500ms",
+			},
+			expected: &RemoteSignerConfig{
+				URL:          "http://127.0.0.1:9000",
+				Address:      &address,
+				BLSPublicKey: []byte{0x1, 0x2},
+				Timeout:      500 * time.Millisecond,
+			},
+		},
+		{
+			name: "should return error for invalid timeout",
+			extra: map[string]interface{}{
+				secrets.RemoteSignerURL:     "http://127.0.0.1:9000",
+				secrets.RemoteSignerTimeout: "soon",
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := NewRemoteSignerConfig(&secrets.SecretsManagerConfig{
+				Type:  secrets.Local,
+				Extra: test.extra,
+			})
+
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, config)
+		})
+	}
+}
+
+func TestRemoteKeyManager_ECDSA(t *testing.T) {
+	t.Parallel()
+
+	remoteSigner, config := newTestRemoteSigner(t)
+
+	testKey, _ := newTestECDSAKey(t)
+	remoteSigner.addECDSAKey(testKey)
+
+	keyManager, err := NewRemoteKeyManager(config, validators.ECDSAValidatorType)
+	assert.NoError(t, err)
+
+	address := crypto.PubKeyToAddress(&testKey.PublicKey)
+
+	assert.Equal(t, validators.ECDSAValidatorType, keyManager.Type())
+	assert.Equal(t, address, keyManager.Address())
+
+	digest := crypto.Keccak256([]byte("message"))
+
+	for _, sign := range []func([]byte) ([]byte, error){
+		keyManager.SignProposerSeal,
+		keyManager.SignCommittedSeal,
+		keyManager.SignIBFTMessage,
+	} {
+		sig, err := sign(digest)
+		assert.NoError(t, err)
+
+		expected, err := crypto.Sign(testKey, digest)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sig)
+
+		signer, err := keyManager.Ecrecover(sig, digest)
+		assert.NoError(t, err)
+		assert.Equal(t, address, signer)
+	}
+
+	// the verification is done locally
+	sig, err := keyManager.SignCommittedSeal(digest)
+	assert.NoError(t, err)
+
+	assert.NoError(t, keyManager.VerifyCommittedSeal(
+		validators.NewECDSAValidatorSet(validators.NewECDSAValidator(address)),
+		address,
+		sig,
+		digest,
+	))
+}
+
+func TestRemoteKeyManager_BLS(t *testing.T) {
+	t.Parallel()
+
+	remoteSigner, config := newTestRemoteSigner(t)
+
+	ecdsaKey, _ := newTestECDSAKey(t)
+	blsKey, _ := newTestBLSKey(t)
+
+	remoteSigner.addECDSAKey(ecdsaKey)
+	blsPubkey := remoteSigner.addBLSKey(t, blsKey)
+
+	keyManager, err := NewRemoteKeyManager(config, validators.BLSValidatorType)
+	assert.NoError(t, err)
+
+	address := crypto.PubKeyToAddress(&ecdsaKey.PublicKey)
+
+	assert.Equal(t, validators.BLSValidatorType, keyManager.Type())
+	assert.Equal(t, address, keyManager.Address())
+
+	digest := crypto.Keccak256([]byte("message"))
+
+	proposerSeal, err := keyManager.SignProposerSeal(digest)
+	assert.NoError(t, err)
+
+	signer, err := keyManager.Ecrecover(proposerSeal, digest)
+	assert.NoError(t, err)
+	assert.Equal(t, address, signer)
+
+	committedSeal, err := keyManager.SignCommittedSeal(digest)
+	assert.NoError(t, err)
+
+	assert.NoError(t, keyManager.VerifyCommittedSeal(
+		validators.NewBLSValidatorSet(validators.NewBLSValidator(address, blsPubkey)),
+		address,
+		committedSeal,
+		digest,
+	))
+}
+
+func TestRemoteKeyManager_KeyLookup(t *testing.T) {
+	t.Parallel()
+
+	firstKey, _ := newTestECDSAKey(t)
+	secondKey, _ := newTestECDSAKey(t)
+	secondAddress := crypto.PubKeyToAddress(&secondKey.PublicKey)
+	unknownAddress := types.StringToAddress("1")
+
+	tests := []struct {
+		name    string
+		keys    []*ecdsa.PrivateKey
+		address *types.Address
+		err     error
+	}{
+		{
+			name:    "should use the key with the given address",
+			keys:    []*ecdsa.PrivateKey{firstKey, secondKey},
+			address: &secondAddress,
+		},
+		{
+			name: "should return error if the key to use is not specified",
+			keys: []*ecdsa.PrivateKey{firstKey, secondKey},
+			err:  ErrRemoteKeyNotSpecified,
+		},
+		{
+			name:    "should return error if the key is not found",
+			keys:    []*ecdsa.PrivateKey{firstKey},
+			address: &unknownAddress,
+			err:     ErrRemoteKeyNotFound,
+		},
+		{
+			name: "should return error if there are no keys",
+			err:  ErrRemoteKeyNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			remoteSigner, config := newTestRemoteSigner(t)
+			for _, key := range test.keys {
+				remoteSigner.addECDSAKey(key)
+			}
+
+			config.Address = test.address
+
+			keyManager, err := NewRemoteKeyManager(config, validators.ECDSAValidatorType)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, *test.address, keyManager.Address())
+		})
+	}
+}
+
+func TestRemoteKeyManager_InvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	remoteSigner, config := newTestRemoteSigner(t)
+
+	testKey, _ := newTestECDSAKey(t)
+	remoteSigner.addECDSAKey(testKey)
+
+	keyManager, err := NewRemoteKeyManager(config, validators.ECDSAValidatorType)
+	assert.NoError(t, err)
+
+	// the service signs by another key
+	otherKey, _ := newTestECDSAKey(t)
+	remoteSigner.setSigningKey(otherKey)
+
+	sig, err := keyManager.SignProposerSeal(crypto.Keccak256([]byte("message")))
+	assert.Nil(t, sig)
+	assert.ErrorIs(t, err, ErrRemoteSignatureInvalid)
+}
+
+func TestRemoteKeyManager_Timeout(t *testing.T) {
+	t.Parallel()
+
+	remoteSigner, config := newTestRemoteSigner(t)
+
+	testKey, _ := newTestECDSAKey(t)
+	remoteSigner.addECDSAKey(testKey)
+
+	keyManager, err := NewRemoteKeyManager(config, validators.ECDSAValidatorType)
+	assert.NoError(t, err)
+
+	remoteSigner.setDelay(200 * time.Millisecond)
+	config.Timeout = 50 * time.Millisecond
+
+	// the timeout is applied at startup
+	_, err = NewRemoteKeyManager(config, validators.ECDSAValidatorType)
+	assert.Error(t, err)
+
+	// and when signing
+	keyManager.(*RemoteKeyManager).client = newRemoteSignerClient(config.URL, config.Timeout) //nolint:forcetypeassert
+
+	_, err = keyManager.SignProposerSeal(crypto.Keccak256([]byte("message")))
+	assert.Error(t, err)
+}
@@ -49,6 +49,11 @@ type Signer interface {
 		quorumSize int,
 	) error
 
+	GetCommittedSealSigners(
+		header *types.Header,
+		validators validators.Validators,
+	) ([]types.Address, error)
+
 	// ParentCommittedSeals
 	VerifyParentCommittedSeals(
 		parent, header *types.Header,
@@ -56,6 +61,10 @@ type Signer interface {
 		quorum int,
 		mustExist bool,
 	) error
+	GetParentCommittedSealSigners(
+		header *types.Header,
+		parentValidators validators.Validators,
+	) ([]types.Address, error)
 
 	// IBFTMessage
 	SignIBFTMessage([]byte) ([]byte, error)
@@ -254,6 +263,29 @@ func (s *SignerImpl) VerifyCommittedSeals(
 	return nil
 }
 
+// GetCommittedSealSigners returns the addresses of the validators
+// who signed the CommittedSeals in IBFT Extra of the header
+func (s *SignerImpl) GetCommittedSealSigners(
+	header *types.Header,
+	validators validators.Validators,
+) ([]types.Address, error) {
+	extra, err := s.GetIBFTExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.CalculateHeaderHash(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMsg := crypto.Keccak256(
+		wrapCommitHash(hash[:]),
+	)
+
+	return s.keyManager.CommittedSealSigners(extra.CommittedSeals, rawMsg, validators)
+}
+
 // VerifyParentCommittedSeals verifies ParentCommittedSeals in IBFT Extra of the header
 func (s *SignerImpl) VerifyParentCommittedSeals(
 	parent, header *types.Header,
@@ -297,6 +329,28 @@ func (s *SignerImpl) VerifyParentCommittedSeals(
 	return nil
 }
 
+// GetParentCommittedSealSigners returns the addresses of the validators
+// who signed the ParentCommittedSeals in IBFT Extra of the header
+func (s *SignerImpl) GetParentCommittedSealSigners(
+	header *types.Header,
+	parentValidators validators.Validators,
+) ([]types.Address, error) {
+	parentCommittedSeals, err := s.GetParentCommittedSeals(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentCommittedSeals == nil || parentCommittedSeals.Num() == 0 {
+		return nil, nil
+	}
+
+	rawMsg := crypto.Keccak256(
+		wrapCommitHash(header.ParentHash.Bytes()),
+	)
+
+	return s.keyManager.CommittedSealSigners(parentCommittedSeals, rawMsg, parentValidators)
+}
+
 // SignIBFTMessage signs arbitrary message
 func (s *SignerImpl) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return s.keyManager.SignIBFTMessage(crypto.Keccak256(msg))
@@ -959,6 +959,53 @@ func TestSignerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestSignerGetCommittedSealSigners(t *testing.T) {
+	header := &types.Header{
+		Number: 1,
+		ExtraData: getTestExtraBytes(
+			ecdsaValidators,
+			testProposerSeal,
+			testSerializedSeals1,
+			nil,
+		),
+	}
+
+	var expectedSig []byte
+
+	signer := newTestSingleKeyManagerSigner(&MockKeyManager{
+		NewEmptyValidatorsFunc: func() validators.Validators {
+			return ecdsaValidators
+		},
+		NewEmptyCommittedSealsFunc: func() Seals {
+			return &SerializedSeal{}
+		},
+		CommittedSealSignersFunc: func(s Seals, b []byte, v validators.Validators) ([]types.Address, error) {
+			assert.Equal(t, testSerializedSeals1, s)
+			assert.Equal(t, ecdsaValidators, v)
+			assert.Equal(t, expectedSig, b)
+
+			return []types.Address{ecdsaValidator1.Address}, nil
+		},
+	})
+
+	UseIstanbulHeaderHashInTest(t, signer)
+
+	expectedSig = crypto.Keccak256(
+		wrapCommitHash(
+			header.ComputeHash().Hash.Bytes(),
+		),
+	)
+
+	signers, err := signer.GetCommittedSealSigners(header, ecdsaValidators)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{ecdsaValidator1.Address}, signers)
+
+	// the header without IBFT Extra has no signers
+	_, err = signer.GetCommittedSealSigners(&types.Header{}, ecdsaValidators)
+	assert.Error(t, err)
+}
+
 func TestSignerVerifyParentCommittedSeals(t *testing.T) {
 	t.Parallel()
 
@@ -1139,6 +1186,79 @@ func TestSignerVerifyParentCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestSignerGetParentCommittedSealSigners(t *testing.T) {
+	t.Parallel()
+
+	parentHash := types.BytesToHash(crypto.Keccak256(types.ZeroAddress.Bytes()))
+
+	tests := []struct {
+		name        string
+		header      *types.Header
+		signersRes  []types.Address
+		expectedRes []types.Address
+	}{
+		{
+			name: "should return nil if header doesn't have ParentCommittedSeals",
+			header: &types.Header{
+				ParentHash: parentHash,
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					nil,
+				),
+			},
+			expectedRes: nil,
+		},
+		{
+			name: "should return the signers of ParentCommittedSeals",
+			header: &types.Header{
+				ParentHash: parentHash,
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					testSerializedSeals2,
+				),
+			},
+			signersRes:  []types.Address{ecdsaValidator1.Address},
+			expectedRes: []types.Address{ecdsaValidator1.Address},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			expectedSig := crypto.Keccak256(
+				wrapCommitHash(
+					parentHash.Bytes(),
+				),
+			)
+
+			signer := newTestSingleKeyManagerSigner(&MockKeyManager{
+				NewEmptyCommittedSealsFunc: func() Seals {
+					return &SerializedSeal{}
+				},
+				CommittedSealSignersFunc: func(s Seals, b []byte, v validators.Validators) ([]types.Address, error) {
+					assert.Equal(t, testSerializedSeals2, s)
+					assert.Equal(t, ecdsaValidators, v)
+					assert.Equal(t, expectedSig, b)
+
+					return test.signersRes, nil
+				},
+			})
+
+			signers, err := signer.GetParentCommittedSealSigners(test.header, ecdsaValidators)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedRes, signers)
+		})
+	}
+}
+
 func TestSignerSignIBFTMessage(t *testing.T) {
 	t.Parallel()
 
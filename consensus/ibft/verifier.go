@@ -6,6 +6,7 @@ import (
 
 	"github.com/0xPolygon/go-ibft/messages"
 	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/LaChain/polygon-edge/consensus/ibft/fork"
 	"github.com/LaChain/polygon-edge/types"
 )
 
@@ -125,11 +126,25 @@ func (i *backendIBFT) IsProposer(id []byte, height, round uint64) bool {
 		return false
 	}
 
-	nextProposer := CalcProposer(
-		i.currentValidators,
+	jailed, err := i.getJailedValidators(height, i.currentValidators, fork.LivenessSkip)
+	if err != nil {
+		i.logger.Error("failed to get the jailed validators", "height", height, "err", err)
+
+		return false
+	}
+
+	nextProposer, err := i.calcProposerAt(
+		height,
 		round,
+		i.currentValidators,
 		previousProposer,
+		jailed,
 	)
+	if err != nil {
+		i.logger.Error("failed to calculate the proposer", "height", height, "round", round, "err", err)
+
+		return false
+	}
 
 	return types.BytesToAddress(id) == nextProposer.Addr()
 }
@@ -8,6 +8,9 @@ var (
 	// ABI for Staking Contract
 	StakingABI = abi.MustNewABI(StakingJSONABI)
 
+	// ABI for Access Control Contract
+	AccessControlABI = abi.MustNewABI(AccessControlJSONABI)
+
 	// ABI for Contract used in e2e stress test
 	StressTestABI = abi.MustNewABI(StressTestJSONABI)
 
@@ -0,0 +1,106 @@
+package accesscontrol
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/LaChain/polygon-edge/helper/common"
+	"github.com/LaChain/polygon-edge/helper/keccak"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+// Role is a role granted to the accounts by the access control contract
+type Role uint64
+
+const (
+	// RoleAdmin can grant and revoke the roles, and is allowed to send transactions and deploy contracts
+	RoleAdmin Role = iota
+	// RoleDeployer is allowed to deploy contracts
+	RoleDeployer
+	// RoleSender is allowed to send transactions
+	RoleSender
+)
+
+var (
+	// access control contract address
+	AddrAccessControlContract = types.StringToAddress("1002")
+
+	ErrSenderRoleMissing   = errors.New("sender role not granted by the access control contract")
+	ErrDeployerRoleMissing = errors.New("deployer role not granted by the access control contract")
+)
+
+// Slot definitions for SC storage
+var (
+	membersSlot     = int64(0) // mapping(uint256 => mapping(address => bool))
+	memberCountSlot = int64(1) // mapping(uint256 => uint256)
+)
+
+// StorageReader is an interface to read the storage of the contract
+type StorageReader interface {
+	GetStorage(addr types.Address, key types.Hash) types.Hash
+}
+
+// MemberIndex returns the storage index of the membership of the account in the role
+//
+// More information:
+// https://docs.soliditylang.org/en/latest/internals/layout_in_storage.html
+func MemberIndex(role Role, account types.Address) types.Hash {
+	roleIndex := keccak.Keccak256(nil, append(
+		common.PadLeftOrTrim(new(big.Int).SetUint64(uint64(role)).Bytes(), 32),
+		common.PadLeftOrTrim(big.NewInt(membersSlot).Bytes(), 32)...,
+	))
+
+	return types.BytesToHash(keccak.Keccak256(nil, append(
+		common.PadLeftOrTrim(account.Bytes(), 32),
+		roleIndex...,
+	)))
+}
+
+// MemberCountIndex returns the storage index of the number of accounts granted the role
+func MemberCountIndex(role Role) types.Hash {
+	return types.BytesToHash(keccak.Keccak256(nil, append(
+		common.PadLeftOrTrim(new(big.Int).SetUint64(uint64(role)).Bytes(), 32),
+		common.PadLeftOrTrim(big.NewInt(memberCountSlot).Bytes(), 32)...,
+	)))
+}
+
+// HasRole returns true if the account is granted the role by the contract
+func HasRole(r StorageReader, contract types.Address, role Role, account types.Address) bool {
+	return r.GetStorage(contract, MemberIndex(role, account)) != types.ZeroHash
+}
+
+// MemberCount returns the number of accounts granted the role by the contract
+func MemberCount(r StorageReader, contract types.Address, role Role) uint64 {
+	return new(big.Int).SetBytes(r.GetStorage(contract, MemberCountIndex(role)).Bytes()).Uint64()
+}
+
+// IsAllowed returns true if the account is allowed to act in the role.
+// As with the whitelists, anyone is allowed if the role is not granted to any account
+func IsAllowed(r StorageReader, contract types.Address, role Role, account types.Address) bool {
+	return MemberCount(r, contract, role) == 0 ||
+		HasRole(r, contract, role, account) ||
+		HasRole(r, contract, RoleAdmin, account)
+}
+
+// ValidateTx checks if the contract allows the sender to send the transaction,
+// create is true for contract creations
+func ValidateTx(r StorageReader, contract types.Address, from types.Address, create bool) error {
+	if !IsAllowed(r, contract, RoleSender, from) {
+		return ErrSenderRoleMissing
+	}
+
+	if create {
+		return ValidateCreate(r, contract, from)
+	}
+
+	return nil
+}
+
+// ValidateCreate checks if the contract allows the creator to deploy contracts
+func ValidateCreate(r StorageReader, contract types.Address, creator types.Address) error {
+	if !IsAllowed(r, contract, RoleDeployer, creator) {
+		return ErrDeployerRoleMissing
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package accesscontrol
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addr1 = types.StringToAddress("1")
+	addr2 = types.StringToAddress("2")
+	addr3 = types.StringToAddress("3")
+)
+
+type storageMock map[types.Hash]types.Hash
+
+func (m storageMock) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	if addr != AddrAccessControlContract {
+		return types.ZeroHash
+	}
+
+	return m[key]
+}
+
+// newStorageMock returns the storage of the contract granting the roles to the accounts
+func newStorageMock(roles map[Role][]types.Address) storageMock {
+	storage := storageMock{}
+
+	for role, accounts := range roles {
+		for _, account := range accounts {
+			storage[MemberIndex(role, account)] = types.BytesToHash(big.NewInt(1).Bytes())
+		}
+
+		storage[MemberCountIndex(role)] = types.BytesToHash(big.NewInt(int64(len(accounts))).Bytes())
+	}
+
+	return storage
+}
+
+func TestMemberCount(t *testing.T) {
+	t.Parallel()
+
+	storage := newStorageMock(map[Role][]types.Address{
+		RoleSender: {addr1, addr2},
+	})
+
+	assert.Equal(t, uint64(2), MemberCount(storage, AddrAccessControlContract, RoleSender))
+	assert.Equal(t, uint64(0), MemberCount(storage, AddrAccessControlContract, RoleDeployer))
+	assert.True(t, HasRole(storage, AddrAccessControlContract, RoleSender, addr2))
+	assert.False(t, HasRole(storage, AddrAccessControlContract, RoleDeployer, addr2))
+}
+
+func TestValidateTx(t *testing.T) {
+	t.Parallel()
+
+	storage := newStorageMock(map[Role][]types.Address{
+		RoleAdmin:    {addr1},
+		RoleSender:   {addr2},
+		RoleDeployer: {addr2},
+	})
+
+	tests := []struct {
+		name   string
+		from   types.Address
+		create bool
+		err    error
+	}{
+		{"should allow the granted sender", addr2, false, nil},
+		{"should allow the granted deployer", addr2, true, nil},
+		{"should allow the admin", addr1, true, nil},
+		{"should reject the sender without role", addr3, false, ErrSenderRoleMissing},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, ValidateTx(storage, AddrAccessControlContract, test.from, test.create), test.err)
+		})
+	}
+
+	t.Run("should reject the deployer without role", func(t *testing.T) {
+		t.Parallel()
+
+		storage := newStorageMock(map[Role][]types.Address{
+			RoleDeployer: {addr2},
+		})
+
+		assert.ErrorIs(t, ValidateTx(storage, AddrAccessControlContract, addr3, true), ErrDeployerRoleMissing)
+		assert.NoError(t, ValidateTx(storage, AddrAccessControlContract, addr3, false))
+	})
+}
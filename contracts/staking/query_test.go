@@ -9,6 +9,7 @@ import (
 	"github.com/LaChain/polygon-edge/state/runtime"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/ethgo"
 )
 
 var (
@@ -220,3 +221,40 @@ func TestQueryValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAccountStake(t *testing.T) {
+	method := abis.StakingABI.Methods["accountStake"]
+	assert.NotNil(t, method)
+
+	input, err := method.Encode([]interface{}{ethgo.Address(addr2)})
+	assert.NoError(t, err)
+
+	tx := &types.Transaction{
+		From:     addr1,
+		To:       &AddrStakingContract,
+		Value:    big.NewInt(0),
+		Input:    input,
+		GasPrice: big.NewInt(0),
+		Gas:      queryGasLimit,
+		Nonce:    10,
+	}
+
+	mock := &TxMock{
+		hashToRes: map[types.Hash]*runtime.ExecutionResult{
+			tx.ComputeHash().Hash: {
+				ReturnValue: leftPad(big.NewInt(1e18).Bytes(), 32),
+			},
+		},
+		nonce: map[types.Address]uint64{
+			addr1: 10,
+		},
+	}
+
+	stake, err := QueryAccountStake(mock, addr1, addr2)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e18), stake)
+
+	// the query of another account is not found
+	_, err = QueryAccountStake(mock, addr1, addr1)
+	assert.Error(t, err)
+}
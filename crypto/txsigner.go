@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/bits"
@@ -12,6 +13,10 @@ import (
 	"github.com/umbracle/fastrlp"
 )
 
+var (
+	ErrInvalidChainID = errors.New("invalid chain id for signer")
+)
+
 // TxSigner is a utility interface used to recover data from a transaction
 type TxSigner interface {
 	// Hash returns the hash of the transaction
@@ -27,11 +32,13 @@ type TxSigner interface {
 	CalculateV(parity byte) []byte
 }
 
-// NewSigner creates a new signer object (EIP155 or FrontierSigner)
+// NewSigner creates a new signer object (London, EIP155 or FrontierSigner)
 func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
 	var signer TxSigner
 
-	if forks.EIP155 {
+	if forks.London || forks.Berlin {
+		signer = NewLondonSigner(chainID)
+	} else if forks.EIP155 {
 		signer = &EIP155Signer{chainID: chainID}
 	} else {
 		signer = &FrontierSigner{}
@@ -227,6 +234,123 @@ func (e *EIP155Signer) CalculateV(parity byte) []byte {
 	return reference.Bytes()
 }
 
+// NewLondonSigner returns a new LondonSigner object
+func NewLondonSigner(chainID uint64) *LondonSigner {
+	return &LondonSigner{
+		EIP155Signer: EIP155Signer{chainID: chainID},
+	}
+}
+
+// LondonSigner is a signer that supports typed transactions, access list (EIP-2930)
+// and dynamic fee (EIP-1559) ones, on top of the legacy ones handled by the EIP155Signer
+type LondonSigner struct {
+	EIP155Signer
+}
+
+// Hash returns the hash of the transaction to be signed
+func (l *LondonSigner) Hash(tx *types.Transaction) types.Hash {
+	if tx.Type == types.LegacyTx {
+		return l.EIP155Signer.Hash(tx)
+	}
+
+	return calcTypedTxHash(tx, l.chainID)
+}
+
+// Sender returns the transaction sender
+func (l *LondonSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if tx.Type == types.LegacyTx {
+		return l.EIP155Signer.Sender(tx)
+	}
+
+	if tx.ChainID == nil || tx.ChainID.Uint64() != l.chainID {
+		return types.Address{}, ErrInvalidChainID
+	}
+
+	// V is the signature parity for typed transactions
+	var parity byte
+	if tx.V != nil {
+		parity = byte(tx.V.Uint64())
+	}
+
+	sig, err := encodeSignature(tx.R, tx.S, parity)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(l.Hash(tx).Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
+// SignTx signs the transaction using the passed in private key
+func (l *LondonSigner) SignTx(
+	tx *types.Transaction,
+	privateKey *ecdsa.PrivateKey,
+) (*types.Transaction, error) {
+	if tx.Type == types.LegacyTx {
+		return l.EIP155Signer.SignTx(tx, privateKey)
+	}
+
+	tx = tx.Copy()
+
+	if tx.ChainID == nil {
+		tx.ChainID = new(big.Int).SetUint64(l.chainID)
+	}
+
+	h := l.Hash(tx)
+
+	sig, err := Sign(privateKey, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes([]byte{sig[64]})
+
+	return tx, nil
+}
+
+// calcTypedTxHash calculates the signing hash of a typed transaction,
+// keccak256(type || RLP payload without the signature values)
+func calcTypedTxHash(tx *types.Transaction, chainID uint64) types.Hash {
+	a := signerPool.Get()
+
+	v := a.NewArray()
+	v.Set(a.NewUint(chainID))
+	v.Set(a.NewUint(tx.Nonce))
+
+	if tx.Type == types.DynamicFeeTx {
+		v.Set(a.NewBigInt(tx.GasTipCap))
+		v.Set(a.NewBigInt(tx.GasFeeCap))
+	} else {
+		v.Set(a.NewBigInt(tx.GasPrice))
+	}
+
+	v.Set(a.NewUint(tx.Gas))
+
+	if tx.To == nil {
+		v.Set(a.NewNull())
+	} else {
+		v.Set(a.NewCopyBytes((*tx.To).Bytes()))
+	}
+
+	v.Set(a.NewBigInt(tx.Value))
+	v.Set(a.NewCopyBytes(tx.Input))
+	v.Set(tx.AccessList.MarshalRLPWith(a))
+
+	hash := keccak.Keccak256(nil, v.MarshalTo([]byte{byte(tx.Type)}))
+
+	signerPool.Put(a)
+
+	return types.BytesToHash(hash)
+}
+
 // encodeSignature generates a signature value based on the R, S and V value
 func encodeSignature(R, S *big.Int, V byte) ([]byte, error) {
 	if !ValidateSignatureValues(V, R, S) {
@@ -143,3 +143,69 @@ func TestEIP155Signer_ChainIDMismatch(t *testing.T) {
 		}
 	}
 }
+
+func TestLondonSigner_DynamicFeeTx(t *testing.T) {
+	t.Parallel()
+
+	signer := NewLondonSigner(100)
+	toAddress := types.StringToAddress("1")
+
+	key, err := GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	txn := &types.Transaction{
+		Type:      types.DynamicFeeTx,
+		To:        &toAddress,
+		Value:     big.NewInt(10),
+		GasPrice:  big.NewInt(0),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+	}
+
+	signedTx, err := signer.SignTx(txn, key)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), signedTx.ChainID.Uint64())
+
+	from, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), from)
+
+	// the chain ID is part of the signed payload
+	_, err = NewLondonSigner(200).Sender(signedTx)
+	assert.ErrorIs(t, err, ErrInvalidChainID)
+}
+
+func TestLondonSigner_AccessListTx(t *testing.T) {
+	t.Parallel()
+
+	signer := NewLondonSigner(100)
+	toAddress := types.StringToAddress("1")
+
+	key, err := GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	txn := &types.Transaction{
+		Type:     types.AccessListTx,
+		To:       &toAddress,
+		Value:    big.NewInt(10),
+		GasPrice: big.NewInt(1),
+		AccessList: types.TxAccessList{
+			{Address: toAddress, StorageKeys: []types.Hash{types.StringToHash("1")}},
+		},
+	}
+
+	signedTx, err := signer.SignTx(txn, key)
+	assert.NoError(t, err)
+
+	from, err := signer.Sender(signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), from)
+
+	// the access list is part of the signed payload
+	signedTx.AccessList[0].StorageKeys = nil
+
+	from, err = signer.Sender(signedTx)
+	if err == nil {
+		assert.NotEqual(t, PubKeyToAddress(&key.PublicKey), from)
+	}
+}
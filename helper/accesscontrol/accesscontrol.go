@@ -0,0 +1,52 @@
+package accesscontrol
+
+import (
+	"math/big"
+
+	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	// AccessControlSCBytecode is the runtime bytecode of the access control contract,
+	// which has the same interface and storage layout as the following contract:
+	//
+	//	contract AccessControl {
+	//	    mapping(uint256 => mapping(address => bool)) public hasRole;
+	//	    mapping(uint256 => uint256) public memberCount;
+	//
+	//	    function grantRole(uint256 role, address account) external; // only admins
+	//	    function revokeRole(uint256 role, address account) external; // only admins
+	//	}
+	//
+	//nolint: lll
+	AccessControlSCBytecode = "0x3461005c576004361061005c576000357c010000000000000000000000000000000000000000000000000000000090048063ec2606c0146100615780636e8165e8146100a75780631fe5f589146100c95780631d0b19e714610149575b600080fd5b6044361061005c576004356000526000602052604060002060205260243573ffffffffffffffffffffffffffffffffffffffff1660005260406000205460005260206000f35b6024361061005c57600435600052600160205260406000205460005260206000f35b6044361061005c57600060005260006020526040600020602052336000526040600020541561005c576004356000526000602052604060002060205260243573ffffffffffffffffffffffffffffffffffffffff16600052604060002080546101cb57600190556004356000526001602052604060002080546001019055005b6044361061005c57600060005260006020526040600020602052336000526040600020541561005c576004356000526000602052604060002060205260243573ffffffffffffffffffffffffffffffffffffffff1660005260406000208054156101cb5760009055600435600052600160205260406000208054600190039055005b00"
+)
+
+// PredeployAccessControlSC is a helper method for setting up the access control smart contract account,
+// granting the admin role to the passed in admins
+func PredeployAccessControlSC(admins []types.Address) (*chain.GenesisAccount, error) {
+	scHex, err := hex.DecodeHex(AccessControlSCBytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	storageMap := make(map[types.Hash]types.Hash)
+	bigTrueValue := big.NewInt(1)
+
+	for _, admin := range admins {
+		storageMap[accesscontrol.MemberIndex(accesscontrol.RoleAdmin, admin)] = types.BytesToHash(bigTrueValue.Bytes())
+	}
+
+	// Count each admin only once
+	storageMap[accesscontrol.MemberCountIndex(accesscontrol.RoleAdmin)] = types.BytesToHash(
+		big.NewInt(int64(len(storageMap))).Bytes(),
+	)
+
+	return &chain.GenesisAccount{
+		Code:    scHex,
+		Storage: storageMap,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package accesscontrol
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/abis"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/ethgo"
+)
+
+var (
+	admin = types.StringToAddress("1")
+	user  = types.StringToAddress("2")
+	other = types.StringToAddress("3")
+
+	contract = accesscontrol.AddrAccessControlContract
+)
+
+func newTestTransition(t *testing.T) *state.Transition {
+	t.Helper()
+
+	account, err := PredeployAccessControlSC([]types.Address{admin, admin})
+	assert.NoError(t, err)
+
+	ex := state.NewExecutor(&chain.Params{
+		Forks:                 chain.AllForksEnabled,
+		AccessControlContract: &contract,
+	}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+	rootHash := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		contract: account,
+	})
+
+	ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+		return func(i uint64) types.Hash {
+			return rootHash
+		}
+	}
+
+	transition, err := ex.BeginTxn(rootHash, &types.Header{GasLimit: 10000000}, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	return transition
+}
+
+// callContract calls the method of the access control contract from the given address
+func callContract(
+	t *testing.T,
+	transition *state.Transition,
+	from types.Address,
+	method string,
+	args ...interface{},
+) *runtime.ExecutionResult {
+	t.Helper()
+
+	input, err := abis.AccessControlABI.Methods[method].Encode(args)
+	assert.NoError(t, err)
+
+	result, err := transition.Apply(&types.Transaction{
+		From:     from,
+		To:       &contract,
+		Input:    input,
+		Nonce:    transition.GetNonce(from),
+		Gas:      1000000,
+		Value:    big.NewInt(0),
+		GasPrice: big.NewInt(0),
+	})
+	assert.NoError(t, err)
+
+	return result
+}
+
+func TestPredeployAccessControlSC(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	assert.True(t, accesscontrol.HasRole(transition, contract, accesscontrol.RoleAdmin, admin))
+	assert.False(t, accesscontrol.HasRole(transition, contract, accesscontrol.RoleAdmin, user))
+	assert.Equal(t, uint64(1), accesscontrol.MemberCount(transition, contract, accesscontrol.RoleAdmin))
+
+	result := callContract(t, transition, user, "hasRole", big.NewInt(0), ethgo.Address(admin))
+	assert.NoError(t, result.Err)
+	assert.Equal(t, types.BytesToHash([]byte{1}).Bytes(), result.ReturnValue)
+}
+
+func TestAccessControlSC_GrantRevoke(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+	sender := big.NewInt(int64(accesscontrol.RoleSender))
+
+	// only the admins can grant the roles
+	result := callContract(t, transition, user, "grantRole", sender, ethgo.Address(user))
+	assert.ErrorIs(t, result.Err, runtime.ErrExecutionReverted)
+
+	result = callContract(t, transition, admin, "grantRole", sender, ethgo.Address(user))
+	assert.NoError(t, result.Err)
+
+	// granting the role again does not change the count
+	result = callContract(t, transition, admin, "grantRole", sender, ethgo.Address(user))
+	assert.NoError(t, result.Err)
+
+	assert.True(t, accesscontrol.HasRole(transition, contract, accesscontrol.RoleSender, user))
+	assert.Equal(t, uint64(1), accesscontrol.MemberCount(transition, contract, accesscontrol.RoleSender))
+
+	result = callContract(t, transition, user, "memberCount", sender)
+	assert.NoError(t, result.Err)
+	assert.Equal(t, types.BytesToHash([]byte{1}).Bytes(), result.ReturnValue)
+
+	// the sender role is restricted now
+	err := transition.Write(&types.Transaction{
+		From:     other,
+		To:       &user,
+		Gas:      21000,
+		Value:    big.NewInt(0),
+		GasPrice: big.NewInt(0),
+	})
+
+	var appErr *state.TransitionApplicationError
+	if assert.ErrorAs(t, err, &appErr) {
+		assert.ErrorIs(t, appErr.Err, accesscontrol.ErrSenderRoleMissing)
+	}
+
+	result = callContract(t, transition, admin, "revokeRole", sender, ethgo.Address(user))
+	assert.NoError(t, result.Err)
+
+	assert.False(t, accesscontrol.HasRole(transition, contract, accesscontrol.RoleSender, user))
+	assert.Equal(t, uint64(0), accesscontrol.MemberCount(transition, contract, accesscontrol.RoleSender))
+}
+
+func TestAccessControlSC_Create(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	result := callContract(
+		t,
+		transition,
+		admin,
+		"grantRole",
+		big.NewInt(int64(accesscontrol.RoleDeployer)),
+		ethgo.Address(user),
+	)
+	assert.NoError(t, result.Err)
+
+	// the deployer and the admins can deploy contracts
+	assert.NoError(t, transition.Create2(user, nil, big.NewInt(0), 100000).Err)
+	assert.NoError(t, transition.Create2(admin, nil, big.NewInt(0), 100000).Err)
+
+	result = transition.Create2(other, nil, big.NewInt(0), 100000)
+	assert.ErrorIs(t, result.Err, accesscontrol.ErrDeployerRoleMissing)
+}
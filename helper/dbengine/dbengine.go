@@ -0,0 +1,225 @@
+package dbengine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/blockchain/storage/badger"
+	"github.com/LaChain/polygon-edge/blockchain/storage/leveldb"
+	"github.com/LaChain/polygon-edge/blockchain/storage/pebble"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Engine is the database engine the blockchain and the state are stored with
+type Engine string
+
+const (
+	LevelDB Engine = "leveldb"
+	Pebble  Engine = "pebble"
+	Badger  Engine = "badger"
+)
+
+const (
+	// engineFile records the engine of a database, the databases without it are leveldb ones
+	engineFile = "ENGINE"
+	// copyBatchSize is the number of entries written at once when copying a database
+	copyBatchSize = 10000
+)
+
+var (
+	ErrUnknownEngine  = errors.New("unknown db engine")
+	ErrEngineMismatch = errors.New("the database was created with another db engine")
+	ErrDatabaseExists = errors.New("the database already exists")
+)
+
+// Parse returns the engine with the given name
+func Parse(name string) (Engine, error) {
+	switch engine := Engine(name); engine {
+	case LevelDB, Pebble, Badger:
+		return engine, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownEngine, name)
+}
+
+// Detect returns the engine of the database in the given directory,
+// and false with leveldb if there's no database yet
+func Detect(path string) (Engine, bool, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return LevelDB, false, nil
+		}
+
+		return "", false, err
+	}
+
+	if len(entries) == 0 {
+		return LevelDB, false, nil
+	}
+
+	name, err := os.ReadFile(filepath.Join(path, engineFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return LevelDB, true, nil
+		}
+
+		return "", false, err
+	}
+
+	engine, err := Parse(strings.TrimSpace(string(name)))
+
+	return engine, true, err
+}
+
+// OpenBlockchainStorage opens the blockchain storage in the given directory with the engine
+func OpenBlockchainStorage(engine Engine, path string, logger hclog.Logger) (storage.Storage, error) {
+	if err := prepare(engine, path); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case Pebble:
+		return pebble.NewPebbleStorage(path, logger)
+	case Badger:
+		return badger.NewBadgerStorage(path, logger)
+	default:
+		return leveldb.NewLevelDBStorage(path, logger)
+	}
+}
+
+// OpenStateStorage opens the trie storage in the given directory with the engine
+func OpenStateStorage(engine Engine, path string, logger hclog.Logger) (itrie.Storage, error) {
+	if err := prepare(engine, path); err != nil {
+		return nil, err
+	}
+
+	return openKV(engine, path, logger)
+}
+
+// OpenExistingBlockchainStorage opens the blockchain storage in the given directory
+// with the engine it was created with
+func OpenExistingBlockchainStorage(path string, logger hclog.Logger) (storage.Storage, error) {
+	engine, _, err := Detect(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return OpenBlockchainStorage(engine, path, logger)
+}
+
+// OpenExistingStateStorage opens the trie storage in the given directory
+// with the engine it was created with
+func OpenExistingStateStorage(path string, logger hclog.Logger) (itrie.Storage, error) {
+	engine, _, err := Detect(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return OpenStateStorage(engine, path, logger)
+}
+
+// Copy copies all the entries of the database in the source directory into a new database
+// with the engine in the destination directory, and returns the number of entries
+func Copy(src, dst string, engine Engine, logger hclog.Logger) (int, error) {
+	if _, exists, err := Detect(dst); err != nil {
+		return 0, err
+	} else if exists {
+		return 0, fmt.Errorf("%w: %s", ErrDatabaseExists, dst)
+	}
+
+	srcEngine, _, err := Detect(src)
+	if err != nil {
+		return 0, err
+	}
+
+	// both databases are plain key-value stores, accessed as trie storages
+	from, err := openKV(srcEngine, src, logger)
+	if err != nil {
+		return 0, fmt.Errorf("unable to open the source database: %w", err)
+	}
+
+	defer from.Close()
+
+	to, err := OpenStateStorage(engine, dst, logger)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create the destination database: %w", err)
+	}
+
+	var (
+		batch  = to.Batch()
+		copied int
+	)
+
+	from.Iterate(func(k []byte) bool {
+		value, ok := from.Get(k)
+		if !ok {
+			return true
+		}
+
+		// the key is only valid during the call
+		batch.Put(append([]byte{}, k...), value)
+
+		if copied++; copied%copyBatchSize == 0 {
+			batch.Write()
+			batch = to.Batch()
+		}
+
+		return true
+	})
+
+	batch.Write()
+
+	if err := to.Close(); err != nil {
+		return 0, fmt.Errorf("unable to close the destination database: %w", err)
+	}
+
+	return copied, nil
+}
+
+// prepare checks the database in the directory was created with the engine,
+// and records the engine of the new ones
+func prepare(engine Engine, path string) error {
+	current, exists, err := Detect(path)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if current != engine {
+			return fmt.Errorf(
+				"%w: %s is a %s database, run the db migrate command to convert it to %s",
+				ErrEngineMismatch, path, current, engine,
+			)
+		}
+
+		return nil
+	}
+
+	if engine == LevelDB {
+		return nil
+	}
+
+	if err := os.MkdirAll(path, 0750); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(path, engineFile), []byte(engine), 0600)
+}
+
+// openKV opens the database in the given directory as a trie storage
+func openKV(engine Engine, path string, logger hclog.Logger) (itrie.Storage, error) {
+	switch engine {
+	case Pebble:
+		return itrie.NewPebbleStorage(path, logger)
+	case Badger:
+		return itrie.NewBadgerStorage(path, logger)
+	default:
+		return itrie.NewLevelDBStorage(path, logger)
+	}
+}
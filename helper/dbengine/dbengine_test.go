@@ -0,0 +1,131 @@
+package dbengine
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"leveldb", "pebble", "badger"} {
+		engine, err := Parse(name)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Engine(name), engine)
+	}
+
+	_, err := Parse("rocksdb")
+	assert.ErrorIs(t, err, ErrUnknownEngine)
+}
+
+func TestOpenStorage_Detect(t *testing.T) {
+	t.Parallel()
+
+	for _, engine := range []Engine{LevelDB, Pebble, Badger} {
+		engine := engine
+
+		t.Run(string(engine), func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "blockchain")
+
+			detected, exists, err := Detect(path)
+			require.NoError(t, err)
+			assert.False(t, exists)
+			assert.Equal(t, LevelDB, detected)
+
+			db, err := OpenBlockchainStorage(engine, path, hclog.NewNullLogger())
+			require.NoError(t, err)
+			require.NoError(t, db.WriteHeadNumber(10))
+			require.NoError(t, db.Close())
+
+			detected, exists, err = Detect(path)
+			require.NoError(t, err)
+			assert.True(t, exists)
+			assert.Equal(t, engine, detected)
+
+			db, err = OpenExistingBlockchainStorage(path, hclog.NewNullLogger())
+			require.NoError(t, err)
+
+			head, ok := db.ReadHeadNumber()
+			assert.True(t, ok)
+			assert.Equal(t, uint64(10), head)
+			require.NoError(t, db.Close())
+
+			// the database can't be opened with another engine
+			other := Pebble
+			if engine == Pebble {
+				other = Badger
+			}
+
+			_, err = OpenStateStorage(other, path, hclog.NewNullLogger())
+			assert.ErrorIs(t, err, ErrEngineMismatch)
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	engines := []Engine{LevelDB, Pebble, Badger}
+
+	for _, from := range engines {
+		for _, to := range engines {
+			if from == to {
+				continue
+			}
+
+			from, to := from, to
+
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				t.Parallel()
+
+				var (
+					dir = t.TempDir()
+					src = filepath.Join(dir, "trie")
+					dst = filepath.Join(dir, "trie.new")
+				)
+
+				storage, err := OpenStateStorage(from, src, hclog.NewNullLogger())
+				require.NoError(t, err)
+
+				for i := 0; i < copyBatchSize+10; i++ {
+					storage.Put([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i)))
+				}
+
+				storage.SetCode(types.StringToHash("0x1"), []byte{0x1})
+				require.NoError(t, storage.Close())
+
+				copied, err := Copy(src, dst, to, hclog.NewNullLogger())
+				require.NoError(t, err)
+				assert.Equal(t, copyBatchSize+11, copied)
+
+				// the destination must be a new database
+				_, err = Copy(src, dst, to, hclog.NewNullLogger())
+				assert.ErrorIs(t, err, ErrDatabaseExists)
+
+				storage, err = OpenExistingStateStorage(dst, hclog.NewNullLogger())
+				require.NoError(t, err)
+
+				defer storage.Close()
+
+				for i := 0; i < copyBatchSize+10; i++ {
+					value, ok := storage.Get([]byte(fmt.Sprintf("key-%d", i)))
+					assert.True(t, ok)
+					assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), value)
+				}
+
+				code, ok := storage.GetCode(types.StringToHash("0x1"))
+				assert.True(t, ok)
+				assert.Equal(t, []byte{0x1}, code)
+			})
+		}
+	}
+}
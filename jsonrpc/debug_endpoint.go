@@ -2,12 +2,15 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/LaChain/polygon-edge/helper/hex"
 	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/LaChain/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/LaChain/polygon-edge/types"
 )
@@ -21,6 +24,13 @@ var (
 	ErrTraceGenesisBlock = errors.New("genesis is not traceable")
 	// ErrNoConfig is an error returns when config is empty
 	ErrNoConfig = errors.New("missing config object")
+	// ErrUnknownTracer is an error returned when the requested tracer doesn't exist
+	ErrUnknownTracer = errors.New("unknown tracer")
+)
+
+const (
+	callTracerName     = "callTracer"
+	prestateTracerName = "prestateTracer"
 )
 
 type debugBlockchainStore interface {
@@ -69,11 +79,13 @@ type Debug struct {
 }
 
 type TraceConfig struct {
-	EnableMemory     bool    `json:"enableMemory"`
-	DisableStack     bool    `json:"disableStack"`
-	DisableStorage   bool    `json:"disableStorage"`
-	EnableReturnData bool    `json:"enableReturnData"`
-	Timeout          *string `json:"timeout"`
+	EnableMemory     bool            `json:"enableMemory"`
+	DisableStack     bool            `json:"disableStack"`
+	DisableStorage   bool            `json:"disableStorage"`
+	EnableReturnData bool            `json:"enableReturnData"`
+	Timeout          *string         `json:"timeout"`
+	Tracer           string          `json:"tracer"`
+	TracerConfig     json.RawMessage `json:"tracerConfig"`
 }
 
 func (d *Debug) TraceBlockByNumber(
@@ -142,7 +154,12 @@ func (d *Debug) TraceTransaction(
 
 	defer cancel()
 
-	return d.store.TraceTxn(block, tx.Hash, tracer)
+	result, err := d.store.TraceTxn(block, tx.Hash, tracer)
+	if err != nil {
+		return nil, stateError(err, block.Number()-1)
+	}
+
+	return result, nil
 }
 
 func (d *Debug) TraceCall(
@@ -166,13 +183,18 @@ func (d *Debug) TraceCall(
 	}
 
 	tracer, cancel, err := newTracer(config)
+	if err != nil {
+		return nil, err
+	}
+
 	defer cancel()
 
+	result, err := d.store.TraceCall(tx, header, tracer)
 	if err != nil {
-		return nil, err
+		return nil, stateError(err, header.Number)
 	}
 
-	return d.store.TraceCall(tx, header, tracer)
+	return result, nil
 }
 
 func (d *Debug) traceBlock(
@@ -184,13 +206,18 @@ func (d *Debug) traceBlock(
 	}
 
 	tracer, cancel, err := newTracer(config)
+	if err != nil {
+		return nil, err
+	}
+
 	defer cancel()
 
+	results, err := d.store.TraceBlock(block, tracer)
 	if err != nil {
-		return nil, err
+		return nil, stateError(err, block.Number()-1)
 	}
 
-	return d.store.TraceBlock(block, tracer)
+	return results, nil
 }
 
 // newTracer creates new tracer by config
@@ -214,13 +241,18 @@ func newTracer(config *TraceConfig) (
 		}
 	}
 
-	tracer := structtracer.NewStructTracer(structtracer.Config{
-		EnableMemory:     config.EnableMemory,
-		EnableStack:      !config.DisableStack,
-		EnableStorage:    !config.DisableStorage,
-		EnableReturnData: config.EnableReturnData,
-	})
+	tracer, err := buildTracer(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// cancellation of context is done by caller
+	return tracer, cancelOnTimeout(tracer, timeout), nil
+}
 
+// cancelOnTimeout cancels the tracer once the timeout expires,
+// unless the returned function is called before
+func cancelOnTimeout(tracer tracer.Tracer, timeout time.Duration) context.CancelFunc {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
 
 	go func() {
@@ -231,6 +263,49 @@ func newTracer(config *TraceConfig) (
 		}
 	}()
 
-	// cancellation of context is done by caller
-	return tracer, cancel, nil
+	return cancel
+}
+
+// buildTracer creates the tracer selected in the config, the struct logger by default
+func buildTracer(config *TraceConfig) (tracer.Tracer, error) {
+	switch config.Tracer {
+	case "":
+		return structtracer.NewStructTracer(structtracer.Config{
+			EnableMemory:     config.EnableMemory,
+			EnableStack:      !config.DisableStack,
+			EnableStorage:    !config.DisableStorage,
+			EnableReturnData: config.EnableReturnData,
+		}), nil
+
+	case callTracerName:
+		tracerConfig := calltracer.Config{}
+		if err := decodeTracerConfig(config.TracerConfig, &tracerConfig); err != nil {
+			return nil, err
+		}
+
+		return calltracer.NewCallTracer(tracerConfig), nil
+
+	case prestateTracerName:
+		tracerConfig := prestatetracer.Config{}
+		if err := decodeTracerConfig(config.TracerConfig, &tracerConfig); err != nil {
+			return nil, err
+		}
+
+		return prestatetracer.NewPrestateTracer(tracerConfig), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTracer, config.Tracer)
+	}
+}
+
+func decodeTracerConfig(raw json.RawMessage, config interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, config); err != nil {
+		return fmt.Errorf("invalid tracer config: %w", err)
+	}
+
+	return nil
 }
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state"
 	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -154,6 +157,16 @@ func TestDebugTraceConfigDecode(t *testing.T) {
 				Timeout:          &timeout15s,
 			},
 		},
+		{
+			input: `{
+				"tracer": "callTracer",
+				"tracerConfig": {"onlyTopCall": true}
+			}`,
+			expected: TraceConfig{
+				Tracer:       "callTracer",
+				TracerConfig: json.RawMessage(`{"onlyTopCall": true}`),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -558,6 +571,36 @@ func TestTraceTransaction(t *testing.T) {
 	}
 }
 
+func TestTraceTransaction_HistoricalStateUnavailable(t *testing.T) {
+	t.Parallel()
+
+	blockWithTx := &types.Block{
+		Header: testBlock10.Header,
+		Transactions: []*types.Transaction{
+			testTx1,
+		},
+	}
+
+	endpoint := &Debug{
+		&debugEndpointMockStore{
+			readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
+				return testBlock10.Hash(), true
+			},
+			getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
+				return blockWithTx, true
+			},
+			traceTxnFn: func(block *types.Block, txHash types.Hash, tracer tracer.Tracer) (interface{}, error) {
+				return nil, state.ErrStateNotFound
+			},
+		},
+	}
+
+	res, err := endpoint.TraceTransaction(testTxHash1, &TraceConfig{})
+
+	assert.Nil(t, res)
+	assert.Equal(t, NewHistoricalStateUnavailableError(testBlock10.Number()-1), err)
+}
+
 func TestTraceCall(t *testing.T) {
 	t.Parallel()
 
@@ -709,6 +752,73 @@ func Test_newTracer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should create the tracer selected in the config", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			config   *TraceConfig
+			expected tracer.Tracer
+		}{
+			{
+				config: &TraceConfig{
+					Tracer:       "callTracer",
+					TracerConfig: json.RawMessage(`{"onlyTopCall": true, "withLog": true}`),
+				},
+				expected: calltracer.NewCallTracer(calltracer.Config{
+					OnlyTopCall: true,
+					WithLog:     true,
+				}),
+			},
+			{
+				config: &TraceConfig{
+					Tracer: "prestateTracer",
+				},
+				expected: prestatetracer.NewPrestateTracer(prestatetracer.Config{}),
+			},
+			{
+				config: &TraceConfig{
+					Tracer:       "prestateTracer",
+					TracerConfig: json.RawMessage(`{"diffMode": true}`),
+				},
+				expected: prestatetracer.NewPrestateTracer(prestatetracer.Config{
+					DiffMode: true,
+				}),
+			},
+		}
+
+		for _, test := range tests {
+			tracer, cancel, err := newTracer(test.config)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, tracer)
+
+			cancel()
+		}
+	})
+
+	t.Run("should return error if tracer is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, cancel, err := newTracer(&TraceConfig{
+			Tracer: "unknownTracer",
+		})
+
+		assert.Nil(t, tracer)
+		assert.Nil(t, cancel)
+		assert.ErrorIs(t, err, ErrUnknownTracer)
+	})
+
+	t.Run("should return error if tracer config is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := newTracer(&TraceConfig{
+			Tracer:       "callTracer",
+			TracerConfig: json.RawMessage(`{"onlyTopCall": 1}`),
+		})
+
+		assert.Error(t, err)
+	})
+
 	t.Run("should return error if arg is nil", func(t *testing.T) {
 		t.Parallel()
 
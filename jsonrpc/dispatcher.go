@@ -30,11 +30,13 @@ func (f *funcData) numParams() int {
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	TxPool *TxPool
-	Debug  *Debug
+	Eth      *Eth
+	Web3     *Web3
+	Net      *Net
+	TxPool   *TxPool
+	Debug    *Debug
+	Trace    *Trace
+	Istanbul *Istanbul
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -55,6 +57,34 @@ type dispatcherParams struct {
 	priceLimit              uint64
 	jsonRPCBatchLengthLimit uint64
 	blockRangeLimit         uint64
+
+	gasPriceOracleBlocks     uint64
+	gasPriceOraclePercentile uint64
+
+	// lightMode restricts the endpoints to the ones served by light nodes
+	lightMode bool
+}
+
+// lightEthMethods are the methods of the eth endpoint served by light nodes,
+// whose data is either in the verified headers or fetched with proofs from the peers
+var lightEthMethods = []string{
+	"chainId",
+	"syncing",
+	"blockNumber",
+	"getBlockByNumber",
+	"getBlockByHash",
+	"getBlockTransactionCountByNumber",
+	"getTransactionByHash",
+	"getTransactionReceipt",
+	"getBalance",
+	"getTransactionCount",
+	"getCode",
+	"getStorageAt",
+	"getProof",
+	"newFilter",
+	"newBlockFilter",
+	"getFilterChanges",
+	"uninstallFilter",
 }
 
 func newDispatcher(
@@ -83,7 +113,12 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 		store,
 		d.params.chainID,
 		d.filterManager,
-		d.params.priceLimit,
+		NewGasPriceOracle(
+			store,
+			d.params.gasPriceOracleBlocks,
+			d.params.gasPriceOraclePercentile,
+			d.params.priceLimit,
+		),
 	}
 	d.endpoints.Net = &Net{
 		store,
@@ -99,12 +134,28 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 	d.endpoints.Debug = &Debug{
 		store,
 	}
+	d.endpoints.Trace = &Trace{
+		store,
+		d.params.blockRangeLimit,
+	}
+	d.endpoints.Istanbul = &Istanbul{
+		store,
+	}
 
 	d.registerService("eth", d.endpoints.Eth)
 	d.registerService("net", d.endpoints.Net)
 	d.registerService("web3", d.endpoints.Web3)
+
+	if d.params.lightMode {
+		d.restrictService("eth", lightEthMethods)
+
+		return
+	}
+
 	d.registerService("txpool", d.endpoints.TxPool)
 	d.registerService("debug", d.endpoints.Debug)
+	d.registerService("trace", d.endpoints.Trace)
+	d.registerService("istanbul", d.endpoints.Istanbul)
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -351,6 +402,12 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	if err := getError(output[1]); err != nil {
 		d.logInternalError(req.Method, err)
 
+		// keep the code of the errors defined by the endpoints
+		var rpcErr Error
+		if errors.As(err, &rpcErr) {
+			return nil, rpcErr
+		}
+
 		return nil, NewInvalidRequestError(err.Error())
 	}
 
@@ -426,6 +483,20 @@ func (d *Dispatcher) registerService(serviceName string, service interface{}) {
 	}
 }
 
+// restrictService unregisters the methods of the service which are not in the given list
+func (d *Dispatcher) restrictService(serviceName string, methods []string) {
+	service := d.serviceMap[serviceName]
+	funcMap := make(map[string]*funcData, len(methods))
+
+	for _, name := range methods {
+		if fd, ok := service.funcMap[name]; ok {
+			funcMap[name] = fd
+		}
+	}
+
+	service.funcMap = funcMap
+}
+
 func validateFunc(funcName string, fv reflect.Value, _ bool) (inNum int, reqt []reflect.Type, err error) {
 	if funcName == "" {
 		err = fmt.Errorf("funcName cannot be empty")
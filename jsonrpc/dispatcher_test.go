@@ -2,6 +2,8 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -211,6 +213,96 @@ func (m *mockService) Filter(f LogQuery) (interface{}, error) {
 	return nil, nil
 }
 
+func (m *mockService) Fail(blockNumber BlockNumber) (interface{}, error) {
+	if blockNumber == LatestBlockNumber {
+		return nil, errors.New("failed")
+	}
+
+	return nil, fmt.Errorf("trace failed: %w", NewHistoricalStateUnavailableError(uint64(blockNumber)))
+}
+
+func TestDispatcherErrorCode(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newDispatcher(
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+		},
+	)
+	dispatcher.registerService("mock", &mockService{})
+
+	cases := []struct {
+		name    string
+		params  string
+		code    int
+		message string
+	}{
+		{
+			"errors of the endpoints are invalid requests",
+			`["latest"]`,
+			-32600,
+			"failed",
+		},
+		{
+			"errors defined by the endpoints keep their code",
+			`["0x1"]`,
+			-32002,
+			"historical state unavailable for block 1",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dispatcher.handleReq(Request{
+				Method: "mock_fail",
+				Params: []byte(c.params),
+			})
+
+			assert.Equal(t, c.code, err.ErrorCode())
+			assert.Equal(t, c.message, err.Error())
+		})
+	}
+}
+
+func TestDispatcherLightMode(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newDispatcher(
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+			lightMode:               true,
+		},
+	)
+
+	assert.Len(t, dispatcher.serviceMap["eth"].funcMap, len(lightEthMethods))
+
+	for _, method := range lightEthMethods {
+		assert.Contains(t, dispatcher.serviceMap["eth"].funcMap, method)
+	}
+
+	assert.Contains(t, dispatcher.serviceMap, "net")
+	assert.Contains(t, dispatcher.serviceMap, "web3")
+
+	for _, method := range []string{"eth_call", "eth_sendRawTransaction", "txpool_status", "debug_traceBlockByNumber"} {
+		_, err := dispatcher.handleReq(Request{
+			Method: method,
+			Params: []byte(`[]`),
+		})
+
+		assert.Equal(t, NewMethodNotFoundError(method), err)
+	}
+}
+
 func TestDispatcherFuncDecode(t *testing.T) {
 	srv := &mockService{msgCh: make(chan interface{}, 10)}
 
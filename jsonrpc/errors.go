@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/LaChain/polygon-edge/state"
 	"github.com/LaChain/polygon-edge/state/runtime"
 	"github.com/umbracle/ethgo/abi"
 )
@@ -76,6 +77,20 @@ func (e *methodNotFoundError) ErrorCode() int {
 	return -32601
 }
 
+// historicalStateUnavailableError is returned when the state of the requested block
+// is no longer kept by the node
+type historicalStateUnavailableError struct {
+	err string
+}
+
+func (e *historicalStateUnavailableError) Error() string {
+	return e.err
+}
+
+func (e *historicalStateUnavailableError) ErrorCode() int {
+	return -32002
+}
+
 func NewMethodNotFoundError(method string) *methodNotFoundError {
 	return &methodNotFoundError{fmt.Sprintf("the method %s does not exist/is not available", method)}
 }
@@ -90,10 +105,24 @@ func NewInternalError(msg string) *internalError {
 	return &internalError{msg}
 }
 
+func NewHistoricalStateUnavailableError(blockNumber uint64) *historicalStateUnavailableError {
+	return &historicalStateUnavailableError{fmt.Sprintf("historical state unavailable for block %d", blockNumber)}
+}
+
 func NewSubscriptionNotFoundError(method string) *subscriptionNotFoundError {
 	return &subscriptionNotFoundError{fmt.Sprintf("subscribe method %s not found", method)}
 }
 
+// stateError returns the historical state unavailable error
+// if the state of the block is missing, otherwise the given error
+func stateError(err error, blockNumber uint64) error {
+	if errors.Is(err, state.ErrStateNotFound) {
+		return NewHistoricalStateUnavailableError(blockNumber)
+	}
+
+	return err
+}
+
 func constructErrorFromRevert(result *runtime.ExecutionResult) error {
 	revertErrMsg, unpackErr := abi.UnpackRevertError(result.ReturnValue)
 	if unpackErr != nil {
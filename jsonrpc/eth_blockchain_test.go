@@ -246,42 +246,183 @@ func TestEth_Syncing(t *testing.T) {
 	})
 }
 
-// if price-limit flag is set its value should be returned if it is higher than avg gas price
+// if price-limit flag is set its value should be returned if it is higher than the suggested gas price
 func TestEth_GetPrice_PriceLimitSet(t *testing.T) {
 	priceLimit := uint64(100333)
-	store := newMockBlockStore()
-	// not using newTestEthEndpoint as we need to set priceLimit
-	eth := newTestEthEndpointWithPriceLimit(store, priceLimit)
 
-	t.Run("returns price limit flag value when it is larger than average gas price", func(t *testing.T) {
+	t.Run("returns price limit flag value when it is larger than suggested gas price", func(t *testing.T) {
+		store := newMockBlockStore()
+		store.add(newTestFeeBlock(1, 1000, 1, 2))
+		eth := newTestEthEndpointWithPriceLimit(store, priceLimit)
+
 		res, err := eth.GasPrice()
-		store.averageGasPrice = 0
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 
-		assert.Equal(t, argUint64(priceLimit), res)
+		assert.Equal(t, argBigPtr(new(big.Int).SetUint64(priceLimit)), res)
 	})
 
-	t.Run("returns average gas price when it is larger than set price limit flag", func(t *testing.T) {
-		store.averageGasPrice = 500000
+	t.Run("returns suggested gas price when it is larger than set price limit flag", func(t *testing.T) {
+		store := newMockBlockStore()
+		store.add(newTestFeeBlock(1, 1000, 500000))
+		store.nextBaseFee = 1000
+		eth := newTestEthEndpointWithPriceLimit(store, priceLimit)
+
 		res, err := eth.GasPrice()
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 
-		assert.GreaterOrEqual(t, res, argUint64(priceLimit))
+		assert.Equal(t, argBigPtr(big.NewInt(501000)), res)
 	})
 }
 
 func TestEth_GasPrice(t *testing.T) {
 	store := newMockBlockStore()
-	store.averageGasPrice = 9999
+	store.add(
+		newTestFeeBlock(0, 0),
+		newTestFeeBlock(1, 10, 1, 2, 3, 4, 5),
+		newTestFeeBlock(2, 10, 6, 7, 8, 9, 10),
+	)
+	store.nextBaseFee = 10
 	eth := newTestEthEndpoint(store)
 
 	res, err := eth.GasPrice()
 	assert.NoError(t, err)
-	assert.NotNil(t, res)
 
-	assert.Equal(t, argUint64(store.averageGasPrice), res)
+	// the 60th percentile tip of the last blocks on top of the next base fee
+	assert.Equal(t, argBigPtr(big.NewInt(16)), res)
+}
+
+func TestEth_MaxPriorityFeePerGas(t *testing.T) {
+	t.Run("returns the suggested tip", func(t *testing.T) {
+		store := newMockBlockStore()
+		store.add(newTestFeeBlock(1, 10, 1, 2, 3, 4, 5))
+		store.nextBaseFee = 10
+		eth := newTestEthEndpointWithPriceLimit(store, 5)
+
+		res, err := eth.MaxPriorityFeePerGas()
+		assert.NoError(t, err)
+
+		assert.Equal(t, argBigPtr(big.NewInt(3)), res)
+	})
+
+	t.Run("returns the tip needed to reach the price limit", func(t *testing.T) {
+		store := newMockBlockStore()
+		store.add(newTestFeeBlock(1, 10, 1, 2, 3, 4, 5))
+		store.nextBaseFee = 10
+		eth := newTestEthEndpointWithPriceLimit(store, 20)
+
+		res, err := eth.MaxPriorityFeePerGas()
+		assert.NoError(t, err)
+
+		assert.Equal(t, argBigPtr(big.NewInt(10)), res)
+	})
+}
+
+func TestEth_FeeHistory(t *testing.T) {
+	t.Parallel()
+
+	store := newMockBlockStore()
+	store.add(
+		newTestFeeBlock(0, 0),
+		newTestFeeBlock(1, 10, 1, 2, 3),
+		newTestFeeBlock(2, 20),
+		newTestFeeBlock(3, 30, 4, 5),
+	)
+	store.nextBaseFee = 40
+
+	for _, block := range store.blocks {
+		receipts := make([]*types.Receipt, len(block.Transactions))
+		for i := range receipts {
+			receipts[i] = &types.Receipt{GasUsed: testFeeTxGasUsed}
+		}
+
+		store.receipts[block.Hash()] = receipts
+	}
+
+	// the gas used by the transactions of block 3 is uneven
+	store.receipts[store.blocks[3].Hash()][0].GasUsed = 3 * testFeeTxGasUsed
+	store.blocks[3].Header.GasUsed = 4 * testFeeTxGasUsed
+
+	eth := newTestEthEndpoint(store)
+
+	cases := []struct {
+		name        string
+		blockCount  argUint64
+		newestBlock BlockNumber
+		percentiles []float64
+		result      *feeHistoryResult
+		err         error
+	}{
+		{
+			name:        "should return the fees and rewards of the last blocks",
+			blockCount:  3,
+			newestBlock: LatestBlockNumber,
+			percentiles: []float64{0, 50, 100},
+			result: &feeHistoryResult{
+				OldestBlock:   1,
+				BaseFeePerGas: []argUint64{10, 20, 30, 40},
+				GasUsedRatio:  []float64{0.3, 0, 0.4},
+				Reward: [][]argBig{
+					{argBig(*big.NewInt(1)), argBig(*big.NewInt(2)), argBig(*big.NewInt(3))},
+					{argBig(*big.NewInt(0)), argBig(*big.NewInt(0)), argBig(*big.NewInt(0))},
+					{argBig(*big.NewInt(4)), argBig(*big.NewInt(4)), argBig(*big.NewInt(5))},
+				},
+			},
+		},
+		{
+			name:        "should not return rewards if there are no percentiles",
+			blockCount:  1,
+			newestBlock: BlockNumber(1),
+			result: &feeHistoryResult{
+				OldestBlock:   1,
+				BaseFeePerGas: []argUint64{10, 40},
+				GasUsedRatio:  []float64{0.3},
+			},
+		},
+		{
+			name:        "should limit the block count to the available blocks",
+			blockCount:  10,
+			newestBlock: BlockNumber(1),
+			result: &feeHistoryResult{
+				OldestBlock:   0,
+				BaseFeePerGas: []argUint64{0, 10, 40},
+				GasUsedRatio:  []float64{0, 0.3},
+			},
+		},
+		{
+			name:        "should return error if the percentiles are not increasing",
+			blockCount:  1,
+			newestBlock: LatestBlockNumber,
+			percentiles: []float64{50, 10},
+			err:         ErrInvalidRewardPercentile,
+		},
+		{
+			name:        "should return error if a percentile is out of range",
+			blockCount:  1,
+			newestBlock: LatestBlockNumber,
+			percentiles: []float64{101},
+			err:         ErrInvalidRewardPercentile,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := eth.FeeHistory(c.blockCount, c.newestBlock, c.percentiles)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.result, res)
+		})
+	}
 }
 
 func TestEth_Call(t *testing.T) {
@@ -341,13 +482,13 @@ type testStore interface {
 
 type mockBlockStore struct {
 	testStore
-	blocks          []*types.Block
-	topics          []types.Hash
-	pendingTxns     []*types.Transaction
-	receipts        map[types.Hash][]*types.Receipt
-	isSyncing       bool
-	averageGasPrice int64
-	ethCallError    error
+	blocks       []*types.Block
+	topics       []types.Hash
+	pendingTxns  []*types.Transaction
+	receipts     map[types.Hash][]*types.Receipt
+	isSyncing    bool
+	nextBaseFee  uint64
+	ethCallError error
 }
 
 func newMockBlockStore() *mockBlockStore {
@@ -513,8 +654,8 @@ func (m *mockBlockStore) GetSyncProgression() *progress.Progression {
 	}
 }
 
-func (m *mockBlockStore) GetAvgGasPrice() *big.Int {
-	return big.NewInt(m.averageGasPrice)
+func (m *mockBlockStore) CalculateBaseFee(parent *types.Header) uint64 {
+	return m.nextBaseFee
 }
 
 func (m *mockBlockStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
@@ -525,6 +666,33 @@ func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
 
+// testFeeTxGasUsed is the gas used by the transactions of the blocks returned by newTestFeeBlock
+const testFeeTxGasUsed = 100
+
+// newTestFeeBlock returns a block with the given base fee,
+// with a dynamic fee transaction paying each of the given tips
+func newTestFeeBlock(number, baseFee uint64, tips ...int64) *types.Block {
+	block := &types.Block{
+		Header: &types.Header{
+			Number:   number,
+			Hash:     types.BytesToHash([]byte{byte(number + 1)}),
+			BaseFee:  baseFee,
+			GasLimit: 1000,
+			GasUsed:  uint64(len(tips)) * testFeeTxGasUsed,
+		},
+	}
+
+	for _, tip := range tips {
+		block.Transactions = append(block.Transactions, &types.Transaction{
+			Type:      types.DynamicFeeTx,
+			GasFeeCap: big.NewInt(1000000),
+			GasTipCap: big.NewInt(tip),
+		})
+	}
+
+	return block
+}
+
 func newTestBlock(number uint64, hash types.Hash) *types.Block {
 	return &types.Block{
 		Header: &types.Header{
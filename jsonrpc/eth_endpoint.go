@@ -4,11 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/LaChain/polygon-edge/chain"
-	"github.com/LaChain/polygon-edge/helper/common"
+	"github.com/LaChain/polygon-edge/crypto"
 	"github.com/LaChain/polygon-edge/helper/progress"
 	"github.com/LaChain/polygon-edge/state"
 	"github.com/LaChain/polygon-edge/state/runtime"
@@ -27,8 +28,10 @@ type ethTxPoolStore interface {
 }
 
 type Account struct {
-	Balance *big.Int
-	Nonce   uint64
+	Balance  *big.Int
+	Nonce    uint64
+	Root     types.Hash
+	CodeHash types.Hash
 }
 
 type ethStateStore interface {
@@ -36,6 +39,12 @@ type ethStateStore interface {
 	GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error)
 	GetForksInTime(blockNumber uint64) chain.ForksInTime
 	GetCode(root types.Hash, addr types.Address) ([]byte, error)
+
+	// GetAccountProof returns the merkle proof of the account in the state with the given root
+	GetAccountProof(root types.Hash, addr types.Address) ([][]byte, error)
+
+	// GetStorageProof returns the merkle proof of the slot in the storage trie with the given root
+	GetStorageProof(storageRoot types.Hash, slot types.Hash) ([][]byte, error)
 }
 
 type ethBlockchainStore interface {
@@ -57,8 +66,8 @@ type ethBlockchainStore interface {
 	// GetReceiptsByHash returns the receipts for a block hash
 	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
 
-	// GetAvgGasPrice returns the average gas price
-	GetAvgGasPrice() *big.Int
+	// CalculateBaseFee returns the base fee of the next block after parent
+	CalculateBaseFee(parent *types.Header) uint64
 
 	// ApplyTxn applies a transaction object to the blockchain
 	ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
@@ -76,17 +85,26 @@ type ethStore interface {
 
 // Eth is the eth jsonrpc endpoint
 type Eth struct {
-	logger        hclog.Logger
-	store         ethStore
-	chainID       uint64
-	filterManager *FilterManager
-	priceLimit    uint64
+	logger         hclog.Logger
+	store          ethStore
+	chainID        uint64
+	filterManager  *FilterManager
+	gasPriceOracle *GasPriceOracle
 }
 
 var (
-	ErrInsufficientFunds = errors.New("insufficient funds for execution")
+	emptyCodeHash = types.BytesToHash(crypto.Keccak256(nil))
+
+	ErrInsufficientFunds  = errors.New("insufficient funds for execution")
+	ErrBerlinNotActivated = errors.New("access lists are not supported before the Berlin fork")
+	ErrAccessListUnstable = errors.New("the access list doesn't settle")
+
+	ErrInvalidRewardPercentile = errors.New("invalid reward percentile")
 )
 
+// maxFeeHistoryBlocks is the maximum number of blocks returned by eth_feeHistory
+const maxFeeHistoryBlocks = 1024
+
 // ChainId returns the chain id of the client
 //
 //nolint:stylecheck
@@ -210,6 +228,7 @@ func (e *Eth) GetTransactionByHash(hash types.Hash) (interface{}, error) {
 					argUintPtr(block.Number()),
 					argHashPtr(block.Hash()),
 					&idx,
+					block.Header.BaseFee,
 				)
 			}
 		}
@@ -331,6 +350,8 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		FromAddr:          txn.From,
 		ToAddr:            txn.To,
 		Logs:              logs,
+		Type:              argUint64(raw.TransactionType),
+		EffectiveGasPrice: argBig(*txn.GetGasPrice(block.Header.BaseFee)),
 	}
 
 	return res, nil
@@ -354,21 +375,222 @@ func (e *Eth) GetStorageAt(
 			return argBytesPtr(types.ZeroHash[:]), nil
 		}
 
-		return nil, err
+		return nil, stateError(err, header.Number)
 	}
 
 	// Pad to return 32 bytes data
 	return argBytesPtr(result), nil
 }
 
-// GasPrice returns the average gas price based on the last x blocks
+// GetProof returns the merkle proofs of the account and of the given storage slots (EIP-1186)
+func (e *Eth) GetProof(
+	address types.Address,
+	storageKeys []types.Hash,
+	filter BlockNumberOrHash,
+) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, err := e.store.GetAccountProof(header.StateRoot, address)
+	if err != nil {
+		return nil, stateError(err, header.Number)
+	}
+
+	res := &accountProofResult{
+		Address:      address,
+		AccountProof: toArgBytesList(accountProof),
+		CodeHash:     emptyCodeHash,
+		StorageHash:  types.EmptyRootHash,
+		StorageProof: make([]storageProofResult, 0, len(storageKeys)),
+	}
+
+	// non-existing accounts are proven by the absence of the key in the state trie
+	account, err := e.store.GetAccount(header.StateRoot, address)
+	if err != nil && !errors.Is(err, ErrStateNotFound) {
+		return nil, stateError(err, header.Number)
+	}
+
+	if account != nil {
+		res.Balance = argBig(*account.Balance)
+		res.Nonce = argUint64(account.Nonce)
+		res.CodeHash = account.CodeHash
+		res.StorageHash = account.Root
+	}
+
+	for _, key := range storageKeys {
+		storageProof := storageProofResult{
+			Key:   key,
+			Proof: []argBytes{},
+		}
+
+		if res.StorageHash != types.EmptyRootHash {
+			proof, err := e.store.GetStorageProof(res.StorageHash, key)
+			if err != nil {
+				return nil, stateError(err, header.Number)
+			}
+
+			value, err := e.store.GetStorage(header.StateRoot, address, key)
+			if err != nil {
+				return nil, stateError(err, header.Number)
+			}
+
+			storageProof.Proof = toArgBytesList(proof)
+			storageProof.Value = argBig(*new(big.Int).SetBytes(value))
+		}
+
+		res.StorageProof = append(res.StorageProof, storageProof)
+	}
+
+	return res, nil
+}
+
+// GasPrice returns the gas price suggested by the oracle from the tips paid in the last blocks,
 // taking into consideration operator defined price limit
 func (e *Eth) GasPrice() (interface{}, error) {
-	// Fetch average gas price in uint64
-	avgGasPrice := e.store.GetAvgGasPrice().Uint64()
+	return argBigPtr(e.gasPriceOracle.SuggestGasPrice()), nil
+}
+
+// MaxPriorityFeePerGas returns the tip of dynamic fee transactions suggested by the oracle
+func (e *Eth) MaxPriorityFeePerGas() (interface{}, error) {
+	return argBigPtr(e.gasPriceOracle.SuggestTipCap()), nil
+}
+
+// FeeHistory returns the base fees and gas used ratios of the blocks in the range ending with newestBlock,
+// along with the tips paid at the given percentiles of the gas used in each block
+func (e *Eth) FeeHistory(
+	blockCount argUint64,
+	newestBlock BlockNumber,
+	rewardPercentiles []float64,
+) (interface{}, error) {
+	for i, percentile := range rewardPercentiles {
+		if percentile < 0 || percentile > 100 || (i > 0 && percentile < rewardPercentiles[i-1]) {
+			return nil, fmt.Errorf("%w: %f", ErrInvalidRewardPercentile, percentile)
+		}
+	}
 
-	// Return --price-limit flag defined value if it is greater than avgGasPrice
-	return argUint64(common.Max(e.priceLimit, avgGasPrice)), nil
+	newest, err := GetNumericBlockNumber(newestBlock, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	count := uint64(blockCount)
+	if count > maxFeeHistoryBlocks {
+		count = maxFeeHistoryBlocks
+	}
+
+	if count > newest+1 {
+		count = newest + 1
+	}
+
+	res := &feeHistoryResult{
+		OldestBlock:   argUint64(newest + 1 - count),
+		BaseFeePerGas: []argUint64{},
+		GasUsedRatio:  []float64{},
+	}
+
+	if count == 0 {
+		return res, nil
+	}
+
+	if len(rewardPercentiles) > 0 {
+		res.Reward = [][]argBig{}
+	}
+
+	var header *types.Header
+
+	for num := uint64(res.OldestBlock); num <= newest; num++ {
+		block, ok := e.store.GetBlockByNumber(num, true)
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", num)
+		}
+
+		header = block.Header
+
+		res.BaseFeePerGas = append(res.BaseFeePerGas, argUint64(header.BaseFee))
+
+		gasUsedRatio := float64(0)
+		if header.GasLimit != 0 {
+			gasUsedRatio = float64(header.GasUsed) / float64(header.GasLimit)
+		}
+
+		res.GasUsedRatio = append(res.GasUsedRatio, gasUsedRatio)
+
+		if len(rewardPercentiles) > 0 {
+			rewards, err := e.blockRewards(block, rewardPercentiles)
+			if err != nil {
+				return nil, err
+			}
+
+			res.Reward = append(res.Reward, rewards)
+		}
+	}
+
+	// the base fee of the block after the newest one is known as well
+	res.BaseFeePerGas = append(res.BaseFeePerGas, argUint64(e.store.CalculateBaseFee(header)))
+
+	return res, nil
+}
+
+// blockRewards returns the tips paid by the transactions of the block at the given percentiles,
+// where each transaction is weighted by the gas it used
+func (e *Eth) blockRewards(block *types.Block, percentiles []float64) ([]argBig, error) {
+	rewards := make([]argBig, len(percentiles))
+
+	if len(block.Transactions) == 0 {
+		return rewards, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		return nil, fmt.Errorf("receipts of block %d not found", block.Number())
+	}
+
+	type txGasAndTip struct {
+		gasUsed uint64
+		tip     *big.Int
+	}
+
+	var (
+		txs          = make([]txGasAndTip, len(block.Transactions))
+		totalGasUsed = uint64(0)
+	)
+
+	for i, tx := range block.Transactions {
+		txs[i] = txGasAndTip{
+			gasUsed: receipts[i].GasUsed,
+			tip:     tx.EffectiveTip(block.Header.BaseFee),
+		}
+
+		totalGasUsed += receipts[i].GasUsed
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].tip.Cmp(txs[j].tip) < 0
+	})
+
+	var (
+		txIndex    = 0
+		sumGasUsed = txs[0].gasUsed
+	)
+
+	for i, percentile := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * percentile / 100)
+
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txs)-1 {
+			txIndex++
+			sumGasUsed += txs[txIndex].gasUsed
+		}
+
+		rewards[i] = argBig(*txs[txIndex].tip)
+	}
+
+	return rewards, nil
 }
 
 // Call executes a smart contract call using the transaction object data
@@ -390,7 +612,7 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 	// The return value of the execution is saved in the transition (returnValue field)
 	result, err := e.store.ApplyTxn(header, transaction)
 	if err != nil {
-		return nil, err
+		return nil, stateError(err, header.Number)
 	}
 
 	// Check if an EVM revert happened
@@ -405,6 +627,104 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 	return argBytesPtr(result.ReturnValue), nil
 }
 
+type accessListResult struct {
+	AccessList types.TxAccessList `json:"accessList"`
+	Error      string             `json:"error,omitempty"`
+	GasUsed    argUint64          `json:"gasUsed"`
+}
+
+// CreateAccessList computes the access list of the transaction (EIP-2930),
+// along with the gas used by the transaction when it includes it
+func (e *Eth) CreateAccessList(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	if !e.store.GetForksInTime(header.Number).Berlin {
+		return nil, ErrBerlinNotActivated
+	}
+
+	transaction, err := DecodeTxn(arg, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	// Warming up the accessed accounts and slots can change the execution path,
+	// so the transaction is applied until its access list doesn't change anymore,
+	// giving up after a pass for each of its entries and one more, the way geth does
+	accessList := transaction.AccessList
+
+	for pass := 0; pass <= accessListSize(accessList); pass++ {
+		transaction.AccessList = accessList
+
+		result, err := e.store.ApplyTxn(header, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		if !accessListsEqual(accessList, result.AccessList) {
+			accessList = result.AccessList
+
+			continue
+		}
+
+		res := &accessListResult{
+			AccessList: result.AccessList,
+			GasUsed:    argUint64(result.GasUsed),
+		}
+
+		if result.AccessList == nil {
+			res.AccessList = types.TxAccessList{}
+		}
+
+		if result.Failed() {
+			res.Error = result.Err.Error()
+		}
+
+		return res, nil
+	}
+
+	return nil, ErrAccessListUnstable
+}
+
+// accessListSize returns the number of the addresses and the storage keys in the access list
+func accessListSize(accessList types.TxAccessList) int {
+	size := len(accessList)
+
+	for _, tuple := range accessList {
+		size += len(tuple.StorageKeys)
+	}
+
+	return size
+}
+
+// accessListsEqual checks if both access lists have the same entries in the same order
+func accessListsEqual(a, b types.TxAccessList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Address != b[i].Address || len(a[i].StorageKeys) != len(b[i].StorageKeys) {
+			return false
+		}
+
+		for j := range a[i].StorageKeys {
+			if a[i].StorageKeys[j] != b[i].StorageKeys[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // EstimateGas estimates the gas needed to execute a transaction
 func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
 	transaction, err := DecodeTxn(arg, e.store)
@@ -445,7 +765,8 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		highEnd = header.GasLimit
 	}
 
-	gasPriceInt := new(big.Int).Set(transaction.GasPrice)
+	// the fee cap bounds the allowance of dynamic fee transactions
+	gasPriceInt := transaction.GetGasPrice(0)
 	valueInt := new(big.Int).Set(transaction.Value)
 
 	var availableBalance *big.Int
@@ -622,7 +943,7 @@ func (e *Eth) GetBalance(address types.Address, filter BlockNumberOrHash) (inter
 		// Account not found, return an empty account
 		return argUintPtr(0), nil
 	} else if err != nil {
-		return nil, err
+		return nil, stateError(err, header.Number)
 	}
 
 	return argBigPtr(acc.Balance), nil
@@ -630,35 +951,19 @@ func (e *Eth) GetBalance(address types.Address, filter BlockNumberOrHash) (inter
 
 // GetTransactionCount returns account nonce
 func (e *Eth) GetTransactionCount(address types.Address, filter BlockNumberOrHash) (interface{}, error) {
-	var (
-		blockNumber BlockNumber
-		header      *types.Header
-		err         error
-	)
-
-	// The filter is empty, use the latest block by default
-	if filter.BlockNumber == nil && filter.BlockHash == nil {
-		filter.BlockNumber, _ = createBlockNumberPointer("latest")
+	// The pending nonce counts the transactions in the pool, on top of the state of the latest block
+	if filter.BlockNumber != nil && *filter.BlockNumber == PendingBlockNumber {
+		return argUintPtr(e.store.GetNonce(address)), nil
 	}
 
-	if filter.BlockNumber == nil {
-		header, err = GetHeaderFromBlockNumberOrHash(filter, e.store)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get header from block hash or block number: %w", err)
-		}
-
-		blockNumber = BlockNumber(header.Number)
-	} else {
-		blockNumber = *filter.BlockNumber
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get header from block hash or block number: %w", err)
 	}
 
-	nonce, err := GetNextNonce(address, blockNumber, e.store)
+	nonce, err := GetNextNonce(address, BlockNumber(header.Number), e.store)
 	if err != nil {
-		if errors.Is(err, ErrStateNotFound) {
-			return argUintPtr(0), nil
-		}
-
-		return nil, err
+		return nil, stateError(err, header.Number)
 	}
 
 	return argUintPtr(nonce), nil
@@ -671,7 +976,6 @@ func (e *Eth) GetCode(address types.Address, filter BlockNumberOrHash) (interfac
 		return nil, err
 	}
 
-	emptySlice := []byte{}
 	code, err := e.store.GetCode(header.StateRoot, address)
 
 	if errors.Is(err, ErrStateNotFound) {
@@ -679,7 +983,7 @@ func (e *Eth) GetCode(address types.Address, filter BlockNumberOrHash) (interfac
 		// return the default value
 		return "0x", nil
 	} else if err != nil {
-		return argBytesPtr(emptySlice), err
+		return nil, stateError(err, header.Number)
 	}
 
 	return argBytesPtr(code), nil
@@ -2,12 +2,15 @@ package jsonrpc
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/crypto"
 	"github.com/LaChain/polygon-edge/helper/hex"
 	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
 	"github.com/LaChain/polygon-edge/state/runtime"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
@@ -761,10 +764,88 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 	assert.ErrorIs(t, estimateErr, ErrInsufficientFunds)
 }
 
+func TestEth_CreateAccessList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should fail before Berlin", func(t *testing.T) {
+		t.Parallel()
+
+		eth := newTestEthEndpoint(getExampleStore())
+
+		res, err := eth.CreateAccessList(constructMockTx(nil, nil), BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrBerlinNotActivated)
+		assert.Nil(t, res)
+	})
+
+	t.Run("should apply the transaction until the access list is stable", func(t *testing.T) {
+		t.Parallel()
+
+		accessList := types.TxAccessList{
+			{Address: addr1, StorageKeys: []types.Hash{hash1}},
+		}
+
+		calls := 0
+		store := getExampleStore()
+		store.forks = chain.AllForksEnabled.At(0)
+		store.applyTxnHook = func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+			calls++
+
+			result := &runtime.ExecutionResult{
+				GasUsed:    state.TxGas + uint64(len(txn.AccessList))*state.TxAccessListAddressGas,
+				AccessList: accessList,
+			}
+
+			// the execution path changes once the slot is warm
+			if len(txn.AccessList) > 0 {
+				result.Err = runtime.ErrExecutionReverted
+			}
+
+			return result, nil
+		}
+
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.CreateAccessList(constructMockTx(nil, nil), BlockNumberOrHash{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, &accessListResult{
+			AccessList: accessList,
+			Error:      runtime.ErrExecutionReverted.Error(),
+			GasUsed:    argUint64(state.TxGas + state.TxAccessListAddressGas),
+		}, res)
+	})
+
+	t.Run("should fail if the access list doesn't settle", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		store := getExampleStore()
+		store.forks = chain.AllForksEnabled.At(0)
+		store.applyTxnHook = func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+			calls++
+
+			// every pass accesses a slot the previous one didn't
+			return &runtime.ExecutionResult{
+				AccessList: types.TxAccessList{
+					{Address: addr1, StorageKeys: []types.Hash{types.BytesToHash([]byte{byte(calls)})}},
+				},
+			}, nil
+		}
+
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.CreateAccessList(constructMockTx(nil, nil), BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrAccessListUnstable)
+		assert.Nil(t, res)
+		assert.Equal(t, 3, calls)
+	})
+}
+
 type mockSpecialStore struct {
 	ethStore
 	account *mockAccount
 	block   *types.Block
+	forks   chain.ForksInTime
 
 	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
 }
@@ -833,7 +914,7 @@ func (m *mockSpecialStore) GetCode(root types.Hash, addr types.Address) ([]byte,
 }
 
 func (m *mockSpecialStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
-	return chain.ForksInTime{}
+	return m.forks
 }
 
 func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
@@ -843,3 +924,253 @@ func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+// mockProofStore serves the state from an in-memory trie
+type mockProofStore struct {
+	ethStore
+	header *types.Header
+	state  *itrie.State
+	snap   state.Snapshot
+}
+
+func newMockProofStore(t *testing.T, objs []*state.Object) *mockProofStore {
+	t.Helper()
+
+	st := itrie.NewState(itrie.NewMemoryStorage())
+	snap, root := st.NewSnapshot().Commit(objs)
+
+	return &mockProofStore{
+		header: &types.Header{StateRoot: types.BytesToHash(root)},
+		state:  st,
+		snap:   snap,
+	}
+}
+
+func (m *mockProofStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockProofStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
+	account, err := m.snap.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, ErrStateNotFound
+	}
+
+	return &Account{
+		Balance:  account.Balance,
+		Nonce:    account.Nonce,
+		Root:     account.Root,
+		CodeHash: types.BytesToHash(account.CodeHash),
+	}, nil
+}
+
+func (m *mockProofStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	account, err := m.GetAccount(root, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.snap.GetStorage(addr, account.Root, slot).Bytes(), nil
+}
+
+func (m *mockProofStore) GetAccountProof(root types.Hash, addr types.Address) ([][]byte, error) {
+	return m.state.GetProof(root, crypto.Keccak256(addr.Bytes()))
+}
+
+func (m *mockProofStore) GetStorageProof(storageRoot types.Hash, slot types.Hash) ([][]byte, error) {
+	return m.state.GetProof(storageRoot, crypto.Keccak256(slot.Bytes()))
+}
+
+func fromArgBytesList(list []argBytes) [][]byte {
+	res := make([][]byte, len(list))
+	for i, b := range list {
+		res[i] = b
+	}
+
+	return res
+}
+
+func TestEth_State_GetProof(t *testing.T) {
+	t.Parallel()
+
+	slot := types.BytesToHash([]byte{0x1})
+	store := newMockProofStore(t, []*state.Object{
+		{
+			Address:  addr0,
+			Balance:  big.NewInt(100),
+			Nonce:    2,
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+			Storage: []*state.StorageObject{
+				{Key: slot.Bytes(), Val: types.BytesToHash([]byte{0x2a}).Bytes()},
+			},
+		},
+		{
+			Address:  addr1,
+			Balance:  big.NewInt(1),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+		},
+	})
+	eth := newTestEthEndpoint(store)
+	stateRoot := store.header.StateRoot
+
+	t.Run("existing account", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := eth.GetProof(addr0, []types.Hash{slot, types.BytesToHash([]byte{0x2})}, BlockNumberOrHash{})
+		assert.NoError(t, err)
+
+		proof, ok := res.(*accountProofResult)
+		assert.True(t, ok)
+		assert.Equal(t, argUint64(2), proof.Nonce)
+		assert.Equal(t, argBig(*big.NewInt(100)), proof.Balance)
+
+		value, err := itrie.VerifyProof(stateRoot, crypto.Keccak256(addr0.Bytes()), fromArgBytesList(proof.AccountProof))
+		assert.NoError(t, err)
+		assert.NotNil(t, value)
+
+		assert.Len(t, proof.StorageProof, 2)
+		assert.Equal(t, argBig(*big.NewInt(0x2a)), proof.StorageProof[0].Value)
+
+		for _, storageProof := range proof.StorageProof {
+			value, err := itrie.VerifyProof(
+				proof.StorageHash,
+				crypto.Keccak256(storageProof.Key.Bytes()),
+				fromArgBytesList(storageProof.Proof),
+			)
+			assert.NoError(t, err)
+
+			if storageProof.Key == slot {
+				assert.NotNil(t, value)
+			} else {
+				assert.Nil(t, value)
+			}
+		}
+	})
+
+	t.Run("missing account", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := eth.GetProof(uninitializedAddress, []types.Hash{slot}, BlockNumberOrHash{})
+		assert.NoError(t, err)
+
+		proof, ok := res.(*accountProofResult)
+		assert.True(t, ok)
+		assert.Equal(t, types.EmptyRootHash, proof.StorageHash)
+		assert.Equal(t, emptyCodeHash, proof.CodeHash)
+		assert.Empty(t, proof.StorageProof[0].Proof)
+
+		value, err := itrie.VerifyProof(
+			stateRoot,
+			crypto.Keccak256(uninitializedAddress.Bytes()),
+			fromArgBytesList(proof.AccountProof),
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
+
+type mockPrunedStore struct {
+	mockSpecialStore
+}
+
+func (m *mockPrunedStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
+	return nil, fmt.Errorf("unable to get snapshot for root '%s': %w", root, state.ErrStateNotFound)
+}
+
+func (m *mockPrunedStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	_, err := m.GetAccount(root, addr)
+
+	return nil, err
+}
+
+func (m *mockPrunedStore) GetCode(root types.Hash, addr types.Address) ([]byte, error) {
+	_, err := m.GetAccount(root, addr)
+
+	return nil, err
+}
+
+func (m *mockPrunedStore) GetAccountProof(root types.Hash, addr types.Address) ([][]byte, error) {
+	return nil, fmt.Errorf("%w: trie node %s not found", state.ErrStateNotFound, root)
+}
+
+func (m *mockPrunedStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+	return nil, state.ErrStateNotFound
+}
+
+func TestEth_State_HistoricalStateUnavailable(t *testing.T) {
+	t.Parallel()
+
+	store := &mockPrunedStore{
+		mockSpecialStore: mockSpecialStore{
+			block: &types.Block{
+				Header: &types.Header{
+					Number: 5,
+				},
+			},
+		},
+	}
+
+	eth := newTestEthEndpoint(store)
+	filter := BlockNumberOrHash{}
+	expected := NewHistoricalStateUnavailableError(5)
+
+	cases := []struct {
+		name string
+		call func() (interface{}, error)
+	}{
+		{
+			"eth_getBalance",
+			func() (interface{}, error) {
+				return eth.GetBalance(addr0, filter)
+			},
+		},
+		{
+			"eth_getStorageAt",
+			func() (interface{}, error) {
+				return eth.GetStorageAt(addr0, types.ZeroHash, filter)
+			},
+		},
+		{
+			"eth_getTransactionCount",
+			func() (interface{}, error) {
+				return eth.GetTransactionCount(addr0, filter)
+			},
+		},
+		{
+			"eth_getCode",
+			func() (interface{}, error) {
+				return eth.GetCode(addr0, filter)
+			},
+		},
+		{
+			"eth_getProof",
+			func() (interface{}, error) {
+				return eth.GetProof(addr0, []types.Hash{hash1}, filter)
+			},
+		},
+		{
+			"eth_call",
+			func() (interface{}, error) {
+				return eth.Call(constructMockTx(nil, nil), filter)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.call()
+			assert.Nil(t, res)
+			assert.Equal(t, expected, err)
+		})
+	}
+}
@@ -649,11 +649,6 @@ func (f *FilterManager) processEvent(evnt *blockchain.Event) {
 
 // appendLogsToFilters makes each LogFilters append logs in the header
 func (f *FilterManager) appendLogsToFilters(header *block) error {
-	receipts, err := f.store.GetReceiptsByHash(header.Hash)
-	if err != nil {
-		return err
-	}
-
 	// Get logFilters from filters
 	logFilters := make([]*logFilter, 0)
 
@@ -663,10 +658,16 @@ func (f *FilterManager) appendLogsToFilters(header *block) error {
 		}
 	}
 
+	// the receipts are read only when needed, as light nodes fetch them from the peers
 	if len(logFilters) == 0 {
 		return nil
 	}
 
+	receipts, err := f.store.GetReceiptsByHash(header.Hash)
+	if err != nil {
+		return err
+	}
+
 	block, ok := f.store.GetBlockByHash(header.Hash, true)
 	if !ok {
 		f.logger.Error("could not find block in store", "hash", header.Hash.String())
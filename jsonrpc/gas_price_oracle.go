@@ -0,0 +1,138 @@
+package jsonrpc
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	// DefaultGasPriceOracleBlocks is the number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks uint64 = 20
+
+	// DefaultGasPriceOraclePercentile is the percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile uint64 = 60
+)
+
+type gasPriceOracleStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// GetBlockByNumber returns a block using the provided number
+	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// CalculateBaseFee returns the base fee of the next block after parent
+	CalculateBaseFee(parent *types.Header) uint64
+}
+
+// GasPriceOracle suggests the gas price of new transactions
+// from the tips paid by the transactions in the last blocks
+type GasPriceOracle struct {
+	store gasPriceOracleStore
+
+	// blocks is the number of recent blocks sampled
+	blocks uint64
+	// percentile is the percentile of the sampled tips suggested
+	percentile uint64
+	// priceLimit is the minimum gas price accepted by the txpool
+	priceLimit uint64
+
+	// the suggested tip is cached until a new block is written
+	lock     sync.Mutex
+	lastTip  *big.Int
+	lastHead types.Hash
+}
+
+// NewGasPriceOracle returns a gas price oracle sampling the given number of blocks,
+// with suggestions floored at the price limit
+func NewGasPriceOracle(store gasPriceOracleStore, blocks, percentile, priceLimit uint64) *GasPriceOracle {
+	if percentile > 100 {
+		percentile = 100
+	}
+
+	return &GasPriceOracle{
+		store:      store,
+		blocks:     blocks,
+		percentile: percentile,
+		priceLimit: priceLimit,
+	}
+}
+
+// SuggestGasPrice returns the gas price of a transaction to be included in the next blocks,
+// which is the base fee of the next block plus the suggested tip, and at least the price limit
+func (o *GasPriceOracle) SuggestGasPrice() *big.Int {
+	head := o.store.Header()
+
+	price := new(big.Int).Add(
+		new(big.Int).SetUint64(o.store.CalculateBaseFee(head)),
+		o.suggestTip(head),
+	)
+
+	return bigMax(price, new(big.Int).SetUint64(o.priceLimit))
+}
+
+// SuggestTipCap returns the tip of a transaction to be included in the next blocks.
+// It is raised so that the tip plus the base fee of the next block is at least the price limit
+func (o *GasPriceOracle) SuggestTipCap() *big.Int {
+	head := o.store.Header()
+
+	tip := o.suggestTip(head)
+
+	baseFee := o.store.CalculateBaseFee(head)
+	if baseFee < o.priceLimit {
+		tip = bigMax(tip, new(big.Int).SetUint64(o.priceLimit-baseFee))
+	}
+
+	return tip
+}
+
+// suggestTip returns the configured percentile of the tips paid
+// by the transactions in the last blocks up to head, or zero if there are none
+func (o *GasPriceOracle) suggestTip(head *types.Header) *big.Int {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.lastTip != nil && o.lastHead == head.Hash {
+		return new(big.Int).Set(o.lastTip)
+	}
+
+	tips := []*big.Int{}
+
+	for i := uint64(0); i < o.blocks && i <= head.Number; i++ {
+		block, ok := o.store.GetBlockByNumber(head.Number-i, true)
+		if !ok {
+			break
+		}
+
+		for _, tx := range block.Transactions {
+			if tip := tx.EffectiveTip(block.Header.BaseFee); tip.Sign() >= 0 {
+				tips = append(tips, tip)
+			}
+		}
+	}
+
+	tip := big.NewInt(0)
+
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool {
+			return tips[i].Cmp(tips[j]) < 0
+		})
+
+		tip = tips[uint64(len(tips)-1)*o.percentile/100]
+	}
+
+	o.lastTip = tip
+	o.lastHead = head.Hash
+
+	return new(big.Int).Set(tip)
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return b
+	}
+
+	return a
+}
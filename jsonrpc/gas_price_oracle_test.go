@@ -0,0 +1,63 @@
+package jsonrpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasPriceOracle_SuggestGasPrice(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		blocks     uint64
+		percentile uint64
+		priceLimit uint64
+		price      int64
+	}{
+		{"should suggest the percentile of the tips", 3, 50, 0, 13},
+		{"should sample only the last blocks", 1, 0, 0, 17},
+		{"should suggest the highest tip", 3, 100, 0, 19},
+		{"should suggest at least the price limit", 3, 50, 100, 100},
+		{"should suggest the base fee if no blocks are sampled", 0, 50, 0, 10},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newMockBlockStore()
+			store.add(
+				newTestFeeBlock(0, 0),
+				newTestFeeBlock(1, 5, 1, 2, 3),
+				newTestFeeBlock(2, 10, 9, 8, 7),
+			)
+			store.nextBaseFee = 10
+
+			oracle := NewGasPriceOracle(store, c.blocks, c.percentile, c.priceLimit)
+
+			assert.Equal(t, big.NewInt(c.price), oracle.SuggestGasPrice())
+		})
+	}
+}
+
+func TestGasPriceOracle_Cache(t *testing.T) {
+	t.Parallel()
+
+	store := newMockBlockStore()
+	store.add(newTestFeeBlock(0, 1, 1))
+
+	oracle := NewGasPriceOracle(store, 1, 50, 0)
+	assert.Equal(t, big.NewInt(1), oracle.SuggestTipCap())
+
+	// the tip is not sampled again until a new block is written
+	store.blocks[0].Transactions[0].GasTipCap = big.NewInt(2)
+	assert.Equal(t, big.NewInt(1), oracle.SuggestTipCap())
+
+	store.add(newTestFeeBlock(1, 1, 3))
+	assert.Equal(t, big.NewInt(3), oracle.SuggestTipCap())
+}
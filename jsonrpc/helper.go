@@ -220,6 +220,32 @@ func DecodeTxn(arg *txnArgs, store nonceGetter) (*types.Transaction, error) {
 		txn.To = arg.To
 	}
 
+	// access list transaction (EIP-2930)
+	if arg.AccessList != nil || (arg.Type != nil && types.TxType(*arg.Type) == types.AccessListTx) {
+		txn.Type = types.AccessListTx
+
+		if arg.AccessList != nil {
+			txn.AccessList = arg.AccessList.Copy()
+		}
+	}
+
+	// dynamic fee transaction (EIP-1559)
+	if arg.MaxFeePerGas != nil || arg.MaxPriorityFeePerGas != nil ||
+		(arg.Type != nil && types.TxType(*arg.Type) == types.DynamicFeeTx) {
+		if arg.MaxFeePerGas == nil {
+			arg.MaxFeePerGas = argBytesPtr([]byte{})
+		}
+
+		if arg.MaxPriorityFeePerGas == nil {
+			arg.MaxPriorityFeePerGas = argBytesPtr([]byte{})
+		}
+
+		txn.Type = types.DynamicFeeTx
+		txn.GasPrice = new(big.Int)
+		txn.GasFeeCap = new(big.Int).SetBytes(*arg.MaxFeePerGas)
+		txn.GasTipCap = new(big.Int).SetBytes(*arg.MaxPriorityFeePerGas)
+	}
+
 	txn.ComputeHash()
 
 	return txn, nil
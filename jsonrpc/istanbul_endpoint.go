@@ -0,0 +1,245 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/LaChain/polygon-edge/validators/store"
+)
+
+var (
+	// ErrIstanbulNotSupported is an error returned when the chain doesn't run IBFT
+	ErrIstanbulNotSupported = errors.New("istanbul endpoint is only supported by IBFT")
+)
+
+// IstanbulBackend is the IBFT consensus queried by the istanbul endpoint
+type IstanbulBackend interface {
+	// GetBlockSigners returns the proposer of the block and the validators who committed it
+	GetBlockSigners(header *types.Header) (types.Address, []types.Address, error)
+
+	// GetValidatorsByHeight returns the validators of the block at the height
+	GetValidatorsByHeight(height uint64) (validators.Validators, error)
+
+	// GetVotes returns the votes at the height, or nil if the validators are not voted
+	GetVotes(height uint64) ([]*store.Vote, error)
+
+	// GetCandidates returns the candidates proposed by the node
+	GetCandidates() ([]*store.Candidate, error)
+}
+
+// istanbulStore provides access to the methods needed by istanbul endpoint
+type istanbulStore interface {
+	headerGetter
+
+	// GetHeaderByHash gets a header using the provided hash
+	GetHeaderByHash(types.Hash) (*types.Header, bool)
+
+	// GetIstanbulBackend returns the IBFT consensus, or false if the chain runs another one
+	GetIstanbulBackend() (IstanbulBackend, bool)
+}
+
+// Istanbul is the istanbul jsonrpc endpoint, returning the validators and the signers of the blocks
+type Istanbul struct {
+	store istanbulStore
+}
+
+// BlockSigners are the proposer and the committers of a block
+type BlockSigners struct {
+	Number     argUint64       `json:"number"`
+	Hash       types.Hash      `json:"hash"`
+	Author     types.Address   `json:"author"`
+	Committers []types.Address `json:"committers"`
+}
+
+// IstanbulValidator is a validator, with the BLS public key of a BLS validator
+type IstanbulValidator struct {
+	Address      types.Address `json:"address"`
+	BLSPublicKey *argBytes     `json:"blsPublicKey,omitempty"`
+}
+
+// IstanbulVote is a vote of a validator for a candidate
+type IstanbulVote struct {
+	Validator types.Address `json:"validator"`
+	Candidate types.Address `json:"candidate"`
+	Authorize bool          `json:"authorize"`
+}
+
+// IstanbulCandidate is a candidate proposed by the node to be added or removed
+type IstanbulCandidate struct {
+	IstanbulValidator
+	Authorize bool `json:"authorize"`
+}
+
+// IstanbulSnapshot is the validators and the votes at a block
+type IstanbulSnapshot struct {
+	Number     argUint64            `json:"number"`
+	Hash       types.Hash           `json:"hash"`
+	Validators []*IstanbulValidator `json:"validators"`
+	Votes      []*IstanbulVote      `json:"votes"`
+}
+
+// GetSignersFromBlock returns the proposer and the committers of the block, the latest one by default
+func (i *Istanbul) GetSignersFromBlock(number *BlockNumber) (interface{}, error) {
+	header, err := i.getHeader(number)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.getSigners(header)
+}
+
+// GetSignersFromBlockByHash returns the proposer and the committers of the block
+func (i *Istanbul) GetSignersFromBlockByHash(hash types.Hash) (interface{}, error) {
+	header, ok := i.store.GetHeaderByHash(hash)
+	if !ok {
+		return nil, fmt.Errorf("header %s not found", hash)
+	}
+
+	return i.getSigners(header)
+}
+
+// GetValidators returns the addresses of the validators of the block, the latest one by default
+func (i *Istanbul) GetValidators(number *BlockNumber) (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := i.getHeader(number)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := backend.GetValidatorsByHeight(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]types.Address, vals.Len())
+	for idx := range addrs {
+		addrs[idx] = vals.At(uint64(idx)).Addr()
+	}
+
+	return addrs, nil
+}
+
+// GetSnapshot returns the validators and the votes at the block, the latest one by default
+func (i *Istanbul) GetSnapshot(number *BlockNumber) (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := i.getHeader(number)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := backend.GetValidatorsByHeight(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := backend.GetVotes(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &IstanbulSnapshot{
+		Number:     argUint64(header.Number),
+		Hash:       header.Hash,
+		Validators: make([]*IstanbulValidator, vals.Len()),
+		Votes:      make([]*IstanbulVote, len(votes)),
+	}
+
+	for idx := range snapshot.Validators {
+		snapshot.Validators[idx] = toIstanbulValidator(vals.At(uint64(idx)))
+	}
+
+	for idx, vote := range votes {
+		snapshot.Votes[idx] = &IstanbulVote{
+			Validator: vote.Validator,
+			Candidate: vote.Candidate.Addr(),
+			Authorize: vote.Authorize,
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Candidates returns the candidates proposed by the node
+func (i *Istanbul) Candidates() (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := backend.GetCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*IstanbulCandidate, len(candidates))
+	for idx, candidate := range candidates {
+		res[idx] = &IstanbulCandidate{
+			IstanbulValidator: *toIstanbulValidator(candidate.Validator),
+			Authorize:         candidate.Authorize,
+		}
+	}
+
+	return res, nil
+}
+
+// getBackend returns the IBFT consensus of the chain
+func (i *Istanbul) getBackend() (IstanbulBackend, error) {
+	backend, ok := i.store.GetIstanbulBackend()
+	if !ok {
+		return nil, ErrIstanbulNotSupported
+	}
+
+	return backend, nil
+}
+
+// getHeader returns the header of the block, the latest one if the number isn't given
+func (i *Istanbul) getHeader(number *BlockNumber) (*types.Header, error) {
+	if number == nil {
+		return i.store.Header(), nil
+	}
+
+	return GetBlockHeader(*number, i.store)
+}
+
+// getSigners returns the proposer and the committers of the block of the header
+func (i *Istanbul) getSigners(header *types.Header) (*BlockSigners, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	author, committers, err := backend.GetBlockSigners(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockSigners{
+		Number:     argUint64(header.Number),
+		Hash:       header.Hash,
+		Author:     author,
+		Committers: committers,
+	}, nil
+}
+
+// toIstanbulValidator converts the validator to the response of the validator
+func toIstanbulValidator(validator validators.Validator) *IstanbulValidator {
+	res := &IstanbulValidator{
+		Address: validator.Addr(),
+	}
+
+	if blsValidator, ok := validator.(*validators.BLSValidator); ok && len(blsValidator.BLSPublicKey) > 0 {
+		res.BLSPublicKey = argBytesPtr(blsValidator.BLSPublicKey)
+	}
+
+	return res
+}
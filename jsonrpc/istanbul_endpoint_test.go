@@ -0,0 +1,195 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/LaChain/polygon-edge/validators/store"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testIstanbulAddr1 = types.StringToAddress("1")
+	testIstanbulAddr2 = types.StringToAddress("2")
+	testIstanbulAddr3 = types.StringToAddress("3")
+)
+
+type istanbulEndpointMockStore struct {
+	headers []*types.Header
+	backend IstanbulBackend
+}
+
+func (s *istanbulEndpointMockStore) Header() *types.Header {
+	return s.headers[len(s.headers)-1]
+}
+
+func (s *istanbulEndpointMockStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
+	if num >= uint64(len(s.headers)) {
+		return nil, false
+	}
+
+	return s.headers[num], true
+}
+
+func (s *istanbulEndpointMockStore) GetHeaderByHash(hash types.Hash) (*types.Header, bool) {
+	for _, header := range s.headers {
+		if header.Hash == hash {
+			return header, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *istanbulEndpointMockStore) GetIstanbulBackend() (IstanbulBackend, bool) {
+	return s.backend, s.backend != nil
+}
+
+type mockIstanbulBackend struct {
+	validators validators.Validators
+	votes      []*store.Vote
+	candidates []*store.Candidate
+}
+
+func (b *mockIstanbulBackend) GetBlockSigners(header *types.Header) (types.Address, []types.Address, error) {
+	return testIstanbulAddr1, []types.Address{testIstanbulAddr1, types.BytesToAddress(header.Hash.Bytes())}, nil
+}
+
+func (b *mockIstanbulBackend) GetValidatorsByHeight(height uint64) (validators.Validators, error) {
+	return b.validators, nil
+}
+
+func (b *mockIstanbulBackend) GetVotes(height uint64) ([]*store.Vote, error) {
+	return b.votes, nil
+}
+
+func (b *mockIstanbulBackend) GetCandidates() ([]*store.Candidate, error) {
+	return b.candidates, nil
+}
+
+func newTestIstanbulEndpoint(backend IstanbulBackend) *Istanbul {
+	return &Istanbul{
+		store: &istanbulEndpointMockStore{
+			headers: []*types.Header{
+				{Number: 0, Hash: types.StringToHash("10")},
+				{Number: 1, Hash: types.StringToHash("11")},
+			},
+			backend: backend,
+		},
+	}
+}
+
+func TestIstanbulGetSigners(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newTestIstanbulEndpoint(&mockIstanbulBackend{})
+	genesis := BlockNumber(0)
+
+	expectedSigners := func(number uint64, hash types.Hash) *BlockSigners {
+		return &BlockSigners{
+			Number:     argUint64(number),
+			Hash:       hash,
+			Author:     testIstanbulAddr1,
+			Committers: []types.Address{testIstanbulAddr1, types.BytesToAddress(hash.Bytes())},
+		}
+	}
+
+	// the latest block by default
+	res, err := endpoint.GetSignersFromBlock(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSigners(1, types.StringToHash("11")), res)
+
+	res, err = endpoint.GetSignersFromBlock(&genesis)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSigners(0, types.StringToHash("10")), res)
+
+	res, err = endpoint.GetSignersFromBlockByHash(types.StringToHash("10"))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSigners(0, types.StringToHash("10")), res)
+
+	_, err = endpoint.GetSignersFromBlockByHash(types.StringToHash("12"))
+	assert.Error(t, err)
+}
+
+func TestIstanbulGetSnapshot(t *testing.T) {
+	t.Parallel()
+
+	blsPublicKey := []byte{0x1, 0x2}
+
+	endpoint := newTestIstanbulEndpoint(&mockIstanbulBackend{
+		validators: validators.NewBLSValidatorSet(
+			validators.NewBLSValidator(testIstanbulAddr1, blsPublicKey),
+			validators.NewBLSValidator(testIstanbulAddr2, blsPublicKey),
+		),
+		votes: []*store.Vote{
+			{
+				Validator: testIstanbulAddr1,
+				Candidate: validators.NewBLSValidator(testIstanbulAddr3, blsPublicKey),
+				Authorize: true,
+			},
+		},
+	})
+
+	addrs, err := endpoint.GetValidators(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.Address{testIstanbulAddr1, testIstanbulAddr2}, addrs)
+
+	res, err := endpoint.GetSnapshot(nil)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(res)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"number": "0x1",
+		"hash": "`+types.StringToHash("11").String()+`",
+		"validators": [
+			{"address": "`+testIstanbulAddr1.String()+`", "blsPublicKey": "0x0102"},
+			{"address": "`+testIstanbulAddr2.String()+`", "blsPublicKey": "0x0102"}
+		],
+		"votes": [
+			{"validator": "`+testIstanbulAddr1.String()+`", "candidate": "`+testIstanbulAddr3.String()+`", "authorize": true}
+		]
+	}`, string(data))
+}
+
+func TestIstanbulCandidates(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newTestIstanbulEndpoint(&mockIstanbulBackend{
+		candidates: []*store.Candidate{
+			{
+				Validator: validators.NewECDSAValidator(testIstanbulAddr3),
+				Authorize: false,
+			},
+		},
+	})
+
+	res, err := endpoint.Candidates()
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(res)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `[{"address": "`+testIstanbulAddr3.String()+`", "authorize": false}]`, string(data))
+}
+
+func TestIstanbulNotSupported(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newTestIstanbulEndpoint(nil)
+
+	_, err := endpoint.GetSignersFromBlock(nil)
+	assert.ErrorIs(t, err, ErrIstanbulNotSupported)
+
+	_, err = endpoint.GetValidators(nil)
+	assert.ErrorIs(t, err, ErrIstanbulNotSupported)
+
+	_, err = endpoint.GetSnapshot(nil)
+	assert.ErrorIs(t, err, ErrIstanbulNotSupported)
+
+	_, err = endpoint.Candidates()
+	assert.ErrorIs(t, err, ErrIstanbulNotSupported)
+}
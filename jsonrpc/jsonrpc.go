@@ -56,6 +56,7 @@ type JSONRPCStore interface {
 	txPoolStore
 	filterManagerStore
 	debugStore
+	istanbulStore
 }
 
 type Config struct {
@@ -67,6 +68,12 @@ type Config struct {
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+
+	GasPriceOracleBlocks     uint64
+	GasPriceOraclePercentile uint64
+
+	// LightMode serves only the endpoints whose data is available to a light node
+	LightMode bool
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -78,11 +85,14 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 			logger,
 			config.Store,
 			&dispatcherParams{
-				chainID:                 config.ChainID,
-				chainName:               config.ChainName,
-				priceLimit:              config.PriceLimit,
-				jsonRPCBatchLengthLimit: config.BatchLengthLimit,
-				blockRangeLimit:         config.BlockRangeLimit,
+				chainID:                  config.ChainID,
+				chainName:                config.ChainName,
+				priceLimit:               config.PriceLimit,
+				jsonRPCBatchLengthLimit:  config.BatchLengthLimit,
+				blockRangeLimit:          config.BlockRangeLimit,
+				gasPriceOracleBlocks:     config.GasPriceOracleBlocks,
+				gasPriceOraclePercentile: config.GasPriceOraclePercentile,
+				lightMode:                config.LightMode,
 			},
 		),
 	}
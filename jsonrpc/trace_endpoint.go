@@ -0,0 +1,384 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/flattracer"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+var (
+	// ErrUnsupportedTraceType is an error returned when the requested trace type is not supported
+	ErrUnsupportedTraceType = errors.New("unsupported trace type")
+)
+
+// traceTraceType is the only supported trace type, stateDiff and vmTrace are not
+const traceTraceType = "trace"
+
+// Trace is the trace jsonrpc endpoint, returning the calls
+// made by the transactions in the format of the OpenEthereum trace module
+type Trace struct {
+	store           debugStore
+	blockRangeLimit uint64
+}
+
+// TraceFilter is the filter of the traces returned by trace_filter
+type TraceFilter struct {
+	FromBlock   *BlockNumber    `json:"fromBlock"`
+	ToBlock     *BlockNumber    `json:"toBlock"`
+	FromAddress []types.Address `json:"fromAddress"`
+	ToAddress   []types.Address `json:"toAddress"`
+	After       *uint64         `json:"after"`
+	Count       *uint64         `json:"count"`
+}
+
+// TraceResults is the result of tracing a transaction with the given trace types
+type TraceResults struct {
+	Output          string              `json:"output"`
+	StateDiff       interface{}         `json:"stateDiff"`
+	Trace           []*flattracer.Trace `json:"trace"`
+	VMTrace         interface{}         `json:"vmTrace"`
+	TransactionHash *types.Hash         `json:"transactionHash,omitempty"`
+}
+
+// Block returns the traces of all the transactions in the block
+func (t *Trace) Block(blockNumber BlockNumber) (interface{}, error) {
+	num, err := GetNumericBlockNumber(blockNumber, t.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := t.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", num)
+	}
+
+	return t.traceBlock(block)
+}
+
+// Transaction returns the traces of the transaction
+func (t *Trace) Transaction(txHash types.Hash) (interface{}, error) {
+	tx, block := GetTxAndBlockByTxHash(txHash, t.store)
+	if tx == nil {
+		return nil, fmt.Errorf("tx %s not found", txHash.String())
+	}
+
+	if block.Number() == 0 {
+		return nil, ErrTraceGenesisBlock
+	}
+
+	tracer := flattracer.NewFlatTracer()
+
+	cancel := cancelOnTimeout(tracer, defaultTraceTimeout)
+	defer cancel()
+
+	result, err := t.store.TraceTxn(block, tx.Hash, tracer)
+	if err != nil {
+		return nil, stateError(err, block.Number()-1)
+	}
+
+	traces, _ := result.([]*flattracer.Trace)
+
+	for idx, blockTx := range block.Transactions {
+		if blockTx.Hash == tx.Hash {
+			setTraceLocation(traces, block, idx)
+
+			break
+		}
+	}
+
+	return traces, nil
+}
+
+// Call returns the traces of a call executed on top of the given block
+func (t *Trace) Call(
+	arg *txnArgs,
+	traceTypes []string,
+	filter BlockNumberOrHash,
+) (interface{}, error) {
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(filter, t.store)
+	if err != nil {
+		return nil, ErrHeaderNotFound
+	}
+
+	tx, err := DecodeTxn(arg, t.store)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if tx.Gas == 0 {
+		tx.Gas = header.GasLimit
+	}
+
+	tracer := flattracer.NewFlatTracer()
+
+	cancel := cancelOnTimeout(tracer, defaultTraceTimeout)
+	defer cancel()
+
+	result, err := t.store.TraceCall(tx, header, tracer)
+	if err != nil {
+		return nil, stateError(err, header.Number)
+	}
+
+	traces, _ := result.([]*flattracer.Trace)
+
+	return newTraceResults(traces, traceTypes), nil
+}
+
+// ReplayBlockTransactions returns the traces of all the transactions in the block
+// along with their outputs
+func (t *Trace) ReplayBlockTransactions(
+	blockNumber BlockNumber,
+	traceTypes []string,
+) (interface{}, error) {
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+
+	num, err := GetNumericBlockNumber(blockNumber, t.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := t.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", num)
+	}
+
+	if block.Number() == 0 {
+		return []*TraceResults{}, nil
+	}
+
+	txTraces, err := t.traceTransactions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, len(txTraces))
+
+	for idx, traces := range txTraces {
+		results[idx] = newTraceResults(traces, traceTypes)
+		results[idx].TransactionHash = &block.Transactions[idx].Hash
+	}
+
+	return results, nil
+}
+
+// Filter returns the traces in the block range made from or to the given addresses
+func (t *Trace) Filter(filter *TraceFilter) (interface{}, error) {
+	if filter == nil {
+		return nil, ErrNoConfig
+	}
+
+	from, err := t.filterBlockNumber(filter.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := t.filterBlockNumber(filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if to < from {
+		return nil, ErrIncorrectBlockRange
+	}
+
+	// if not disabled, avoid handling large block ranges
+	if t.blockRangeLimit != 0 && to-from > t.blockRangeLimit {
+		return nil, ErrBlockRangeTooHigh
+	}
+
+	// the genesis block has no transactions
+	if from == 0 {
+		from = 1
+	}
+
+	var (
+		traces  = []*flattracer.Trace{}
+		skipped = uint64(0)
+	)
+
+	if filter.Count != nil && *filter.Count == 0 {
+		return traces, nil
+	}
+
+	for i := from; i <= to; i++ {
+		block, ok := t.store.GetBlockByNumber(i, true)
+		if !ok {
+			break
+		}
+
+		if len(block.Transactions) == 0 {
+			continue
+		}
+
+		blockTraces, err := t.traceBlock(block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !filter.match(trace) {
+				continue
+			}
+
+			if filter.After != nil && skipped < *filter.After {
+				skipped++
+
+				continue
+			}
+
+			traces = append(traces, trace)
+
+			if filter.Count != nil && uint64(len(traces)) == *filter.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// traceBlock returns the traces of all the transactions in the block,
+// including the location of the transactions
+func (t *Trace) traceBlock(block *types.Block) ([]*flattracer.Trace, error) {
+	traces := []*flattracer.Trace{}
+
+	if block.Number() == 0 {
+		return traces, nil
+	}
+
+	txTraces, err := t.traceTransactions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, txTrace := range txTraces {
+		setTraceLocation(txTrace, block, idx)
+
+		traces = append(traces, txTrace...)
+	}
+
+	return traces, nil
+}
+
+// traceTransactions returns the traces of each transaction in the block
+func (t *Trace) traceTransactions(block *types.Block) ([][]*flattracer.Trace, error) {
+	tracer := flattracer.NewFlatTracer()
+
+	cancel := cancelOnTimeout(tracer, defaultTraceTimeout)
+	defer cancel()
+
+	results, err := t.store.TraceBlock(block, tracer)
+	if err != nil {
+		return nil, stateError(err, block.Number()-1)
+	}
+
+	txTraces := make([][]*flattracer.Trace, len(results))
+
+	for idx, result := range results {
+		txTraces[idx], _ = result.([]*flattracer.Trace)
+	}
+
+	return txTraces, nil
+}
+
+func (t *Trace) filterBlockNumber(number *BlockNumber) (uint64, error) {
+	if number == nil {
+		return GetNumericBlockNumber(LatestBlockNumber, t.store)
+	}
+
+	return GetNumericBlockNumber(*number, t.store)
+}
+
+// match returns true if the trace is made from and to the addresses of the filter
+func (f *TraceFilter) match(trace *flattracer.Trace) bool {
+	from, to := trace.Action.From, trace.Action.To
+
+	switch {
+	case trace.Result != nil && trace.Result.Address != nil:
+		// creation
+		to = trace.Result.Address
+	case trace.Action.Address != nil:
+		// self destruct
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	}
+
+	return matchAddress(f.FromAddress, from) && matchAddress(f.ToAddress, to)
+}
+
+// matchAddress returns true if the addresses are empty or contain the address
+func matchAddress(addresses []types.Address, addr *types.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+
+	if addr == nil {
+		return false
+	}
+
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setTraceLocation(traces []*flattracer.Trace, block *types.Block, txIndex int) {
+	var (
+		blockHash   = block.Hash()
+		blockNumber = block.Number()
+		txHash      = block.Transactions[txIndex].Hash
+		txPosition  = uint64(txIndex)
+	)
+
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txPosition
+	}
+}
+
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		if traceType != traceTraceType {
+			return fmt.Errorf("%w: %s", ErrUnsupportedTraceType, traceType)
+		}
+	}
+
+	return nil
+}
+
+// newTraceResults returns the results of a transaction,
+// the traces are included only if they are requested
+func newTraceResults(traces []*flattracer.Trace, traceTypes []string) *TraceResults {
+	results := &TraceResults{
+		Output: "0x",
+	}
+
+	if len(traces) != 0 && traces[0].Result != nil {
+		if traces[0].Result.Code != "" {
+			results.Output = traces[0].Result.Code
+		} else if traces[0].Result.Output != "" {
+			results.Output = traces[0].Result.Output
+		}
+	}
+
+	for _, traceType := range traceTypes {
+		if traceType == traceTraceType {
+			results.Trace = traces
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,298 @@
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer/flattracer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testTraceFrom = types.StringToAddress("1")
+	testTraceTo   = types.StringToAddress("2")
+)
+
+// newTestTraces returns the traces of a call and its subcall
+func newTestTraces(from, to types.Address) []*flattracer.Trace {
+	return []*flattracer.Trace{
+		{
+			Type:         "call",
+			Action:       flattracer.Action{CallType: "call", From: &from, To: &to},
+			Result:       &flattracer.Result{GasUsed: "0x1", Output: "0x02"},
+			Subtraces:    1,
+			TraceAddress: []int{},
+		},
+		{
+			Type:         "call",
+			Action:       flattracer.Action{CallType: "staticcall", From: &to, To: &from},
+			Result:       &flattracer.Result{GasUsed: "0x1", Output: "0x"},
+			TraceAddress: []int{0},
+		},
+	}
+}
+
+// newTestTraceBlock returns a block with a transaction for each of the given senders
+func newTestTraceBlock(number uint64, senders ...types.Address) *types.Block {
+	block := &types.Block{
+		Header: createTestHeader(number),
+	}
+
+	for i, sender := range senders {
+		block.Transactions = append(block.Transactions, &types.Transaction{
+			Hash: types.BytesToHash([]byte{byte(number), byte(i)}),
+			From: sender,
+		})
+	}
+
+	return block
+}
+
+// newTestTraceStore returns a store with the given blocks,
+// whose transactions are traced as a call from their sender
+func newTestTraceStore(blocks ...*types.Block) *debugEndpointMockStore {
+	return &debugEndpointMockStore{
+		headerFn: func() *types.Header {
+			return blocks[len(blocks)-1].Header
+		},
+		getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+			for _, block := range blocks {
+				if block.Number() == num {
+					return block, true
+				}
+			}
+
+			return nil, false
+		},
+		traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
+			results := make([]interface{}, len(block.Transactions))
+			for i, tx := range block.Transactions {
+				results[i] = newTestTraces(tx.From, testTraceTo)
+			}
+
+			return results, nil
+		},
+	}
+}
+
+func TestTraceBlock_Location(t *testing.T) {
+	t.Parallel()
+
+	block := newTestTraceBlock(1, testTraceFrom, testTraceFrom)
+	endpoint := &Trace{store: newTestTraceStore(block)}
+
+	res, err := endpoint.Block(BlockNumber(1))
+	assert.NoError(t, err)
+
+	traces, ok := res.([]*flattracer.Trace)
+	assert.True(t, ok)
+	assert.Len(t, traces, 4)
+
+	for i, trace := range traces {
+		txIndex := i / 2
+
+		assert.Equal(t, block.Hash(), *trace.BlockHash)
+		assert.Equal(t, uint64(1), *trace.BlockNumber)
+		assert.Equal(t, block.Transactions[txIndex].Hash, *trace.TransactionHash)
+		assert.Equal(t, uint64(txIndex), *trace.TransactionPosition)
+	}
+}
+
+func TestTraceTransaction_Location(t *testing.T) {
+	t.Parallel()
+
+	block := newTestTraceBlock(1, testTraceFrom, testTraceFrom)
+	txHash := block.Transactions[1].Hash
+
+	endpoint := &Trace{
+		store: &debugEndpointMockStore{
+			readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
+				return block.Hash(), true
+			},
+			getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
+				return block, true
+			},
+			traceTxnFn: func(b *types.Block, hash types.Hash, tracer tracer.Tracer) (interface{}, error) {
+				assert.Equal(t, txHash, hash)
+
+				return newTestTraces(testTraceFrom, testTraceTo), nil
+			},
+		},
+	}
+
+	res, err := endpoint.Transaction(txHash)
+	assert.NoError(t, err)
+
+	traces, ok := res.([]*flattracer.Trace)
+	assert.True(t, ok)
+
+	for _, trace := range traces {
+		assert.Equal(t, txHash, *trace.TransactionHash)
+		assert.Equal(t, uint64(1), *trace.TransactionPosition)
+	}
+}
+
+func TestTraceReplayBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	block := newTestTraceBlock(1, testTraceFrom)
+	endpoint := &Trace{store: newTestTraceStore(block)}
+
+	t.Run("should return the traces and outputs of the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.ReplayBlockTransactions(BlockNumber(1), []string{"trace"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []*TraceResults{
+			{
+				Output:          "0x02",
+				Trace:           newTestTraces(testTraceFrom, testTraceTo),
+				TransactionHash: &block.Transactions[0].Hash,
+			},
+		}, res)
+	})
+
+	t.Run("should return error if the trace type is not supported", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.ReplayBlockTransactions(BlockNumber(1), []string{"trace", "vmTrace"})
+
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, ErrUnsupportedTraceType)
+	})
+}
+
+func TestTraceFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		other = types.StringToAddress("3")
+
+		blocks = []*types.Block{
+			newTestTraceBlock(0),
+			newTestTraceBlock(1, testTraceFrom, other),
+			newTestTraceBlock(2),
+			newTestTraceBlock(3, other, testTraceFrom),
+		}
+
+		one, three = BlockNumber(1), BlockNumber(3)
+		zero, two  = uint64(0), uint64(2)
+	)
+
+	tests := []struct {
+		name   string
+		filter *TraceFilter
+		limit  uint64
+		txs    []types.Hash
+		err    error
+	}{
+		{
+			name: "should return the traces from the address",
+			filter: &TraceFilter{
+				FromBlock:   &one,
+				ToBlock:     &three,
+				FromAddress: []types.Address{testTraceFrom},
+			},
+			txs: []types.Hash{blocks[1].Transactions[0].Hash, blocks[3].Transactions[1].Hash},
+		},
+		{
+			name: "should return the traces to the address",
+			filter: &TraceFilter{
+				ToAddress: []types.Address{other},
+			},
+			txs: []types.Hash{blocks[3].Transactions[0].Hash},
+		},
+		{
+			name: "should skip and limit the traces",
+			filter: &TraceFilter{
+				FromBlock: &one,
+				After:     &two,
+				Count:     &two,
+			},
+			txs: []types.Hash{blocks[1].Transactions[1].Hash, blocks[1].Transactions[1].Hash},
+		},
+		{
+			name: "should return no traces if count is zero",
+			filter: &TraceFilter{
+				FromBlock: &one,
+				Count:     &zero,
+			},
+			txs: []types.Hash{},
+		},
+		{
+			name: "should return error if the range exceeds the limit",
+			filter: &TraceFilter{
+				FromBlock: &one,
+				ToBlock:   &three,
+			},
+			limit: 1,
+			err:   ErrBlockRangeTooHigh,
+		},
+		{
+			name: "should return error if the range is incorrect",
+			filter: &TraceFilter{
+				FromBlock: &three,
+				ToBlock:   &one,
+			},
+			err: ErrIncorrectBlockRange,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := &Trace{
+				store:           newTestTraceStore(blocks...),
+				blockRangeLimit: test.limit,
+			}
+
+			res, err := endpoint.Filter(test.filter)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			traces, ok := res.([]*flattracer.Trace)
+			assert.True(t, ok)
+
+			txs := make([]types.Hash, len(traces))
+			for i, trace := range traces {
+				txs[i] = *trace.TransactionHash
+			}
+
+			assert.Equal(t, test.txs, txs)
+		})
+	}
+}
+
+func TestTraceCall_HistoricalStateUnavailable(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &Trace{
+		store: &debugEndpointMockStore{
+			headerFn: func() *types.Header {
+				return testHeader10
+			},
+			getNonceFn: func(types.Address) uint64 {
+				return 0
+			},
+			traceCallFn: func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error) {
+				return nil, state.ErrStateNotFound
+			},
+		},
+	}
+
+	res, err := endpoint.Call(&txnArgs{To: &testTraceTo}, []string{"trace"}, BlockNumberOrHash{})
+
+	assert.Nil(t, res)
+	assert.Equal(t, NewHistoricalStateUnavailableError(testHeader10.Number), err)
+}
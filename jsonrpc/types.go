@@ -29,6 +29,13 @@ type transaction struct {
 	BlockHash   *types.Hash    `json:"blockHash"`
 	BlockNumber *argUint64     `json:"blockNumber"`
 	TxIndex     *argUint64     `json:"transactionIndex"`
+
+	// EIP-2718 fields, omitted for legacy transactions
+	Type       argUint64           `json:"type,omitempty"`
+	ChainID    *argBig             `json:"chainId,omitempty"`
+	GasFeeCap  *argBig             `json:"maxFeePerGas,omitempty"`
+	GasTipCap  *argBig             `json:"maxPriorityFeePerGas,omitempty"`
+	AccessList *types.TxAccessList `json:"accessList,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -43,18 +50,21 @@ func (h transactionHash) MarshalText() ([]byte, error) {
 }
 
 func toPendingTransaction(t *types.Transaction) *transaction {
-	return toTransaction(t, nil, nil, nil)
+	return toTransaction(t, nil, nil, nil, 0)
 }
 
+// toTransaction converts the transaction to its JSON form.
+// The gas price is the effective gas price of the transaction in a block with the given base fee
 func toTransaction(
 	t *types.Transaction,
 	blockNumber *argUint64,
 	blockHash *types.Hash,
 	txIndex *int,
+	baseFee uint64,
 ) *transaction {
 	res := &transaction{
 		Nonce:    argUint64(t.Nonce),
-		GasPrice: argBig(*t.GasPrice),
+		GasPrice: argBig(*t.GetGasPrice(baseFee)),
 		Gas:      argUint64(t.Gas),
 		To:       t.To,
 		Value:    argBig(*t.Value),
@@ -66,6 +76,22 @@ func toTransaction(
 		From:     t.From,
 	}
 
+	if t.Type != types.LegacyTx {
+		accessList := t.AccessList
+		if accessList == nil {
+			accessList = types.TxAccessList{}
+		}
+
+		res.Type = argUint64(t.Type)
+		res.ChainID = argBigPtr(t.ChainID)
+		res.AccessList = &accessList
+	}
+
+	if t.Type == types.DynamicFeeTx {
+		res.GasFeeCap = argBigPtr(t.GasFeeCap)
+		res.GasTipCap = argBigPtr(t.GasTipCap)
+	}
+
 	if blockNumber != nil {
 		res.BlockNumber = blockNumber
 	}
@@ -102,6 +128,7 @@ type block struct {
 	Hash            types.Hash          `json:"hash"`
 	Transactions    []transactionOrHash `json:"transactions"`
 	Uncles          []types.Hash        `json:"uncles"`
+	BaseFee         argUint64           `json:"baseFeePerGas,omitempty"`
 }
 
 func (b *block) Copy() *block {
@@ -140,6 +167,7 @@ func toBlock(b *types.Block, fullTx bool) *block {
 		Hash:            h.Hash,
 		Transactions:    []transactionOrHash{},
 		Uncles:          []types.Hash{},
+		BaseFee:         argUint64(h.BaseFee),
 	}
 
 	for idx, txn := range b.Transactions {
@@ -151,6 +179,7 @@ func toBlock(b *types.Block, fullTx bool) *block {
 					argUintPtr(b.Number()),
 					argHashPtr(b.Hash()),
 					&idx,
+					h.BaseFee,
 				),
 			)
 		} else {
@@ -182,6 +211,8 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	Type              argUint64      `json:"type,omitempty"`
+	EffectiveGasPrice argBig         `json:"effectiveGasPrice"`
 }
 
 type Log struct {
@@ -307,14 +338,43 @@ func encodeToHex(b []byte) []byte {
 
 // txnArgs is the transaction argument for the rpc endpoints
 type txnArgs struct {
-	From     *types.Address
-	To       *types.Address
-	Gas      *argUint64
-	GasPrice *argBytes
-	Value    *argBytes
-	Data     *argBytes
-	Input    *argBytes
-	Nonce    *argUint64
+	From                 *types.Address
+	To                   *types.Address
+	Gas                  *argUint64
+	GasPrice             *argBytes
+	MaxFeePerGas         *argBytes
+	MaxPriorityFeePerGas *argBytes
+	Value                *argBytes
+	Data                 *argBytes
+	Input                *argBytes
+	Nonce                *argUint64
+	Type                 *argUint64
+	AccessList           *types.TxAccessList
+}
+
+type accountProofResult struct {
+	Address      types.Address        `json:"address"`
+	AccountProof []argBytes           `json:"accountProof"`
+	Balance      argBig               `json:"balance"`
+	CodeHash     types.Hash           `json:"codeHash"`
+	Nonce        argUint64            `json:"nonce"`
+	StorageHash  types.Hash           `json:"storageHash"`
+	StorageProof []storageProofResult `json:"storageProof"`
+}
+
+type storageProofResult struct {
+	Key   types.Hash `json:"key"`
+	Value argBig     `json:"value"`
+	Proof []argBytes `json:"proof"`
+}
+
+func toArgBytesList(list [][]byte) []argBytes {
+	res := make([]argBytes, len(list))
+	for i, b := range list {
+		res[i] = argBytes(b)
+	}
+
+	return res
 }
 
 type progression struct {
@@ -323,3 +383,10 @@ type progression struct {
 	CurrentBlock  argUint64 `json:"currentBlock"`
 	HighestBlock  argUint64 `json:"highestBlock"`
 }
+
+type feeHistoryResult struct {
+	OldestBlock   argUint64   `json:"oldestBlock"`
+	BaseFeePerGas []argUint64 `json:"baseFeePerGas"`
+	GasUsedRatio  []float64   `json:"gasUsedRatio"`
+	Reward        [][]argBig  `json:"reward,omitempty"`
+}
@@ -117,7 +117,7 @@ func TestToTransaction_Returns_V_R_S_ValuesWithoutLeading0(t *testing.T) {
 		From:     types.Address{},
 	}
 
-	jsonTx := toTransaction(&txn, nil, nil, nil)
+	jsonTx := toTransaction(&txn, nil, nil, nil, 0)
 
 	jsonV, _ := jsonTx.V.MarshalText()
 	jsonR, _ := jsonTx.R.MarshalText()
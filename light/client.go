@@ -0,0 +1,485 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/light/proto"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/umbracle/fastrlp"
+	"google.golang.org/grpc"
+)
+
+const (
+	clientLoggerName = "light-client"
+
+	// maxRequestAttempts is the maximum number of peers asked for the same data
+	maxRequestAttempts = 5
+	// requestTimeout is the time a peer has to respond to a request
+	requestTimeout = 10 * time.Second
+	// defaultCacheSize is the size of the caches of the fetched bodies, receipts and proofs
+	defaultCacheSize = 256
+)
+
+var (
+	ErrNoPeers          = errors.New("no peers to request the data from")
+	ErrRequestFailed    = errors.New("no peer returned valid data")
+	ErrInvalidBody      = errors.New("block body does not match the header")
+	ErrInvalidReceipts  = errors.New("receipts do not match the header")
+	ErrInvalidCode      = errors.New("code does not match the hash")
+	ErrInvalidTxLookup  = errors.New("block does not include the transaction")
+	ErrUnknownBlockHash = errors.New("block is not in the local chain")
+)
+
+var emptyCodeHash = types.BytesToHash(crypto.Keccak256(nil))
+
+// Client fetches the data not stored by a light node from its peers,
+// and verifies it against the local verified headers before returning it
+type Client struct {
+	logger     hclog.Logger
+	blockchain Blockchain
+	signer     TxSigner
+
+	// peers returns the peers to request the data from
+	peers func() []peer.ID
+	// dial opens the connection to the light protocol of the peer
+	dial func(peer.ID) (*grpc.ClientConn, error)
+
+	conns     map[peer.ID]*grpc.ClientConn
+	connsLock sync.Mutex
+
+	// next is the index of the peer asked first by the next request, so that the load is spread
+	next uint64
+
+	bodies   *lru.Cache
+	receipts *lru.Cache
+	proofs   *lru.Cache
+}
+
+func NewClient(
+	logger hclog.Logger,
+	network Network,
+	blockchain Blockchain,
+	signer TxSigner,
+) *Client {
+	return newClient(
+		logger,
+		blockchain,
+		signer,
+		func() []peer.ID {
+			peers := network.Peers()
+			ids := make([]peer.ID, 0, len(peers))
+
+			for _, p := range peers {
+				ids = append(ids, p.Info.ID)
+			}
+
+			return ids
+		},
+		func(peerID peer.ID) (*grpc.ClientConn, error) {
+			return network.NewProtoConnection(lightProto, peerID)
+		},
+	)
+}
+
+func newClient(
+	logger hclog.Logger,
+	blockchain Blockchain,
+	signer TxSigner,
+	peers func() []peer.ID,
+	dial func(peer.ID) (*grpc.ClientConn, error),
+) *Client {
+	// the size is valid, so the caches are always created
+	bodies, _ := lru.New(defaultCacheSize)
+	receipts, _ := lru.New(defaultCacheSize)
+	proofs, _ := lru.New(defaultCacheSize)
+
+	return &Client{
+		logger:     logger.Named(clientLoggerName),
+		blockchain: blockchain,
+		signer:     signer,
+		peers:      peers,
+		dial:       dial,
+		conns:      make(map[peer.ID]*grpc.ClientConn),
+		bodies:     bodies,
+		receipts:   receipts,
+		proofs:     proofs,
+	}
+}
+
+// Close closes the connections to the peers
+func (c *Client) Close() {
+	c.connsLock.Lock()
+	defer c.connsLock.Unlock()
+
+	for id, conn := range c.conns {
+		_ = conn.Close()
+
+		delete(c.conns, id)
+	}
+}
+
+// GetBlockBody returns the body of the block with the given header
+func (c *Client) GetBlockBody(header *types.Header) (*types.Body, error) {
+	if header.TxRoot == types.EmptyRootHash && header.Sha3Uncles == types.EmptyUncleHash {
+		return &types.Body{}, nil
+	}
+
+	if body, ok := c.bodies.Get(header.Hash); ok {
+		//nolint:forcetypeassert
+		return body.(*types.Body), nil
+	}
+
+	body := &types.Body{}
+
+	if err := c.request(func(ctx context.Context, client proto.LightClient) error {
+		resp, err := client.GetBlockBody(ctx, &proto.BlockRequest{Hash: header.Hash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		body = &types.Body{}
+		if err := body.UnmarshalRLP(resp.Body); err != nil {
+			return err
+		}
+
+		return c.verifyBody(header, body)
+	}); err != nil {
+		return nil, err
+	}
+
+	c.bodies.Add(header.Hash, body)
+
+	return body, nil
+}
+
+// verifyBody checks the body against the roots in the header,
+// and recovers the senders of the transactions, which are not covered by the roots
+func (c *Client) verifyBody(header *types.Header, body *types.Body) error {
+	if buildroot.CalculateUncleRoot(body.Uncles) != header.Sha3Uncles ||
+		buildroot.CalculateTransactionsRoot(body.Transactions) != header.TxRoot {
+		return ErrInvalidBody
+	}
+
+	for _, tx := range body.Transactions {
+		from, err := c.signer.Sender(tx)
+		if err != nil {
+			return fmt.Errorf("unable to recover the sender of transaction %s: %w", tx.Hash, err)
+		}
+
+		tx.From = from
+	}
+
+	return nil
+}
+
+// GetReceipts returns the receipts of the block with the given header
+func (c *Client) GetReceipts(header *types.Header) ([]*types.Receipt, error) {
+	if header.ReceiptsRoot == types.EmptyRootHash {
+		return []*types.Receipt{}, nil
+	}
+
+	if receipts, ok := c.receipts.Get(header.Hash); ok {
+		//nolint:forcetypeassert
+		return receipts.([]*types.Receipt), nil
+	}
+
+	// the transactions fill the fields of the receipts not covered by the root
+	body, err := c.GetBlockBody(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipts types.Receipts
+
+	if err := c.request(func(ctx context.Context, client proto.LightClient) error {
+		resp, err := client.GetReceipts(ctx, &proto.BlockRequest{Hash: header.Hash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		receipts = types.Receipts{}
+		if err := receipts.UnmarshalStoreRLP(resp.Receipts); err != nil {
+			return err
+		}
+
+		if len(receipts) != len(body.Transactions) ||
+			buildroot.CalculateReceiptsRoot(receipts) != header.ReceiptsRoot {
+			return ErrInvalidReceipts
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	deriveReceiptFields(receipts, body)
+
+	c.receipts.Add(header.Hash, []*types.Receipt(receipts))
+
+	return receipts, nil
+}
+
+// deriveReceiptFields sets the fields of the receipts that are not part of the receipts root
+// from the transactions of the block
+func deriveReceiptFields(receipts []*types.Receipt, body *types.Body) {
+	for i, receipt := range receipts {
+		tx := body.Transactions[i]
+
+		receipt.TxHash = tx.Hash
+		receipt.GasUsed = receipt.CumulativeGasUsed
+
+		if i > 0 {
+			receipt.GasUsed -= receipts[i-1].CumulativeGasUsed
+		}
+
+		receipt.ContractAddress = nil
+		if tx.To == nil {
+			receipt.SetContractAddress(crypto.CreateAddress(tx.From, tx.Nonce))
+		}
+	}
+}
+
+// GetAccountProof returns the account in the state with the given root, nil if it does not exist,
+// and the merkle proof it has been verified with
+func (c *Client) GetAccountProof(root types.Hash, addr types.Address) (*state.Account, [][]byte, error) {
+	value, proof, err := c.getProof(root, crypto.Keccak256(addr.Bytes()))
+	if err != nil || value == nil {
+		return nil, proof, err
+	}
+
+	account := &state.Account{}
+	if err := account.UnmarshalRlp(value); err != nil {
+		return nil, nil, err
+	}
+
+	return account, proof, nil
+}
+
+// GetStorageProof returns the value of the slot in the storage trie with the given root,
+// and the merkle proof it has been verified with
+func (c *Client) GetStorageProof(storageRoot types.Hash, slot types.Hash) (types.Hash, [][]byte, error) {
+	value, proof, err := c.getProof(storageRoot, crypto.Keccak256(slot.Bytes()))
+	if err != nil || value == nil {
+		return types.ZeroHash, proof, err
+	}
+
+	// the slots are stored RLP encoded
+	v, err := (&fastrlp.Parser{}).Parse(value)
+	if err != nil {
+		return types.ZeroHash, nil, err
+	}
+
+	data, err := v.GetBytes(nil)
+	if err != nil {
+		return types.ZeroHash, nil, err
+	}
+
+	return types.BytesToHash(data), proof, nil
+}
+
+// getProof returns the verified value of the key in the trie with the given root and its proof
+func (c *Client) getProof(root types.Hash, key []byte) ([]byte, [][]byte, error) {
+	if root == types.EmptyRootHash {
+		return nil, [][]byte{}, nil
+	}
+
+	cacheKey := string(root.Bytes()) + string(key)
+	if proof, ok := c.proofs.Get(cacheKey); ok {
+		//nolint:forcetypeassert
+		entry := proof.(*proofEntry)
+
+		return entry.value, entry.proof, nil
+	}
+
+	entry := &proofEntry{}
+
+	if err := c.request(func(ctx context.Context, client proto.LightClient) error {
+		resp, err := client.GetProof(ctx, &proto.ProofRequest{Root: root.Bytes(), Key: key})
+		if err != nil {
+			return err
+		}
+
+		value, err := itrie.VerifyProof(root, key, resp.Nodes)
+		if err != nil {
+			return err
+		}
+
+		entry.value, entry.proof = value, resp.Nodes
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	c.proofs.Add(cacheKey, entry)
+
+	return entry.value, entry.proof, nil
+}
+
+// GetCode returns the contract code with the given hash
+func (c *Client) GetCode(hash types.Hash) ([]byte, error) {
+	if hash == emptyCodeHash {
+		return []byte{}, nil
+	}
+
+	var code []byte
+
+	if err := c.request(func(ctx context.Context, client proto.LightClient) error {
+		resp, err := client.GetCode(ctx, &proto.CodeRequest{Hash: hash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		if types.BytesToHash(crypto.Keccak256(resp.Code)) != hash {
+			return ErrInvalidCode
+		}
+
+		code = resp.Code
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+// GetTxLookup returns the hash of the canonical block including the transaction with the given hash
+func (c *Client) GetTxLookup(txHash types.Hash) (types.Hash, error) {
+	var blockHash types.Hash
+
+	if err := c.request(func(ctx context.Context, client proto.LightClient) error {
+		resp, err := client.GetTxLookup(ctx, &proto.TxLookupRequest{Hash: txHash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		blockHash = types.BytesToHash(resp.BlockHash)
+
+		return c.verifyTxLookup(txHash, blockHash)
+	}); err != nil {
+		return types.ZeroHash, err
+	}
+
+	return blockHash, nil
+}
+
+// verifyTxLookup checks the block is in the local chain and includes the transaction
+func (c *Client) verifyTxLookup(txHash, blockHash types.Hash) error {
+	header, ok := c.blockchain.GetHeaderByHash(blockHash)
+	if !ok {
+		return ErrUnknownBlockHash
+	}
+
+	if canonical, ok := c.blockchain.GetHeaderByNumber(header.Number); !ok || canonical.Hash != blockHash {
+		return ErrUnknownBlockHash
+	}
+
+	body, err := c.GetBlockBody(header)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range body.Transactions {
+		if tx.Hash == txHash {
+			return nil
+		}
+	}
+
+	return ErrInvalidTxLookup
+}
+
+// request runs the given request with the peers in turn, until one of them returns valid data
+func (c *Client) request(fn func(context.Context, proto.LightClient) error) error {
+	peers := c.peers()
+	if len(peers) == 0 {
+		return ErrNoPeers
+	}
+
+	attempts := len(peers)
+	if attempts > maxRequestAttempts {
+		attempts = maxRequestAttempts
+	}
+
+	var (
+		start   = atomic.AddUint64(&c.next, 1)
+		lastErr error
+	)
+
+	for i := 0; i < attempts; i++ {
+		id := peers[(start+uint64(i))%uint64(len(peers))]
+
+		client, err := c.client(id)
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		err = fn(ctx, client)
+
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+
+		c.logger.Debug("request to peer failed", "peer", id, "err", err)
+
+		// the connection is opened again by the next request to the peer
+		c.closeClient(id)
+
+		lastErr = err
+	}
+
+	return fmt.Errorf("%w: %v", ErrRequestFailed, lastErr)
+}
+
+// client returns the client of the light protocol of the peer
+func (c *Client) client(id peer.ID) (proto.LightClient, error) {
+	c.connsLock.Lock()
+	defer c.connsLock.Unlock()
+
+	conn, ok := c.conns[id]
+	if !ok {
+		var err error
+
+		if conn, err = c.dial(id); err != nil {
+			return nil, fmt.Errorf("failed to open a stream, err %w", err)
+		}
+
+		c.conns[id] = conn
+	}
+
+	return proto.NewLightClient(conn), nil
+}
+
+// closeClient closes the connection to the light protocol of the peer
+func (c *Client) closeClient(id peer.ID) {
+	c.connsLock.Lock()
+	defer c.connsLock.Unlock()
+
+	if conn, ok := c.conns[id]; ok {
+		_ = conn.Close()
+
+		delete(c.conns, id)
+	}
+}
+
+type proofEntry struct {
+	value []byte
+	proof [][]byte
+}
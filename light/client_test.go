@@ -0,0 +1,403 @@
+package light
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/light/proto"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	bufSize = 1024 * 1024
+	chainID = 100
+)
+
+type mockBlockchain struct {
+	headers   []*types.Header
+	bodies    map[types.Hash]*types.Body
+	receipts  map[types.Hash][]*types.Receipt
+	txLookups map[types.Hash]types.Hash
+}
+
+func (m *mockBlockchain) GetHeaderByHash(hash types.Hash) (*types.Header, bool) {
+	for _, header := range m.headers {
+		if header.Hash == hash {
+			return header, true
+		}
+	}
+
+	return nil, false
+}
+
+func (m *mockBlockchain) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	if number >= uint64(len(m.headers)) {
+		return nil, false
+	}
+
+	return m.headers[number], true
+}
+
+func (m *mockBlockchain) GetBodyByHash(hash types.Hash) (*types.Body, bool) {
+	body, ok := m.bodies[hash]
+
+	return body, ok
+}
+
+func (m *mockBlockchain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	receipts, ok := m.receipts[hash]
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	return receipts, nil
+}
+
+func (m *mockBlockchain) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
+	blockHash, ok := m.txLookups[hash]
+
+	return blockHash, ok
+}
+
+// lightTestChain is a chain whose second block has a transfer and a contract creation
+type lightTestChain struct {
+	full     *mockBlockchain
+	local    *mockBlockchain
+	storage  itrie.Storage
+	header   *types.Header
+	body     *types.Body
+	receipts []*types.Receipt
+	sender   types.Address
+	contract types.Address
+	code     []byte
+}
+
+func newLightTestChain(t *testing.T) *lightTestChain {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	assert.NoError(t, err)
+
+	var (
+		signer   = crypto.NewEIP155Signer(chainID)
+		sender   = crypto.PubKeyToAddress(&key.PublicKey)
+		receiver = types.StringToAddress("0x1")
+		contract = crypto.CreateAddress(sender, 1)
+		code     = []byte{0x1, 0x2, 0x3}
+		storage  = itrie.NewMemoryStorage()
+	)
+
+	txs := []*types.Transaction{
+		{Nonce: 0, To: &receiver, Value: big.NewInt(1), Gas: 21000, GasPrice: big.NewInt(1)},
+		{Nonce: 1, Value: big.NewInt(0), Gas: 100000, GasPrice: big.NewInt(1), Input: code},
+	}
+
+	for i, tx := range txs {
+		txs[i], err = signer.SignTx(tx, key)
+		assert.NoError(t, err)
+
+		txs[i].From = sender
+		txs[i].ComputeHash()
+	}
+
+	receipts := []*types.Receipt{
+		{CumulativeGasUsed: 21000, GasUsed: 21000, TxHash: txs[0].Hash},
+		{CumulativeGasUsed: 71000, GasUsed: 50000, TxHash: txs[1].Hash, Logs: []*types.Log{
+			{Address: contract, Topics: []types.Hash{types.StringToHash("0x2")}, Data: []byte{0x3}},
+		}},
+	}
+
+	for _, receipt := range receipts {
+		receipt.SetStatus(types.ReceiptSuccess)
+		receipt.LogsBloom = types.CreateBloom([]*types.Receipt{receipt})
+	}
+
+	receipts[1].SetContractAddress(contract)
+
+	_, root := itrie.NewState(storage).NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  sender,
+			Balance:  big.NewInt(1000),
+			Nonce:    2,
+			Root:     types.EmptyRootHash,
+			CodeHash: emptyCodeHash,
+		},
+		{
+			Address:   contract,
+			Balance:   big.NewInt(0),
+			Root:      types.EmptyRootHash,
+			CodeHash:  types.BytesToHash(crypto.Keccak256(code)),
+			Code:      code,
+			DirtyCode: true,
+			Storage: []*state.StorageObject{
+				{
+					Key: types.StringToHash("0x1").Bytes(),
+					Val: types.StringToHash("0x2a").Bytes(),
+				},
+			},
+		},
+	})
+
+	genesis := &types.Header{
+		Sha3Uncles:   types.EmptyUncleHash,
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+	}
+	genesis.ComputeHash()
+
+	header := &types.Header{
+		ParentHash:   genesis.Hash,
+		Number:       1,
+		Sha3Uncles:   types.EmptyUncleHash,
+		StateRoot:    types.BytesToHash(root),
+		TxRoot:       buildroot.CalculateTransactionsRoot(txs),
+		ReceiptsRoot: buildroot.CalculateReceiptsRoot(receipts),
+	}
+	header.ComputeHash()
+
+	body := &types.Body{Transactions: txs}
+
+	return &lightTestChain{
+		full: &mockBlockchain{
+			headers:   []*types.Header{genesis, header},
+			bodies:    map[types.Hash]*types.Body{header.Hash: body},
+			receipts:  map[types.Hash][]*types.Receipt{header.Hash: receipts},
+			txLookups: map[types.Hash]types.Hash{txs[0].Hash: header.Hash, txs[1].Hash: header.Hash},
+		},
+		local: &mockBlockchain{
+			headers: []*types.Header{genesis, header},
+		},
+		storage:  storage,
+		header:   header,
+		body:     body,
+		receipts: receipts,
+		sender:   sender,
+		contract: contract,
+		code:     code,
+	}
+}
+
+// newLightTestClient returns a client requesting the data from the given services
+func newLightTestClient(t *testing.T, local Blockchain, services map[peer.ID]*Service) *Client {
+	t.Helper()
+
+	var (
+		listeners = make(map[peer.ID]*bufconn.Listener, len(services))
+		ids       = make([]peer.ID, 0, len(services))
+	)
+
+	for id, service := range services {
+		lis := bufconn.Listen(bufSize)
+		s := grpc.NewServer()
+		proto.RegisterLightServer(s, service)
+
+		go func() {
+			_ = s.Serve(lis)
+		}()
+
+		t.Cleanup(s.Stop)
+
+		listeners[id] = lis
+		ids = append(ids, id)
+	}
+
+	client := newClient(
+		hclog.NewNullLogger(),
+		local,
+		crypto.NewEIP155Signer(chainID),
+		func() []peer.ID {
+			return ids
+		},
+		func(id peer.ID) (*grpc.ClientConn, error) {
+			lis := listeners[id]
+
+			return grpc.DialContext(context.Background(), "bufnet",
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(
+					func(ctx context.Context, address string) (net.Conn, error) {
+						return lis.Dial()
+					},
+				),
+			)
+		},
+	)
+
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// newTamperedService returns a service whose blocks have the transactions and receipts in reverse order
+func newTamperedService(chain *lightTestChain) *Service {
+	var (
+		txs      = chain.body.Transactions
+		receipts = chain.receipts
+	)
+
+	return NewService(nil, &mockBlockchain{
+		headers: chain.full.headers,
+		bodies: map[types.Hash]*types.Body{
+			chain.header.Hash: {Transactions: []*types.Transaction{txs[1], txs[0]}},
+		},
+		receipts: map[types.Hash][]*types.Receipt{
+			chain.header.Hash: {receipts[1], receipts[0]},
+		},
+		txLookups: map[types.Hash]types.Hash{
+			txs[0].Hash: chain.full.headers[0].Hash,
+		},
+	}, itrie.NewMemoryStorage())
+}
+
+func TestClient_BlockData(t *testing.T) {
+	t.Parallel()
+
+	chain := newLightTestChain(t)
+
+	t.Run("should return the verified body and receipts", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{
+			"A": newTamperedService(chain),
+			"B": NewService(nil, chain.full, chain.storage),
+		})
+
+		// whichever peer is asked first, the valid data is returned
+		for i := 0; i < 2; i++ {
+			client.bodies.Purge()
+			client.receipts.Purge()
+
+			body, err := client.GetBlockBody(chain.header)
+			assert.NoError(t, err)
+			assert.Len(t, body.Transactions, 2)
+
+			for j, tx := range body.Transactions {
+				assert.Equal(t, chain.body.Transactions[j].Hash, tx.Hash)
+				assert.Equal(t, chain.sender, tx.From)
+			}
+
+			receipts, err := client.GetReceipts(chain.header)
+			assert.NoError(t, err)
+			assert.Len(t, receipts, 2)
+
+			for j, receipt := range receipts {
+				assert.Equal(t, chain.receipts[j].TxHash, receipt.TxHash)
+				assert.Equal(t, chain.receipts[j].GasUsed, receipt.GasUsed)
+				assert.Equal(t, chain.receipts[j].ContractAddress, receipt.ContractAddress)
+			}
+		}
+	})
+
+	t.Run("should fail if no peer returns valid data", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{
+			"A": newTamperedService(chain),
+		})
+
+		_, err := client.GetBlockBody(chain.header)
+		assert.ErrorIs(t, err, ErrRequestFailed)
+
+		_, err = client.GetTxLookup(chain.body.Transactions[0].Hash)
+		assert.ErrorIs(t, err, ErrRequestFailed)
+	})
+
+	t.Run("should not request the empty blocks", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{})
+
+		body, err := client.GetBlockBody(chain.local.headers[0])
+		assert.NoError(t, err)
+		assert.Empty(t, body.Transactions)
+
+		receipts, err := client.GetReceipts(chain.local.headers[0])
+		assert.NoError(t, err)
+		assert.Empty(t, receipts)
+
+		_, err = client.GetBlockBody(chain.header)
+		assert.ErrorIs(t, err, ErrNoPeers)
+	})
+
+	t.Run("should return the verified block of the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{
+			"A": NewService(nil, chain.full, chain.storage),
+		})
+
+		blockHash, err := client.GetTxLookup(chain.body.Transactions[1].Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, chain.header.Hash, blockHash)
+
+		_, err = client.GetTxLookup(types.StringToHash("0x3"))
+		assert.ErrorIs(t, err, ErrRequestFailed)
+	})
+}
+
+func TestClient_StateData(t *testing.T) {
+	t.Parallel()
+
+	chain := newLightTestChain(t)
+	root := chain.header.StateRoot
+
+	t.Run("should return the verified accounts and slots", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{
+			"A": NewService(nil, chain.full, chain.storage),
+		})
+
+		account, proof, err := client.GetAccountProof(root, chain.sender)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, proof)
+		assert.Equal(t, uint64(2), account.Nonce)
+		assert.Equal(t, big.NewInt(1000), account.Balance)
+
+		contract, _, err := client.GetAccountProof(root, chain.contract)
+		assert.NoError(t, err)
+
+		value, _, err := client.GetStorageProof(contract.Root, types.StringToHash("0x1"))
+		assert.NoError(t, err)
+		assert.Equal(t, types.StringToHash("0x2a"), value)
+
+		value, _, err = client.GetStorageProof(contract.Root, types.StringToHash("0x2"))
+		assert.NoError(t, err)
+		assert.Equal(t, types.ZeroHash, value)
+
+		code, err := client.GetCode(types.BytesToHash(contract.CodeHash))
+		assert.NoError(t, err)
+		assert.Equal(t, chain.code, code)
+
+		// non-existing accounts are proven by their absence
+		account, proof, err = client.GetAccountProof(root, types.StringToAddress("0xff"))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, proof)
+		assert.Nil(t, account)
+	})
+
+	t.Run("should fail if no peer has the state", func(t *testing.T) {
+		t.Parallel()
+
+		client := newLightTestClient(t, chain.local, map[peer.ID]*Service{
+			"A": NewService(nil, chain.full, itrie.NewMemoryStorage()),
+		})
+
+		_, _, err := client.GetAccountProof(root, chain.sender)
+		assert.ErrorIs(t, err, ErrRequestFailed)
+
+		_, err = client.GetCode(types.BytesToHash(crypto.Keccak256(chain.code)))
+		assert.ErrorIs(t, err, ErrRequestFailed)
+	})
+}
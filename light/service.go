@@ -0,0 +1,140 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/light/proto"
+	"github.com/LaChain/polygon-edge/network/grpc"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	lightProto = "/light/0.1"
+)
+
+var (
+	ErrBlockNotFound     = errors.New("block not found")
+	ErrTxNotFound        = errors.New("transaction not found")
+	ErrCodeNotFound      = errors.New("code not found")
+	ErrStateNotAvailable = errors.New("state not available")
+	ErrInvalidRequest    = errors.New("invalid request")
+)
+
+// Service serves the block bodies, receipts and state proofs requested by the light nodes
+type Service struct {
+	proto.UnimplementedLightServer
+
+	blockchain Blockchain       // reference to the blockchain module
+	network    Network          // reference to the network module
+	state      *itrie.State     // reference to the state served to the light nodes
+	stream     *grpc.GrpcStream // reference to the grpc stream
+}
+
+func NewService(
+	network Network,
+	blockchain Blockchain,
+	stateStorage itrie.Storage,
+) *Service {
+	return &Service{
+		blockchain: blockchain,
+		network:    network,
+		state:      itrie.NewState(stateStorage),
+	}
+}
+
+// Start starts the service
+func (s *Service) Start() {
+	s.stream = grpc.NewGrpcStream()
+
+	proto.RegisterLightServer(s.stream.GrpcServer(), s)
+	s.stream.Serve()
+	s.network.RegisterProtocol(lightProto, s.stream)
+}
+
+// Close closes the service
+func (s *Service) Close() error {
+	return s.stream.Close()
+}
+
+// GetBlockBody is a gRPC endpoint to return the body of a block
+func (s *Service) GetBlockBody(
+	ctx context.Context,
+	req *proto.BlockRequest,
+) (*proto.BlockBody, error) {
+	body, ok := s.blockchain.GetBodyByHash(types.BytesToHash(req.Hash))
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	return &proto.BlockBody{
+		Body: body.MarshalRLPTo(nil),
+	}, nil
+}
+
+// GetReceipts is a gRPC endpoint to return the receipts of a block
+func (s *Service) GetReceipts(
+	ctx context.Context,
+	req *proto.BlockRequest,
+) (*proto.Receipts, error) {
+	receipts, err := s.blockchain.GetReceiptsByHash(types.BytesToHash(req.Hash))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBlockNotFound, err)
+	}
+
+	return &proto.Receipts{
+		Receipts: types.Receipts(receipts).MarshalStoreRLPTo(nil),
+	}, nil
+}
+
+// GetProof is a gRPC endpoint to return the merkle proof of a key in the state or in a storage trie
+func (s *Service) GetProof(
+	ctx context.Context,
+	req *proto.ProofRequest,
+) (*proto.Proof, error) {
+	if len(req.Root) != types.HashLength || len(req.Key) != types.HashLength {
+		return nil, ErrInvalidRequest
+	}
+
+	nodes, err := s.state.GetProof(types.BytesToHash(req.Root), req.Key)
+	if err != nil {
+		// the state has been pruned or was never stored
+		return nil, fmt.Errorf("%w: %v", ErrStateNotAvailable, err)
+	}
+
+	return &proto.Proof{
+		Nodes: nodes,
+	}, nil
+}
+
+// GetCode is a gRPC endpoint to return contract code
+func (s *Service) GetCode(
+	ctx context.Context,
+	req *proto.CodeRequest,
+) (*proto.Code, error) {
+	code, ok := s.state.GetCode(types.BytesToHash(req.Hash))
+	if !ok {
+		return nil, ErrCodeNotFound
+	}
+
+	return &proto.Code{
+		Code: code,
+	}, nil
+}
+
+// GetTxLookup is a gRPC endpoint to return the block including a transaction
+func (s *Service) GetTxLookup(
+	ctx context.Context,
+	req *proto.TxLookupRequest,
+) (*proto.TxLookup, error) {
+	blockHash, ok := s.blockchain.ReadTxLookup(types.BytesToHash(req.Hash))
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	return &proto.TxLookup{
+		BlockHash: blockHash.Bytes(),
+	}, nil
+}
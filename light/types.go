@@ -0,0 +1,36 @@
+package light
+
+import (
+	"github.com/LaChain/polygon-edge/network"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/grpc"
+)
+
+type Blockchain interface {
+	// GetHeaderByHash returns the header of the block with the given hash
+	GetHeaderByHash(types.Hash) (*types.Header, bool)
+	// GetHeaderByNumber returns the canonical header at the given height
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+	// GetBodyByHash returns the body of the block with the given hash
+	GetBodyByHash(types.Hash) (*types.Body, bool)
+	// GetReceiptsByHash returns the receipts of the block with the given hash
+	GetReceiptsByHash(types.Hash) ([]*types.Receipt, error)
+	// ReadTxLookup returns the hash of the block including the transaction with the given hash
+	ReadTxLookup(types.Hash) (types.Hash, bool)
+}
+
+type Network interface {
+	// RegisterProtocol registers gRPC service
+	RegisterProtocol(string, network.Protocol)
+	// Peers returns current connected peers
+	Peers() []*network.PeerConnInfo
+	// NewProtoConnection opens up a new stream on the set protocol to the peer,
+	// and returns a reference to the connection
+	NewProtoConnection(protocol string, peerID peer.ID) (*grpc.ClientConn, error)
+}
+
+type TxSigner interface {
+	// Sender returns the sender of the transaction
+	Sender(tx *types.Transaction) (types.Address, error)
+}
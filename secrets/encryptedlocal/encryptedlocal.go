@@ -0,0 +1,379 @@
+package encryptedlocal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/helper/common"
+	"github.com/LaChain/polygon-edge/secrets"
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo/keystore"
+)
+
+// secretFilePerm is the permission of the encrypted secret files
+const secretFilePerm = 0600
+
+var (
+	errNoPath = errors.New("no path specified for encrypted local secrets manager")
+
+	ErrSecretNotEncrypted = errors.New("secret is stored as plaintext, migrate it with the secrets init command")
+	ErrWrongPassphrase    = errors.New("the passphrase doesn't decrypt the secrets encrypted already")
+)
+
+// EncryptedLocalSecretsManager is a SecretsManager that stores secrets locally on disk,
+// encrypted with a passphrase in the Web3 Secret Storage (keystore v3) format
+type EncryptedLocalSecretsManager struct {
+	// Logger object
+	logger hclog.Logger
+
+	// Path to the base working directory
+	path string
+
+	// Passphrase used to encrypt the secrets
+	passphrase string
+
+	// scryptN is the scrypt cost parameter of the new secrets,
+	// the keystore default is used if zero
+	scryptN int
+
+	// Map of known secrets and their paths
+	secretPathMap map[string]string
+
+	// Map of the secrets decrypted so far
+	decrypted map[string][]byte
+
+	// Mux for the secretPathMap and decrypted
+	lock sync.RWMutex
+}
+
+// SecretsManagerFactory implements the factory method
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	// Set up the base object
+	manager := &EncryptedLocalSecretsManager{
+		logger:        params.Logger.Named(string(secrets.EncryptedLocal)),
+		secretPathMap: make(map[string]string),
+		decrypted:     make(map[string][]byte),
+	}
+
+	// Grab the path to the working directory
+	path, ok := getExtra(config, params, secrets.Path)
+	if !ok {
+		return nil, errNoPath
+	}
+
+	manager.path = path
+
+	if err := manager.Setup(); err != nil {
+		return nil, err
+	}
+
+	passphrase, err := readPassphrase(config, params, !manager.hasEncryptedSecret())
+	if err != nil {
+		return nil, err
+	}
+
+	manager.passphrase = passphrase
+
+	return manager, nil
+}
+
+// Setup sets up the encrypted local SecretsManager
+func (e *EncryptedLocalSecretsManager) Setup() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	subDirectories := []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal}
+
+	// Set up the local directories
+	if err := common.SetupDataDir(e.path, subDirectories); err != nil {
+		return err
+	}
+
+	// The secrets are kept at the same paths as the local SecretsManager,
+	// so that plaintext secrets can be migrated in place
+	e.secretPathMap[secrets.ValidatorKey] = filepath.Join(
+		e.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorKeyLocal,
+	)
+
+	e.secretPathMap[secrets.ValidatorBLSKey] = filepath.Join(
+		e.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyLocal,
+	)
+
+	e.secretPathMap[secrets.NetworkKey] = filepath.Join(
+		e.path,
+		secrets.NetworkFolderLocal,
+		secrets.NetworkKeyLocal,
+	)
+
+	return nil
+}
+
+// GetSecret reads the secret from disk and decrypts it
+func (e *EncryptedLocalSecretsManager) GetSecret(name string) ([]byte, error) {
+	e.lock.RLock()
+	secretPath, ok := e.secretPathMap[name]
+	secret, decrypted := e.decrypted[name]
+	e.lock.RUnlock()
+
+	if !ok {
+		return nil, secrets.ErrSecretNotFound
+	}
+
+	if decrypted {
+		return secret, nil
+	}
+
+	encrypted, err := os.ReadFile(secretPath)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to read secret from disk (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	if !isEncrypted(encrypted) {
+		return nil, fmt.Errorf("%s: %w", secretPath, ErrSecretNotEncrypted)
+	}
+
+	secret, err = keystore.DecryptV3(encrypted, e.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to decrypt secret (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	e.lock.Lock()
+	e.decrypted[name] = secret
+	e.lock.Unlock()
+
+	return secret, nil
+}
+
+// SetSecret encrypts the secret and saves it to disk
+func (e *EncryptedLocalSecretsManager) SetSecret(name string, value []byte) error {
+	e.lock.RLock()
+	secretPath, ok := e.secretPathMap[name]
+	e.lock.RUnlock()
+
+	if !ok {
+		return secrets.ErrSecretNotFound
+	}
+
+	// Checks for existing secret
+	if _, err := os.Stat(secretPath); err == nil {
+		return fmt.Errorf(
+			"%s already initialized",
+			secretPath,
+		)
+	}
+
+	if err := e.writeSecret(secretPath, value); err != nil {
+		return err
+	}
+
+	e.lock.Lock()
+	e.decrypted[name] = value
+	e.lock.Unlock()
+
+	return nil
+}
+
+// HasSecret checks if the secret is present on disk,
+// without decrypting it
+func (e *EncryptedLocalSecretsManager) HasSecret(name string) bool {
+	e.lock.RLock()
+	secretPath, ok := e.secretPathMap[name]
+	e.lock.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	_, err := os.Stat(secretPath)
+
+	return err == nil
+}
+
+// RemoveSecret removes the secret from disk
+func (e *EncryptedLocalSecretsManager) RemoveSecret(name string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	secretPath, ok := e.secretPathMap[name]
+	if !ok {
+		return secrets.ErrSecretNotFound
+	}
+
+	delete(e.secretPathMap, name)
+	delete(e.decrypted, name)
+
+	if removeErr := os.Remove(secretPath); removeErr != nil {
+		return fmt.Errorf("unable to remove secret, %w", removeErr)
+	}
+
+	return nil
+}
+
+// Migrate encrypts in place the secrets stored as plaintext by the local SecretsManager,
+// and returns the names of the migrated secrets.
+// Nothing is migrated unless the passphrase decrypts the secrets encrypted already,
+// so that all the secrets are always encrypted with the same passphrase
+func (e *EncryptedLocalSecretsManager) Migrate() ([]string, error) {
+	type plaintextSecret struct {
+		name    string
+		path    string
+		content []byte
+	}
+
+	var (
+		plaintext = make([]plaintextSecret, 0)
+		verified  = false
+	)
+
+	for _, name := range []string{secrets.ValidatorKey, secrets.ValidatorBLSKey, secrets.NetworkKey} {
+		e.lock.RLock()
+		secretPath, ok := e.secretPathMap[name]
+		e.lock.RUnlock()
+
+		if !ok {
+			continue
+		}
+
+		content, err := os.ReadFile(secretPath)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to read secret from disk (%s), %w", secretPath, err)
+		}
+
+		if !isEncrypted(content) {
+			plaintext = append(plaintext, plaintextSecret{name: name, path: secretPath, content: content})
+
+			continue
+		}
+
+		if verified {
+			continue
+		}
+
+		if _, err := keystore.DecryptV3(content, e.passphrase); err != nil {
+			return nil, fmt.Errorf("%w (%s): %v", ErrWrongPassphrase, secretPath, err)
+		}
+
+		verified = true
+	}
+
+	migrated := make([]string, 0, len(plaintext))
+
+	for _, secret := range plaintext {
+		if err := e.writeSecret(secret.path, secret.content); err != nil {
+			return migrated, err
+		}
+
+		e.logger.Info("secret encrypted", "name", secret.name, "path", secret.path)
+
+		migrated = append(migrated, secret.name)
+	}
+
+	return migrated, nil
+}
+
+// writeSecret encrypts the secret and writes it to disk,
+// replacing the previous file if any
+func (e *EncryptedLocalSecretsManager) writeSecret(secretPath string, value []byte) error {
+	scryptParams := []int{}
+	if e.scryptN != 0 {
+		scryptParams = append(scryptParams, e.scryptN)
+	}
+
+	encrypted, err := keystore.EncryptV3(value, e.passphrase, scryptParams...)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt secret, %w", err)
+	}
+
+	// Write to a temporary file first, so that the secret is never lost halfway
+	tmpPath := secretPath + ".tmp"
+
+	if err := os.WriteFile(tmpPath, encrypted, secretFilePerm); err != nil {
+		return fmt.Errorf(
+			"unable to write secret to disk (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	if err := os.Rename(tmpPath, secretPath); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf(
+			"unable to write secret to disk (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// hasEncryptedSecret returns true if any of the secrets on disk is encrypted
+func (e *EncryptedLocalSecretsManager) hasEncryptedSecret() bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	for _, secretPath := range e.secretPathMap {
+		if content, err := os.ReadFile(secretPath); err == nil && isEncrypted(content) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEncrypted checks if the content is a keystore v3 file
+func isEncrypted(content []byte) bool {
+	var encoding struct {
+		Version int64           `json:"version"`
+		Crypto  json.RawMessage `json:"crypto"`
+	}
+
+	if err := json.Unmarshal(content, &encoding); err != nil {
+		return false
+	}
+
+	return encoding.Version == 3 && len(encoding.Crypto) != 0
+}
+
+// getExtra returns the string value of the key in the runtime parameters,
+// or in the configuration if not set at runtime
+func getExtra(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+	key string,
+) (string, bool) {
+	extras := []map[string]interface{}{params.Extra}
+	if config != nil {
+		extras = append(extras, config.Extra)
+	}
+
+	for _, extra := range extras {
+		if value, ok := extra[key].(string); ok && value != "" {
+			return value, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,260 @@
+package encryptedlocal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/secrets"
+	"github.com/LaChain/polygon-edge/secrets/local"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testPassphrase = "passphrase"
+
+	// testScryptN keeps the encryption fast in the tests
+	testScryptN = 1 << 4
+)
+
+// newTestManager returns an encrypted local secrets manager in the directory,
+// with the passphrase passed at runtime
+func newTestManager(t *testing.T, dir, passphrase string) *EncryptedLocalSecretsManager {
+	t.Helper()
+
+	manager, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path:       dir,
+			secrets.Passphrase: passphrase,
+		},
+	})
+	assert.NoError(t, err)
+
+	encrypted, ok := manager.(*EncryptedLocalSecretsManager)
+	assert.True(t, ok)
+
+	encrypted.scryptN = testScryptN
+
+	return encrypted
+}
+
+func TestEncryptedLocalSecretsManagerFactory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	passphraseFile := filepath.Join(dir, "passphrase")
+	assert.NoError(t, os.WriteFile(passphraseFile, []byte("from-file\n"), 0600))
+
+	testTable := []struct {
+		name       string
+		config     *secrets.SecretsManagerConfig
+		extra      map[string]interface{}
+		passphrase string
+		err        error
+	}{
+		{
+			name:       "Passphrase passed at runtime",
+			extra:      map[string]interface{}{secrets.Path: dir, secrets.Passphrase: testPassphrase},
+			passphrase: testPassphrase,
+		},
+		{
+			name:       "Passphrase read from the file",
+			extra:      map[string]interface{}{secrets.Path: dir, secrets.PassphraseFile: passphraseFile},
+			passphrase: "from-file",
+		},
+		{
+			name: "Passphrase file and path read from the configuration",
+			config: &secrets.SecretsManagerConfig{
+				Type:  secrets.EncryptedLocal,
+				Extra: map[string]interface{}{secrets.Path: dir, secrets.PassphraseFile: passphraseFile},
+			},
+			extra:      map[string]interface{}{},
+			passphrase: "from-file",
+		},
+		{
+			name: "Passphrase ignored in the configuration",
+			config: &secrets.SecretsManagerConfig{
+				Type:  secrets.EncryptedLocal,
+				Extra: map[string]interface{}{secrets.Passphrase: testPassphrase},
+			},
+			extra:      map[string]interface{}{secrets.Path: dir, secrets.PassphraseFile: passphraseFile},
+			passphrase: "from-file",
+		},
+		{
+			name:  "Empty passphrase",
+			extra: map[string]interface{}{secrets.Path: dir, secrets.PassphraseFile: os.DevNull},
+			err:   ErrEmptyPassphrase,
+		},
+		{
+			name: "No path",
+			extra: map[string]interface{}{
+				secrets.Passphrase: testPassphrase,
+			},
+			err: errNoPath,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			manager, err := SecretsManagerFactory(testCase.config, &secrets.SecretsManagerParams{
+				Logger: hclog.NewNullLogger(),
+				Extra:  testCase.extra,
+			})
+
+			if testCase.err != nil {
+				assert.Nil(t, manager)
+				assert.ErrorIs(t, err, testCase.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			encrypted, ok := manager.(*EncryptedLocalSecretsManager)
+			assert.True(t, ok)
+			assert.Equal(t, testCase.passphrase, encrypted.passphrase)
+		})
+	}
+}
+
+func TestEncryptedLocalSecretsManagerFactory_PassphraseEnv(t *testing.T) {
+	t.Setenv("TEST_SECRETS_PASSPHRASE", "from-env")
+
+	manager, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path:          t.TempDir(),
+			secrets.PassphraseEnv: "TEST_SECRETS_PASSPHRASE",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "from-env", manager.(*EncryptedLocalSecretsManager).passphrase) //nolint:forcetypeassert
+
+	_, err = SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path:          t.TempDir(),
+			secrets.PassphraseEnv: "TEST_SECRETS_PASSPHRASE_UNSET",
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestEncryptedLocalSecretsManager_GetSetSecret(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	secret := []byte("secret")
+
+	manager := newTestManager(t, dir, testPassphrase)
+
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+	assert.NoError(t, manager.SetSecret(secrets.ValidatorKey, secret))
+	assert.True(t, manager.HasSecret(secrets.ValidatorKey))
+
+	// the secret can't be overwritten
+	assert.Error(t, manager.SetSecret(secrets.ValidatorKey, secret))
+
+	// unknown secrets are not stored
+	assert.ErrorIs(t, manager.SetSecret("dummySecret", secret), secrets.ErrSecretNotFound)
+
+	// the secret is stored encrypted
+	content, err := os.ReadFile(filepath.Join(dir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	assert.NoError(t, err)
+	assert.True(t, isEncrypted(content))
+	assert.NotContains(t, string(content), string(secret))
+
+	// the secret is decrypted with the same passphrase only
+	value, err := newTestManager(t, dir, testPassphrase).GetSecret(secrets.ValidatorKey)
+	assert.NoError(t, err)
+	assert.Equal(t, secret, value)
+
+	_, err = newTestManager(t, dir, "wrong").GetSecret(secrets.ValidatorKey)
+	assert.Error(t, err)
+
+	assert.NoError(t, manager.RemoveSecret(secrets.ValidatorKey))
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+}
+
+func TestEncryptedLocalSecretsManager_Migrate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	plaintext, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path: dir,
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, plaintext.SetSecret(secrets.ValidatorKey, []byte("validator")))
+	assert.NoError(t, plaintext.SetSecret(secrets.NetworkKey, []byte("network")))
+
+	manager := newTestManager(t, dir, testPassphrase)
+
+	// plaintext secrets are not read
+	_, err = manager.GetSecret(secrets.ValidatorKey)
+	assert.ErrorIs(t, err, ErrSecretNotEncrypted)
+
+	migrated, err := manager.Migrate()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{secrets.ValidatorKey, secrets.NetworkKey}, migrated)
+
+	// migrated secrets are not migrated again
+	migrated, err = manager.Migrate()
+	assert.NoError(t, err)
+	assert.Empty(t, migrated)
+
+	reopened := newTestManager(t, dir, testPassphrase)
+
+	for name, value := range map[string]string{
+		secrets.ValidatorKey: "validator",
+		secrets.NetworkKey:   "network",
+	} {
+		secret, err := reopened.GetSecret(name)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(value), secret)
+	}
+
+	assert.False(t, reopened.HasSecret(secrets.ValidatorBLSKey))
+}
+
+func TestEncryptedLocalSecretsManager_MigrateWrongPassphrase(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// the validator key is encrypted already, the network key is still plaintext
+	assert.NoError(t, newTestManager(t, dir, testPassphrase).SetSecret(secrets.ValidatorKey, []byte("validator")))
+
+	plaintext, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path: dir,
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, plaintext.SetSecret(secrets.NetworkKey, []byte("network")))
+
+	migrated, err := newTestManager(t, dir, "wrong").Migrate()
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+	assert.Empty(t, migrated)
+
+	// nothing is written with the wrong passphrase
+	_, err = newTestManager(t, dir, testPassphrase).GetSecret(secrets.NetworkKey)
+	assert.ErrorIs(t, err, ErrSecretNotEncrypted)
+
+	migrated, err = newTestManager(t, dir, testPassphrase).Migrate()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{secrets.NetworkKey}, migrated)
+}
@@ -0,0 +1,111 @@
+package encryptedlocal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/LaChain/polygon-edge/secrets"
+	"golang.org/x/term"
+)
+
+// DefaultPassphraseEnv is the environment variable read for the passphrase
+// if neither a passphrase file nor another environment variable is configured
+const DefaultPassphraseEnv = "EDGE_SECRETS_PASSPHRASE"
+
+var (
+	ErrNoPassphrase         = errors.New("no passphrase provided and the standard input is not a terminal")
+	ErrEmptyPassphrase      = errors.New("the passphrase is empty")
+	ErrPassphraseMismatched = errors.New("the passphrases do not match")
+)
+
+// readPassphrase reads the passphrase of the secrets, in order, from the runtime parameters,
+// the passphrase file, the environment and the terminal. If the passphrase is prompted for
+// new secrets, it has to be confirmed. The passphrase itself is never read from the configuration,
+// so it isn't stored in plain text next to the secrets
+func readPassphrase(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+	confirm bool,
+) (string, error) {
+	var (
+		passphrase string
+		err        error
+	)
+
+	if value, ok := getExtra(nil, params, secrets.Passphrase); ok {
+		passphrase = value
+	} else if file, ok := getExtra(config, params, secrets.PassphraseFile); ok {
+		passphrase, err = readPassphraseFile(file)
+	} else if env, ok := getExtra(config, params, secrets.PassphraseEnv); ok {
+		passphrase, ok = os.LookupEnv(env)
+		if !ok {
+			err = fmt.Errorf("passphrase environment variable %s is not set", env)
+		}
+	} else if value, ok := os.LookupEnv(DefaultPassphraseEnv); ok {
+		passphrase = value
+	} else {
+		passphrase, err = promptPassphrase(confirm)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if passphrase == "" {
+		return "", ErrEmptyPassphrase
+	}
+
+	return passphrase, nil
+}
+
+// readPassphraseFile reads the passphrase from the first line of the file
+func readPassphraseFile(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("unable to read passphrase file (%s), %w", file, err)
+	}
+
+	passphrase, _, _ := strings.Cut(string(content), "\n")
+
+	return strings.TrimSuffix(passphrase, "\r"), nil
+}
+
+// promptPassphrase reads the passphrase from the terminal without echoing it
+func promptPassphrase(confirm bool) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", ErrNoPassphrase
+	}
+
+	passphrase, err := readTerminal(fd, "Secrets passphrase: ")
+	if err != nil {
+		return "", err
+	}
+
+	if confirm && passphrase != "" {
+		repeated, err := readTerminal(fd, "Repeat the secrets passphrase: ")
+		if err != nil {
+			return "", err
+		}
+
+		if repeated != passphrase {
+			return "", ErrPassphraseMismatched
+		}
+	}
+
+	return passphrase, nil
+}
+
+func readTerminal(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	defer fmt.Fprintln(os.Stderr)
+
+	passphrase, err := term.ReadPassword(fd)
+	if err != nil {
+		return "", fmt.Errorf("unable to read passphrase, %w", err)
+	}
+
+	return string(passphrase), nil
+}
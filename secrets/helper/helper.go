@@ -9,6 +9,7 @@ import (
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/secrets/awsssm"
+	"github.com/LaChain/polygon-edge/secrets/encryptedlocal"
 	"github.com/LaChain/polygon-edge/secrets/gcpssm"
 	"github.com/LaChain/polygon-edge/secrets/hashicorpvault"
 	"github.com/LaChain/polygon-edge/secrets/local"
@@ -31,6 +32,39 @@ func SetupLocalSecretsManager(dataDir string) (secrets.SecretsManager, error) {
 	)
 }
 
+// SetupEncryptedLocalSecretsManager is a helper method for boilerplate encrypted local secrets manager setup.
+// The passphrase is read from the file if given, from the environment or from the terminal otherwise
+func SetupEncryptedLocalSecretsManager(dataDir, passphraseFile string) (secrets.SecretsManager, error) {
+	extra := map[string]interface{}{
+		secrets.Path: dataDir,
+	}
+
+	if passphraseFile != "" {
+		extra[secrets.PassphraseFile] = passphraseFile
+	}
+
+	return encryptedlocal.SecretsManagerFactory(
+		nil,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra:  extra,
+		},
+	)
+}
+
+// setupEncryptedLocal is a helper method for boilerplate encrypted local secrets manager setup
+// from a config holding the data directory path
+func setupEncryptedLocal(
+	secretsConfig *secrets.SecretsManagerConfig,
+) (secrets.SecretsManager, error) {
+	return encryptedlocal.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+		},
+	)
+}
+
 // setupHashicorpVault is a helper method for boilerplate hashicorp vault secrets manager setup
 func setupHashicorpVault(
 	secretsConfig *secrets.SecretsManagerConfig,
@@ -232,6 +266,13 @@ func InitCloudSecretsManager(secretsConfig *secrets.SecretsManagerConfig) (secre
 		}
 
 		secretsManager = GCPSSM
+	case secrets.EncryptedLocal:
+		encryptedLocal, err := setupEncryptedLocal(secretsConfig)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = encryptedLocal
 	default:
 		return secretsManager, errors.New("unsupported secrets manager")
 	}
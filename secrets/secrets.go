@@ -19,6 +19,32 @@ const (
 
 	// Name is the name of the current node
 	Name = "name"
+
+	// Passphrase is the passphrase of the encrypted local secrets
+	Passphrase = "passphrase"
+
+	// PassphraseFile is the path to the file holding the passphrase of the encrypted local secrets
+	PassphraseFile = "passphrase-file"
+
+	// PassphraseEnv is the name of the environment variable holding
+	// the passphrase of the encrypted local secrets
+	PassphraseEnv = "passphrase-env"
+)
+
+// Define constant key names for SecretsManagerConfig.Extra
+// configuring the remote signer of the validator keys
+const (
+	// RemoteSignerURL is the base URL of the remote signing service
+	RemoteSignerURL = "remote-signer-url"
+
+	// RemoteSignerAddress is the address of the validator key in the remote signer
+	RemoteSignerAddress = "remote-signer-address"
+
+	// RemoteSignerBLSPublicKey is the public key of the validator BLS key in the remote signer
+	RemoteSignerBLSPublicKey = "remote-signer-bls-public-key"
+
+	// RemoteSignerTimeout is the timeout of the requests to the remote signer, e.g. 5s
+	RemoteSignerTimeout = "remote-signer-timeout"
 )
 
 // Define constant names for available secrets
@@ -65,6 +91,10 @@ const (
 
 	// GCPSSM pertains to the Google Cloud Computing secret store manager
 	GCPSSM SecretsManagerType = "gcp-ssm"
+
+	// EncryptedLocal pertains to the local FS, with the secrets
+	// encrypted in the Web3 Secret Storage (keystore v3) format
+	EncryptedLocal SecretsManagerType = "encrypted-local"
 )
 
 // SecretsManager defines the base public interface that all
@@ -110,5 +140,5 @@ type SecretsManagerFactory func(
 // SupportedServiceManager checks if the passed in service manager type is supported
 func SupportedServiceManager(service SecretsManagerType) bool {
 	return service == HashicorpVault || service == AWSSSM ||
-		service == Local || service == GCPSSM
+		service == Local || service == GCPSSM || service == EncryptedLocal
 }
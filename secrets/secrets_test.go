@@ -32,6 +32,11 @@ func TestSupportedServiceManager(t *testing.T) {
 			GCPSSM,
 			true,
 		},
+		{
+			"Valid encrypted local secrets manager",
+			EncryptedLocal,
+			true,
+		},
 		{
 			"Invalid secrets manager",
 			"MarsSecretsManager",
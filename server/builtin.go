@@ -7,6 +7,7 @@ import (
 	consensusIBFT "github.com/LaChain/polygon-edge/consensus/ibft"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/secrets/awsssm"
+	"github.com/LaChain/polygon-edge/secrets/encryptedlocal"
 	"github.com/LaChain/polygon-edge/secrets/gcpssm"
 	"github.com/LaChain/polygon-edge/secrets/hashicorpvault"
 	"github.com/LaChain/polygon-edge/secrets/local"
@@ -33,6 +34,7 @@ var secretsManagerBackends = map[secrets.SecretsManagerType]secrets.SecretsManag
 	secrets.HashicorpVault: hashicorpvault.SecretsManagerFactory,
 	secrets.AWSSSM:         awsssm.SecretsManagerFactory,
 	secrets.GCPSSM:         gcpssm.SecretsManagerFactory,
+	secrets.EncryptedLocal: encryptedlocal.SecretsManagerFactory,
 }
 
 func ConsensusSupported(value string) bool {
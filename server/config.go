@@ -6,8 +6,11 @@ import (
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
+	"github.com/LaChain/polygon-edge/syncer"
+	"github.com/LaChain/polygon-edge/types"
 )
 
 const DefaultGRPCPort int = 9632
@@ -24,13 +27,18 @@ type Config struct {
 	PriceLimit         uint64
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
+	PriceBump          uint64
 	BlockTime          uint64
 
 	Telemetry *Telemetry
 	Network   *network.Config
 
-	DataDir     string
-	RestoreFile *string
+	DataDir           string
+	DBEngine          dbengine.Engine
+	RestoreFile       *string
+	StateSnapshotFile *string
+	// StateSnapshotHash is the trusted hash of the anchor block of the state snapshot
+	StateSnapshotHash types.Hash
 
 	Seal bool
 
@@ -41,6 +49,33 @@ type Config struct {
 	JSONLogFormat bool
 
 	LogFilePath string
+
+	// StateRetention is the number of recent blocks whose state is kept.
+	// If it is 0, the state is never pruned
+	StateRetention uint64
+
+	// SyncMode is the way the node catches up with the chain
+	SyncMode syncer.SyncMode
+}
+
+// NodeMode defines which historical state is kept by the node
+type NodeMode string
+
+const (
+	// ArchiveMode keeps the state of all the blocks
+	ArchiveMode NodeMode = "archive"
+
+	// FullMode keeps the state of the last StateRetention blocks
+	FullMode NodeMode = "full"
+)
+
+// NodeMode returns the mode of the node, based on the state retention
+func (c *Config) NodeMode() NodeMode {
+	if c.StateRetention > 0 {
+		return FullMode
+	}
+
+	return ArchiveMode
 }
 
 // Telemetry holds the config details for metric services
@@ -54,4 +89,6 @@ type JSONRPC struct {
 	AccessControlAllowOrigin []string
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	GasPriceBlocks           uint64
+	GasPricePercentile       uint64
 }
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/LaChain/polygon-edge/jsonrpc"
+	"github.com/LaChain/polygon-edge/light"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// lightJSONRPCHub serves the JSON-RPC endpoints of a light node, which only stores the verified headers.
+// The bodies, receipts and state are fetched from the peers and verified against the headers
+type lightJSONRPCHub struct {
+	*jsonRPCHub
+
+	logger hclog.Logger
+	client *light.Client
+}
+
+// GetBlockByHash returns the block with the given hash, fetching its body if needed
+func (j *lightJSONRPCHub) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	header, ok := j.GetHeaderByHash(hash)
+	if !ok {
+		return nil, false
+	}
+
+	return j.getBlock(header, full)
+}
+
+// GetBlockByNumber returns the canonical block at the given height, fetching its body if needed
+func (j *lightJSONRPCHub) GetBlockByNumber(number uint64, full bool) (*types.Block, bool) {
+	header, ok := j.GetHeaderByNumber(number)
+	if !ok {
+		return nil, false
+	}
+
+	return j.getBlock(header, full)
+}
+
+func (j *lightJSONRPCHub) getBlock(header *types.Header, full bool) (*types.Block, bool) {
+	block := &types.Block{
+		Header: header,
+	}
+
+	if !full || header.Number == 0 {
+		return block, true
+	}
+
+	body, err := j.client.GetBlockBody(header)
+	if err != nil {
+		j.logger.Warn("unable to fetch the block body", "hash", header.Hash, "err", err)
+
+		return block, false
+	}
+
+	block.Transactions = body.Transactions
+	block.Uncles = body.Uncles
+
+	return block, true
+}
+
+// GetReceiptsByHash returns the receipts of the block with the given hash
+func (j *lightJSONRPCHub) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	header, ok := j.GetHeaderByHash(hash)
+	if !ok {
+		return nil, light.ErrBlockNotFound
+	}
+
+	return j.client.GetReceipts(header)
+}
+
+// ReadTxLookup returns the hash of the canonical block including the transaction
+func (j *lightJSONRPCHub) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
+	blockHash, err := j.client.GetTxLookup(hash)
+	if err != nil {
+		return types.ZeroHash, false
+	}
+
+	return blockHash, true
+}
+
+// GetNonce returns the nonce of the account at the head of the chain, as the pool of a light node is empty
+func (j *lightJSONRPCHub) GetNonce(addr types.Address) uint64 {
+	account, err := j.GetAccount(j.Header().StateRoot, addr)
+	if err != nil {
+		if !errors.Is(err, jsonrpc.ErrStateNotFound) {
+			j.logger.Warn("unable to fetch the account", "addr", addr, "err", err)
+		}
+
+		return 0
+	}
+
+	return account.Nonce
+}
+
+func (j *lightJSONRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
+	acct, _, err := j.client.GetAccountProof(root, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if acct == nil {
+		return nil, jsonrpc.ErrStateNotFound
+	}
+
+	return &jsonrpc.Account{
+		Nonce:    acct.Nonce,
+		Balance:  acct.Balance,
+		Root:     acct.Root,
+		CodeHash: types.BytesToHash(acct.CodeHash),
+	}, nil
+}
+
+// GetAccountProof returns the verified merkle proof of the account in the state with the given root
+func (j *lightJSONRPCHub) GetAccountProof(root types.Hash, addr types.Address) ([][]byte, error) {
+	_, proof, err := j.client.GetAccountProof(root, addr)
+
+	return proof, err
+}
+
+// GetStorageProof returns the verified merkle proof of the slot in the storage trie with the given root
+func (j *lightJSONRPCHub) GetStorageProof(storageRoot types.Hash, slot types.Hash) ([][]byte, error) {
+	_, proof, err := j.client.GetStorageProof(storageRoot, slot)
+
+	return proof, err
+}
+
+func (j *lightJSONRPCHub) GetStorage(stateRoot types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	account, err := j.GetAccount(stateRoot, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := j.client.GetStorageProof(account.Root, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.Bytes(), nil
+}
+
+func (j *lightJSONRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error) {
+	account, err := j.GetAccount(root, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.client.GetCode(account.CodeHash)
+}
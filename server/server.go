@@ -9,17 +9,22 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/LaChain/polygon-edge/archive"
 	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/blockchain/storage"
+	"github.com/LaChain/polygon-edge/blockchain/storage/memory"
 	"github.com/LaChain/polygon-edge/chain"
 	"github.com/LaChain/polygon-edge/consensus"
 	"github.com/LaChain/polygon-edge/crypto"
 	"github.com/LaChain/polygon-edge/helper/common"
 	configHelper "github.com/LaChain/polygon-edge/helper/config"
+	"github.com/LaChain/polygon-edge/helper/dbengine"
 	"github.com/LaChain/polygon-edge/helper/progress"
 	"github.com/LaChain/polygon-edge/jsonrpc"
+	"github.com/LaChain/polygon-edge/light"
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/secrets"
 	"github.com/LaChain/polygon-edge/server/proto"
@@ -27,6 +32,7 @@ import (
 	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
 	"github.com/LaChain/polygon-edge/state/runtime"
 	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/syncer"
 	"github.com/LaChain/polygon-edge/txpool"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -70,6 +76,14 @@ type Server struct {
 
 	// restore
 	restoreProgression *progress.ProgressionWrapper
+
+	// state pruner, nil if all the state is kept
+	statePruner *statePruner
+
+	// light protocol service, serving the data requested by the light nodes
+	lightService *light.Service
+	// light protocol client, nil unless the node is a light node
+	lightClient *light.Client
 }
 
 var dirPaths = []string{
@@ -174,7 +188,7 @@ func NewServer(config *Config) (*Server, error) {
 	}
 
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	stateStorage, err := dbengine.OpenStateStorage(m.config.DBEngine, filepath.Join(m.config.DataDir, "trie"), logger)
 	if err != nil {
 		return nil, err
 	}
@@ -190,11 +204,17 @@ func NewServer(config *Config) (*Server, error) {
 	genesisRoot := m.executor.WriteGenesis(config.Chain.Genesis.Alloc)
 	config.Chain.Genesis.StateRoot = genesisRoot
 
-	// use the eip155 signer
-	signer := crypto.NewEIP155Signer(uint64(m.config.Chain.Params.ChainID))
+	// use the london signer, it handles eip155 transactions as well.
+	// Whether typed transactions are accepted depends on the Berlin and London forks
+	signer := crypto.NewLondonSigner(uint64(m.config.Chain.Params.ChainID))
 
 	// blockchain object
-	m.blockchain, err = blockchain.NewBlockchain(logger, m.config.DataDir, config.Chain, nil, m.executor, signer)
+	blockchainStorage, err := m.openBlockchainStorage(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	m.blockchain, err = blockchain.NewBlockchain(logger, blockchainStorage, config.Chain, nil, m.executor, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -207,23 +227,21 @@ func NewServer(config *Config) (*Server, error) {
 			Blockchain: m.blockchain,
 		}
 
-		deploymentWhitelist, err := configHelper.GetDeploymentWhitelist(config.Chain)
-		if err != nil {
-			return nil, err
-		}
-
 		// start transaction pool
 		m.txpool, err = txpool.NewTxPool(
 			logger,
-			m.chain.Params.Forks.At(0),
+			m.chain.Params.Forks,
 			hub,
 			m.grpcServer,
 			m.network,
 			&txpool.Config{
-				MaxSlots:            m.config.MaxSlots,
-				PriceLimit:          m.config.PriceLimit,
-				MaxAccountEnqueued:  m.config.MaxAccountEnqueued,
-				DeploymentWhitelist: deploymentWhitelist,
+				MaxSlots:           m.config.MaxSlots,
+				PriceLimit:         m.config.PriceLimit,
+				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
+				PriceBump:          m.config.PriceBump,
+				Whitelists:         configHelper.GetWhitelist(config.Chain),
+
+				AccessControlContract: m.chain.Params.AccessControlContract,
 			},
 		)
 		if err != nil {
@@ -248,6 +266,11 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// import the state snapshot before the consensus reads the validators
+	if err := m.importStateSnapshot(); err != nil {
+		return nil, err
+	}
+
 	// initialize data in consensus layer
 	if err := m.consensus.Initialize(); err != nil {
 		return nil, err
@@ -262,11 +285,30 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// serve the data requested by the light nodes
+	m.lightService = light.NewService(m.network, m.blockchain, stateStorage)
+	m.lightService.Start()
+
+	if config.SyncMode == syncer.LightSync {
+		m.lightClient = light.NewClient(logger, m.network, m.blockchain, signer)
+	}
+
 	// setup and start jsonrpc server
 	if err := m.setupJSONRPC(); err != nil {
 		return nil, err
 	}
 
+	// prune the state of the old blocks
+	if m.config.StateRetention > 0 {
+		epochSize := uint64(0)
+		if provider, ok := m.consensus.(epochSizeProvider); ok {
+			epochSize = provider.GetEpochSize()
+		}
+
+		m.statePruner = newStatePruner(logger, m.blockchain, st, m.config.StateRetention, epochSize)
+		m.statePruner.start()
+	}
+
 	// restore archive data before starting
 	if err := m.restoreChain(); err != nil {
 		return nil, err
@@ -287,13 +329,51 @@ func (s *Server) restoreChain() error {
 		return nil
 	}
 
-	if err := archive.RestoreChain(s.blockchain, *s.config.RestoreFile, s.restoreProgression); err != nil {
+	// several archives can be restored in order, separated by commas
+	filePaths := strings.Split(*s.config.RestoreFile, ",")
+
+	if err := archive.RestoreChain(s.blockchain, filePaths, s.restoreProgression); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// importStateSnapshot writes the state and the anchor block of the snapshot into the empty chain,
+// so that the syncer continues from the anchor
+func (s *Server) importStateSnapshot() error {
+	if s.config.StateSnapshotFile == nil {
+		return nil
+	}
+
+	metadata, err := archive.ImportStateSnapshot(
+		s.blockchain,
+		s.stateStorage,
+		s.logger,
+		*s.config.StateSnapshotFile,
+		s.config.StateSnapshotHash,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import the state snapshot: %w", err)
+	}
+
+	if metadata != nil {
+		s.logger.Info("imported the state snapshot", "number", metadata.Number, "hash", metadata.Hash)
+	}
+
+	return nil
+}
+
+// openBlockchainStorage opens the blockchain storage in the data directory with the configured engine,
+// or in memory if there's no data directory
+func (s *Server) openBlockchainStorage(logger hclog.Logger) (storage.Storage, error) {
+	if s.config.DataDir == "" {
+		return memory.NewMemoryStorage(nil)
+	}
+
+	return dbengine.OpenBlockchainStorage(s.config.DBEngine, filepath.Join(s.config.DataDir, "blockchain"), logger)
+}
+
 type txpoolHub struct {
 	state state.State
 	*blockchain.Blockchain
@@ -328,6 +408,20 @@ func (t *txpoolHub) GetNonce(root types.Hash, addr types.Address) uint64 {
 	return account.Nonce
 }
 
+func (t *txpoolHub) GetStorage(root types.Hash, addr types.Address, key types.Hash) types.Hash {
+	account, err := getAccountImpl(t.state, root, addr)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	return snap.GetStorage(addr, account.Root, key)
+}
+
 func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, error) {
 	account, err := getAccountImpl(t.state, root, addr)
 
@@ -357,9 +451,9 @@ func (s *Server) setupSecretsManager() error {
 		Logger: s.logger,
 	}
 
-	if secretsManagerType == secrets.Local {
-		// Only the base directory is required for
-		// the local secrets manager
+	if secretsManagerType == secrets.Local || secretsManagerType == secrets.EncryptedLocal {
+		// The base directory is required for the local secrets managers,
+		// the encrypted one reads the passphrase source from the config
 		secretsManagerParams.Extra = map[string]interface{}{
 			secrets.Path: s.config.DataDir,
 		}
@@ -417,7 +511,10 @@ func (s *Server) setupConsensus() error {
 			Grpc:           s.grpcServer,
 			Logger:         s.logger,
 			SecretsManager: s.secretsManager,
+			SecretsConfig:  s.config.SecretsManager,
 			BlockTime:      s.config.BlockTime,
+			SyncMode:       s.config.SyncMode,
+			StateStorage:   s.stateStorage,
 		},
 	)
 
@@ -441,6 +538,13 @@ type jsonRPCHub struct {
 	consensus.Consensus
 }
 
+// GetIstanbulBackend returns the consensus if it's IBFT
+func (j *jsonRPCHub) GetIstanbulBackend() (jsonrpc.IstanbulBackend, bool) {
+	backend, ok := j.Consensus.(jsonrpc.IstanbulBackend)
+
+	return backend, ok
+}
+
 func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
@@ -452,13 +556,25 @@ func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.A
 	}
 
 	account := &jsonrpc.Account{
-		Nonce:   acct.Nonce,
-		Balance: new(big.Int).Set(acct.Balance),
+		Nonce:    acct.Nonce,
+		Balance:  new(big.Int).Set(acct.Balance),
+		Root:     acct.Root,
+		CodeHash: types.BytesToHash(acct.CodeHash),
 	}
 
 	return account, nil
 }
 
+// GetAccountProof returns the merkle proof of the account in the state with the given root
+func (j *jsonRPCHub) GetAccountProof(root types.Hash, addr types.Address) ([][]byte, error) {
+	return j.state.GetProof(root, crypto.Keccak256(addr.Bytes()))
+}
+
+// GetStorageProof returns the merkle proof of the slot in the storage trie with the given root
+func (j *jsonRPCHub) GetStorageProof(storageRoot types.Hash, slot types.Hash) ([][]byte, error) {
+	return j.state.GetProof(storageRoot, crypto.Keccak256(slot.Bytes()))
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return j.Executor.GetForksInTime(blockNumber)
@@ -508,6 +624,9 @@ func (j *jsonRPCHub) ApplyTxn(
 		return
 	}
 
+	// calls without a gas price should not be rejected for not paying the base fee
+	transition.SetNoBaseFee(true)
+
 	result, err = transition.Apply(txn)
 
 	return
@@ -625,6 +744,7 @@ func (j *jsonRPCHub) TraceCall(
 	}
 
 	transition.SetTracer(tracer)
+	transition.SetNoBaseFee(true)
 
 	if _, err := transition.Apply(tx); err != nil {
 		return nil, err
@@ -661,8 +781,18 @@ func (s *Server) setupJSONRPC() error {
 		Server:             s.network,
 	}
 
+	var store jsonrpc.JSONRPCStore = hub
+
+	if s.lightClient != nil {
+		store = &lightJSONRPCHub{
+			jsonRPCHub: hub,
+			logger:     s.logger.Named("light-jsonrpc"),
+			client:     s.lightClient,
+		}
+	}
+
 	conf := &jsonrpc.Config{
-		Store:                    hub,
+		Store:                    store,
 		Addr:                     s.config.JSONRPC.JSONRPCAddr,
 		ChainID:                  uint64(s.config.Chain.Params.ChainID),
 		ChainName:                s.chain.Name,
@@ -670,6 +800,9 @@ func (s *Server) setupJSONRPC() error {
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		GasPriceOracleBlocks:     s.config.JSONRPC.GasPriceBlocks,
+		GasPriceOraclePercentile: s.config.JSONRPC.GasPricePercentile,
+		LightMode:                s.lightClient != nil,
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -714,6 +847,11 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 
 // Close closes the Minimal server (blockchain, networking, consensus)
 func (s *Server) Close() {
+	// Stop pruning before the storages are closed
+	if s.statePruner != nil {
+		s.statePruner.close()
+	}
+
 	// Close the blockchain layer
 	if err := s.blockchain.Close(); err != nil {
 		s.logger.Error("failed to close blockchain", "err", err.Error())
@@ -729,6 +867,15 @@ func (s *Server) Close() {
 		s.logger.Error("failed to close consensus", "err", err.Error())
 	}
 
+	// Close the light protocol
+	if err := s.lightService.Close(); err != nil {
+		s.logger.Error("failed to close light service", "err", err.Error())
+	}
+
+	if s.lightClient != nil {
+		s.lightClient.Close()
+	}
+
 	// Close the state storage
 	if err := s.stateStorage.Close(); err != nil {
 		s.logger.Error("failed to close storage for trie", "err", err.Error())
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// epochSizeProvider is implemented by the consensus whose validators change at the epoch boundaries
+type epochSizeProvider interface {
+	GetEpochSize() uint64
+}
+
+// statePruner removes the state of the old blocks as the chain advances.
+// The state of the last retention blocks is always kept, the pruning runs every
+// retention blocks so at most the state of 2*retention blocks is stored.
+// The state at the end of the previous two epochs is kept too, as PoS reads the validators from it
+type statePruner struct {
+	logger     hclog.Logger
+	blockchain *blockchain.Blockchain
+	state      *itrie.State
+	retention  uint64
+	epochSize  uint64
+
+	// oldest is the number of the oldest block whose state is available
+	oldest uint64
+
+	subscription blockchain.Subscription
+	doneCh       chan struct{}
+}
+
+func newStatePruner(
+	logger hclog.Logger,
+	blockchain *blockchain.Blockchain,
+	state *itrie.State,
+	retention uint64,
+	epochSize uint64,
+) *statePruner {
+	return &statePruner{
+		logger:     logger.Named("state_pruner"),
+		blockchain: blockchain,
+		state:      state,
+		retention:  retention,
+		epochSize:  epochSize,
+		doneCh:     make(chan struct{}),
+	}
+}
+
+// start starts listening for new blocks
+func (p *statePruner) start() {
+	if head := p.blockchain.Header(); head != nil {
+		atomic.StoreUint64(&p.oldest, p.findOldestState(head.Number))
+	}
+
+	p.subscription = p.blockchain.SubscribeEvents()
+
+	go p.run()
+}
+
+func (p *statePruner) run() {
+	defer close(p.doneCh)
+
+	lastPruned := uint64(0)
+	if head := p.blockchain.Header(); head != nil {
+		lastPruned = head.Number
+	}
+
+	for {
+		event := p.subscription.GetEvent()
+		if event == nil {
+			// subscription closed
+			return
+		}
+
+		if event.Type == blockchain.EventFork || len(event.NewChain) == 0 {
+			continue
+		}
+
+		head := event.Header().Number
+		if head < lastPruned+p.retention {
+			continue
+		}
+
+		p.prune()
+
+		lastPruned = head
+	}
+}
+
+func (p *statePruner) prune() {
+	roots := p.blockchain.RecentStateRoots(p.retention)
+
+	deleted, err := p.state.Prune(append(p.epochStateRoots(), roots...))
+	if err != nil {
+		p.logger.Error("failed to prune the state", "err", err)
+
+		return
+	}
+
+	// the head might have advanced since the roots were read,
+	// in which case the reported oldest state is a few blocks newer
+	head := p.blockchain.Header().Number
+	if oldest := head + 1 - uint64(len(roots)); oldest > p.oldestState() {
+		atomic.StoreUint64(&p.oldest, oldest)
+	}
+
+	p.logger.Debug("pruned the state", "nodes", deleted, "retained blocks", len(roots))
+}
+
+// epochStateRoots returns the state roots at the end of the epochs before the current one and the previous one,
+// which the validators of these epochs are read from
+func (p *statePruner) epochStateRoots() []types.Hash {
+	head := p.blockchain.Header()
+	if head == nil || p.epochSize == 0 {
+		return nil
+	}
+
+	var (
+		roots      = make([]types.Hash, 0, 2)
+		epochBegin = (head.Number / p.epochSize) * p.epochSize
+	)
+
+	for i := 0; i < 2 && epochBegin > 0; i++ {
+		if header, ok := p.blockchain.GetHeaderByNumber(epochBegin - 1); ok {
+			roots = append(roots, header.StateRoot)
+		}
+
+		epochBegin -= p.epochSize
+	}
+
+	return roots
+}
+
+// oldestState returns the number of the oldest block whose state is available
+func (p *statePruner) oldestState() uint64 {
+	return atomic.LoadUint64(&p.oldest)
+}
+
+// findOldestState searches the oldest block whose state is available,
+// as the state is pruned from the oldest blocks onwards.
+// The state kept at the end of the epochs is skipped, since the state of the next block is pruned
+func (p *statePruner) findOldestState(head uint64) uint64 {
+	return uint64(sort.Search(int(head), func(i int) bool {
+		return p.hasState(uint64(i)) && p.hasState(uint64(i)+1)
+	}))
+}
+
+// hasState returns whether the state of the block is available
+func (p *statePruner) hasState(number uint64) bool {
+	header, ok := p.blockchain.GetHeaderByNumber(number)
+	if !ok {
+		return false
+	}
+
+	_, err := p.state.NewSnapshotAt(header.StateRoot)
+
+	return err == nil
+}
+
+// close stops the pruner, waiting for the running prune to finish
+func (p *statePruner) close() {
+	p.subscription.Close()
+	<-p.doneCh
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/staking"
+	stakingHelper "github.com/LaChain/polygon-edge/helper/staking"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/LaChain/polygon-edge/validators"
+	"github.com/LaChain/polygon-edge/validators/store/contract"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatePruner_KeepsEpochValidators(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize = 10
+		retention = 3
+		length    = 30
+	)
+
+	newValidators := func(addrs ...string) validators.Validators {
+		set := validators.NewECDSAValidatorSet()
+		for _, addr := range addrs {
+			assert.NoError(t, set.Add(validators.NewECDSAValidator(types.StringToAddress(addr))))
+		}
+
+		return set
+	}
+
+	stakingAccount := func(vals validators.Validators) *chain.GenesisAccount {
+		account, err := stakingHelper.PredeployStakingSC(vals, stakingHelper.PredeployParams{MaxValidatorCount: 10})
+		assert.NoError(t, err)
+
+		return account
+	}
+
+	// the validators change in the middle of each epoch
+	changes := map[uint64]validators.Validators{
+		5:  newValidators("100", "101"),
+		15: newValidators("100", "102"),
+		22: newValidators("103"),
+	}
+
+	st := itrie.NewState(itrie.NewMemoryStorage())
+
+	executor := state.NewExecutor(&chain.Params{Forks: chain.AllForksEnabled}, st, hclog.NewNullLogger())
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return types.ZeroHash
+		}
+	}
+
+	root := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		staking.AddrStakingContract: stakingAccount(newValidators("100")),
+	})
+
+	headers := blockchain.NewTestHeadersWithSeed(nil, length, 10000000)
+	headers[0].StateRoot = root
+	headers[0].ComputeHash()
+
+	for i := 1; i < length; i++ {
+		header := headers[i]
+
+		transition, err := executor.BeginTxn(root, header, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		transition.Txn().AddBalance(types.StringToAddress("200"), big.NewInt(1))
+
+		if vals, ok := changes[header.Number]; ok {
+			for key, value := range stakingAccount(vals).Storage {
+				transition.Txn().SetState(staking.AddrStakingContract, key, value)
+			}
+		}
+
+		_, root = transition.Commit()
+
+		header.StateRoot = root
+		header.ParentHash = headers[i-1].Hash
+		header.ComputeHash()
+	}
+
+	b := blockchain.NewTestBlockchain(t, headers)
+
+	pruner := newStatePruner(hclog.NewNullLogger(), b, st, retention, epochSize)
+
+	// the roots committed before the first prune are kept by it
+	pruner.prune()
+	pruner.prune()
+
+	// the state of the older blocks is pruned
+	_, err := st.NewSnapshotAt(headers[25].StateRoot)
+	assert.Error(t, err)
+	assert.Equal(t, uint64(length-retention), pruner.findOldestState(length-1))
+
+	// a restarted node resolves the validators of the current and the previous epoch
+	store, err := contract.NewContractValidatorStore(
+		hclog.NewNullLogger(),
+		b,
+		executor,
+		contract.DefaultValidatorSetCacheSize,
+	)
+	assert.NoError(t, err)
+
+	for height, expected := range map[uint64]validators.Validators{
+		9:  changes[5],
+		19: changes[15],
+	} {
+		vals, err := store.GetValidatorsByHeight(validators.ECDSAValidatorType, height)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, vals)
+	}
+}
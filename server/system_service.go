@@ -27,16 +27,27 @@ type systemService struct {
 // Current: { Number: <blockNumber>; Hash: <headerHash> }
 //
 // P2PAddr: <libp2pAddress>
+//
+// Mode: <archive|full>
+//
+// OldestState: <blockNumber>
 func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto.ServerStatus, error) {
 	header := s.server.blockchain.Header()
 
+	oldestState := uint64(0)
+	if s.server.statePruner != nil {
+		oldestState = s.server.statePruner.oldestState()
+	}
+
 	status := &proto.ServerStatus{
 		Network: int64(s.server.chain.Params.ChainID),
 		Current: &proto.ServerStatus_Block{
 			Number: int64(header.Number),
 			Hash:   header.Hash.String(),
 		},
-		P2PAddr: common.AddrInfoToString(s.server.network.AddrInfo()),
+		P2PAddr:     common.AddrInfoToString(s.server.network.AddrInfo()),
+		Mode:        string(s.server.config.NodeMode()),
+		OldestState: int64(oldestState),
 	}
 
 	return status, nil
@@ -182,8 +193,8 @@ func (s *systemService) Export(req *proto.ExportRequest, stream proto.System_Exp
 	}
 
 	if req.To != 0 {
-		if from >= req.To {
-			return errors.New("to must be greater than from")
+		if from > req.To {
+			return errors.New("to must be greater than or equal to from")
 		}
 
 		to = &req.To
@@ -7,6 +7,7 @@ import (
 	"math/big"
 
 	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
 	"github.com/LaChain/polygon-edge/crypto"
 	"github.com/LaChain/polygon-edge/state/runtime"
 	"github.com/LaChain/polygon-edge/state/runtime/evm"
@@ -19,8 +20,10 @@ import (
 const (
 	spuriousDragonMaxCodeSize = 24576
 
-	TxGas                 uint64 = 21000 // Per transaction not creating a contract
-	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
+	TxGas                     uint64 = 21000 // Per transaction not creating a contract
+	TxGasContractCreation     uint64 = 53000 // Per transaction that creates a contract
+	TxAccessListAddressGas    uint64 = 2400  // Per address specified in the access list
+	TxAccessListStorageKeyGas uint64 = 1900  // Per storage key specified in the access list
 )
 
 var emptyCodeHashTwo = types.BytesToHash(crypto.Keccak256(nil))
@@ -147,6 +150,7 @@ func (e *Executor) BeginTxn(
 		Difficulty: types.BytesToHash(new(big.Int).SetUint64(header.Difficulty).Bytes()),
 		GasLimit:   int64(header.GasLimit),
 		ChainID:    int64(e.config.ChainID),
+		BaseFee:    types.BytesToHash(new(big.Int).SetUint64(header.BaseFee).Bytes()),
 	}
 
 	txn := &Transition{
@@ -159,6 +163,12 @@ func (e *Executor) BeginTxn(
 		config:   forkConfig,
 		gasPool:  uint64(txCtx.GasLimit),
 
+		burnContract: e.config.BurnContract,
+		whitelists:   e.config.Whitelists.At(header.Number),
+
+		accessControlContract:        e.config.AccessControlContract,
+		accessControlInternalCreates: e.config.AccessControlInternalCreates,
+
 		receipts: []*types.Receipt{},
 		totalGas: 0,
 
@@ -183,9 +193,27 @@ type Transition struct {
 	ctx     runtime.TxContext
 	gasPool uint64
 
+	// burnContract receives the base fee, if nil the base fee is burned
+	burnContract *types.Address
+
+	// whitelists restrict the transactions written and the contracts created, if nil anything is allowed
+	whitelists *chain.Whitelists
+
+	// accessControlContract grants the roles to send transactions and deploy contracts, if set
+	accessControlContract *types.Address
+
+	// accessControlInternalCreates applies the deployer role to the contracts created by contracts
+	accessControlInternalCreates bool
+
+	// noBaseFee lets the calls without a gas price run without paying the base fee,
+	// while the BASEFEE opcode still returns the base fee of the block
+	noBaseFee bool
+
 	// result
 	receipts []*types.Receipt
 	totalGas uint64
+	// collectedFees are the fees paid to the coinbase by the transactions
+	collectedFees big.Int
 
 	PostHook func(t *Transition)
 
@@ -208,6 +236,11 @@ func (t *Transition) TotalGas() uint64 {
 	return t.totalGas
 }
 
+// CollectedFees returns the fees paid to the coinbase by the transactions written so far
+func (t *Transition) CollectedFees() *big.Int {
+	return new(big.Int).Set(&t.collectedFees)
+}
+
 func (t *Transition) Receipts() []*types.Receipt {
 	return t.receipts
 }
@@ -229,6 +262,7 @@ func (t *Transition) WriteFailedReceipt(txn *types.Transaction) error {
 
 	receipt := &types.Receipt{
 		CumulativeGasUsed: t.totalGas,
+		TransactionType:   txn.Type,
 		TxHash:            txn.Hash,
 		Logs:              t.state.Logs(),
 	}
@@ -257,6 +291,18 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		}
 	}
 
+	// Make sure the whitelists allow the transaction
+	if err := t.whitelists.ValidateTx(txn.From, txn.To); err != nil {
+		return NewTransitionApplicationError(err, false)
+	}
+
+	// Make sure the access control contract grants the roles to the sender
+	if t.accessControlContract != nil {
+		if err := accesscontrol.ValidateTx(t, *t.accessControlContract, txn.From, txn.To == nil); err != nil {
+			return NewTransitionApplicationError(err, false)
+		}
+	}
+
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
@@ -273,6 +319,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 
 	receipt := &types.Receipt{
 		CumulativeGasUsed: t.totalGas,
+		TransactionType:   txn.Type,
 		TxHash:            txn.Hash,
 		GasUsed:           result.GasUsed,
 	}
@@ -347,9 +394,27 @@ func (t *Transition) ContextPtr() *runtime.TxContext {
 	return &t.ctx
 }
 
+// baseFee returns the base fee of the block being processed
+func (t *Transition) baseFee() uint64 {
+	return new(big.Int).SetBytes(t.ctx.BaseFee.Bytes()).Uint64()
+}
+
 func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
+	// the balance has to cover the max gas cost of a dynamic fee transaction and its value,
+	// even though only the effective gas price is charged
+	if msg.Type == types.DynamicFeeTx {
+		maxGasCost := new(big.Int).Mul(msg.GasFeeCap, new(big.Int).SetUint64(msg.Gas))
+		if msg.Value != nil {
+			maxGasCost.Add(maxGasCost, msg.Value)
+		}
+
+		if t.state.GetBalance(msg.From).Cmp(maxGasCost) < 0 {
+			return ErrNotEnoughFundsForGas
+		}
+	}
+
 	// deduct the upfront max gas cost
-	upfrontGasCost := new(big.Int).Set(msg.GasPrice)
+	upfrontGasCost := msg.GetGasPrice(t.baseFee())
 	upfrontGasCost.Mul(upfrontGasCost, new(big.Int).SetUint64(msg.Gas))
 
 	if err := t.state.SubBalance(msg.From, upfrontGasCost); err != nil {
@@ -363,6 +428,38 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 	return nil
 }
 
+// feeCheck checks the fee fields of the transaction against the base fee of the block (EIP-1559)
+func (t *Transition) feeCheck(msg *types.Transaction) *TransitionApplicationError {
+	if msg.Type == types.AccessListTx && !t.config.Berlin {
+		return NewTransitionApplicationError(ErrTxTypeNotSupported, false)
+	}
+
+	if msg.Type == types.DynamicFeeTx {
+		if !t.config.London {
+			return NewTransitionApplicationError(ErrTxTypeNotSupported, false)
+		}
+
+		if msg.GasTipCap.Cmp(msg.GasFeeCap) > 0 {
+			return NewTransitionApplicationError(ErrTipAboveFeeCap, false)
+		}
+	}
+
+	if !t.config.London || t.isFeelessCall(msg) {
+		return nil
+	}
+
+	feeCap := msg.GasPrice
+	if msg.Type == types.DynamicFeeTx {
+		feeCap = msg.GasFeeCap
+	}
+
+	if feeCap.Cmp(new(big.Int).SetUint64(t.baseFee())) < 0 {
+		return NewTransitionApplicationError(ErrFeeCapTooLow, true)
+	}
+
+	return nil
+}
+
 func (t *Transition) nonceCheck(msg *types.Transaction) error {
 	nonce := t.state.GetNonce(msg.From)
 
@@ -383,6 +480,9 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 	ErrNotEnoughFunds        = fmt.Errorf("not enough funds for transfer with given value")
+	ErrTxTypeNotSupported    = fmt.Errorf("transaction type not supported")
+	ErrTipAboveFeeCap        = fmt.Errorf("max priority fee per gas higher than max fee per gas")
+	ErrFeeCapTooLow          = fmt.Errorf("max fee per gas less than block base fee")
 )
 
 type TransitionApplicationError struct {
@@ -421,13 +521,23 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	// 4. there is no overflow when calculating intrinsic gas
 	// 5. the purchased gas is enough to cover intrinsic usage
 	// 6. caller has enough balance to cover asset transfer for **topmost** call
+	//
+	// After London the fee fields of the message also have to be valid for the base fee of the block
 	txn := t.state
 
+	if err := t.feeCheck(msg); err != nil {
+		return nil, err
+	}
+
 	// 1. the nonce of the message caller is correct
 	if err := t.nonceCheck(msg); err != nil {
 		return nil, NewTransitionApplicationError(err, true)
 	}
 
+	if t.ctx.Tracer != nil {
+		t.ctx.Tracer.TxStart(msg, t)
+	}
+
 	// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
 	if err := t.subGasLimitPrice(msg); err != nil {
 		return nil, NewTransitionApplicationError(err, true)
@@ -438,8 +548,8 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		return nil, NewGasLimitReachedTransitionApplicationError(err)
 	}
 
-	if t.ctx.Tracer != nil {
-		t.ctx.Tracer.TxStart(msg.Gas)
+	if t.config.Berlin {
+		t.prepareAccessList(msg)
 	}
 
 	// 4. there is no overflow when calculating intrinsic gas
@@ -460,7 +570,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		return nil, NewTransitionApplicationError(ErrNotEnoughFunds, true)
 	}
 
-	gasPrice := new(big.Int).Set(msg.GasPrice)
+	gasPrice := msg.GetGasPrice(t.baseFee())
 	value := new(big.Int).Set(msg.Value)
 
 	// Set the specific transaction fields in the context
@@ -478,24 +588,49 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	refund := txn.GetRefund()
 	result.UpdateGasUsed(msg.Gas, refund)
 
-	if t.ctx.Tracer != nil {
-		t.ctx.Tracer.TxEnd(result.GasLeft)
+	if t.config.Berlin {
+		result.AccessList = t.accessedList(msg)
 	}
 
 	// refund the sender
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), gasPrice)
 	txn.AddBalance(msg.From, remaining)
 
-	// pay the coinbase
-	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), gasPrice)
-	txn.AddBalance(t.ctx.Coinbase, coinbaseFee)
+	// the feeless calls pay neither the base fee nor the coinbase
+	if !t.isFeelessCall(msg) {
+		t.payFees(gasPrice, result.GasUsed)
+	}
 
 	// return gas to the pool
 	t.addGasPool(result.GasLeft)
 
+	if t.ctx.Tracer != nil {
+		t.ctx.Tracer.TxEnd(result.GasLeft)
+	}
+
 	return result, nil
 }
 
+// payFees pays the coinbase for the used gas, after London it only gets the tip
+func (t *Transition) payFees(gasPrice *big.Int, gasUsed uint64) {
+	used := new(big.Int).SetUint64(gasUsed)
+	tip := new(big.Int).Set(gasPrice)
+
+	if t.config.London {
+		baseFee := new(big.Int).SetUint64(t.baseFee())
+		tip.Sub(tip, baseFee)
+
+		// the base fee is burned unless it is redirected to the burn contract
+		if t.burnContract != nil {
+			t.state.AddBalance(*t.burnContract, new(big.Int).Mul(used, baseFee))
+		}
+	}
+
+	coinbaseFee := new(big.Int).Mul(used, tip)
+	t.state.AddBalance(t.ctx.Coinbase, coinbaseFee)
+	t.collectedFees.Add(&t.collectedFees, coinbaseFee)
+}
+
 func (t *Transition) Create2(
 	caller types.Address,
 	code []byte,
@@ -619,9 +754,34 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		}
 	}
 
+	// Check if the contract is allowed to deploy contracts,
+	// the creates of the transactions are checked along with the transactions
+	if c.Depth > 1 {
+		if err := t.whitelists.ValidateInternalCreate(c.Caller); err != nil {
+			return &runtime.ExecutionResult{
+				GasLeft: gasLimit,
+				Err:     err,
+			}
+		}
+
+		if t.accessControlContract != nil && t.accessControlInternalCreates {
+			if err := accesscontrol.ValidateCreate(t, *t.accessControlContract, c.Caller); err != nil {
+				return &runtime.ExecutionResult{
+					GasLeft: gasLimit,
+					Err:     err,
+				}
+			}
+		}
+	}
+
 	// Increment the nonce of the caller
 	t.state.IncrNonce(c.Caller)
 
+	// The created address is warm even if the creation fails (eip-2929)
+	if t.config.Berlin {
+		t.state.AddAddressToAccessList(c.Address)
+	}
+
 	// Check if there if there is a collision and the address already exists
 	if t.hasCodeOrNonce(c.Address) {
 		return &runtime.ExecutionResult{
@@ -649,7 +809,11 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 
 	var result *runtime.ExecutionResult
 
-	t.captureCallStart(c, evm.CREATE)
+	if c.Type == runtime.Create2 {
+		t.captureCallStart(c, evm.CREATE2)
+	} else {
+		t.captureCallStart(c, evm.CREATE)
+	}
 
 	defer func() {
 		// pass result to be set later
@@ -758,7 +922,7 @@ func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address)
 }
 
 func (t *Transition) Callx(c *runtime.Contract, h runtime.Host) *runtime.ExecutionResult {
-	if c.Type == runtime.Create {
+	if c.Type == runtime.Create || c.Type == runtime.Create2 {
 		return t.applyCreate(c, h)
 	}
 
@@ -801,6 +965,26 @@ func (t *Transition) SetTracer(tracer tracer.Tracer) {
 	t.ctx.Tracer = tracer
 }
 
+// SetNoBaseFee sets whether the calls without a gas price are exempted from the base fee,
+// which is only meant for the calls that are never written to a block
+func (t *Transition) SetNoBaseFee(noBaseFee bool) {
+	t.noBaseFee = noBaseFee
+}
+
+// isFeelessCall returns whether the message doesn't pay the base fee in the no base fee mode
+func (t *Transition) isFeelessCall(msg *types.Transaction) bool {
+	if !t.noBaseFee {
+		return false
+	}
+
+	if msg.Type == types.DynamicFeeTx {
+		return (msg.GasFeeCap == nil || msg.GasFeeCap.Sign() == 0) &&
+			(msg.GasTipCap == nil || msg.GasTipCap.Sign() == 0)
+	}
+
+	return msg.GasPrice == nil || msg.GasPrice.Sign() == 0
+}
+
 // GetTracer returns a tracer in context
 func (t *Transition) GetTracer() runtime.VMTracer {
 	return t.ctx.Tracer
@@ -810,6 +994,84 @@ func (t *Transition) GetRefund() uint64 {
 	return t.state.GetRefund()
 }
 
+func (t *Transition) AddressInAccessList(addr types.Address) bool {
+	return t.state.AddressInAccessList(addr)
+}
+
+func (t *Transition) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	return t.state.SlotInAccessList(addr, slot)
+}
+
+func (t *Transition) AddAddressToAccessList(addr types.Address) {
+	t.state.AddAddressToAccessList(addr)
+}
+
+func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	t.state.AddSlotToAccessList(addr, slot)
+}
+
+func (t *Transition) GetTransientStorage(addr types.Address, key types.Hash) types.Hash {
+	return t.state.GetTransientState(addr, key)
+}
+
+func (t *Transition) SetTransientStorage(addr types.Address, key types.Hash, value types.Hash) {
+	t.state.SetTransientState(addr, key, value)
+}
+
+// accessedList returns the access list built during the execution of the message,
+// without the accounts that are warm by default and have no storage slots accessed
+func (t *Transition) accessedList(msg *types.Transaction) types.TxAccessList {
+	excluded := map[types.Address]struct{}{
+		msg.From: {},
+	}
+
+	if msg.To != nil {
+		excluded[*msg.To] = struct{}{}
+	} else {
+		excluded[crypto.CreateAddress(msg.From, msg.Nonce)] = struct{}{}
+	}
+
+	for _, addr := range t.precompiles.Addresses() {
+		excluded[addr] = struct{}{}
+	}
+
+	list := types.TxAccessList{}
+
+	for _, tuple := range t.state.AccessList() {
+		if _, ok := excluded[tuple.Address]; ok && len(tuple.StorageKeys) == 0 {
+			continue
+		}
+
+		list = append(list, tuple)
+	}
+
+	return list
+}
+
+// prepareAccessList resets the access list and warms up the accounts and storage slots
+// known at the beginning of the transaction (EIP-2929, EIP-2930)
+func (t *Transition) prepareAccessList(msg *types.Transaction) {
+	t.state.ClearAccessList()
+
+	t.state.AddAddressToAccessList(msg.From)
+
+	if msg.To != nil {
+		t.state.AddAddressToAccessList(*msg.To)
+	}
+
+	for _, addr := range t.precompiles.Addresses() {
+		t.state.AddAddressToAccessList(addr)
+	}
+
+	for _, tuple := range msg.AccessList {
+		t.state.AddAddressToAccessList(tuple.Address)
+
+		for _, key := range tuple.StorageKeys {
+			t.state.AddSlotToAccessList(tuple.Address, key)
+		}
+	}
+}
+
 func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (uint64, error) {
 	cost := uint64(0)
 
@@ -850,6 +1112,12 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 		cost += zeros * 4
 	}
 
+	// eip-2930
+	if len(msg.AccessList) > 0 {
+		cost += uint64(len(msg.AccessList)) * TxAccessListAddressGas
+		cost += uint64(msg.AccessList.StorageKeys()) * TxAccessListStorageKeyGas
+	}
+
 	return cost, nil
 }
 
@@ -879,6 +1147,7 @@ func (t *Transition) captureCallEnd(c *runtime.Contract, result *runtime.Executi
 	t.ctx.Tracer.CallEnd(
 		c.Depth,
 		result.ReturnValue,
+		c.Gas-result.GasLeft,
 		result.Err,
 	)
 }
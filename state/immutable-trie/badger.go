@@ -0,0 +1,107 @@
+package itrie
+
+import (
+	"errors"
+
+	badgerdb "github.com/LaChain/polygon-edge/blockchain/storage/badger"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/hashicorp/go-hclog"
+)
+
+// badgerStorage is a k/v storage using badger
+type badgerStorage struct {
+	db *badger.DB
+}
+
+// badgerBatch is a batch write for badger
+type badgerBatch struct {
+	batch *badger.WriteBatch
+}
+
+func (b *badgerBatch) Put(k, v []byte) {
+	_ = b.batch.Set(k, v)
+}
+
+func (b *badgerBatch) Write() {
+	_ = b.batch.Flush()
+}
+
+func (b *badgerStorage) SetCode(hash types.Hash, code []byte) {
+	b.Put(append(codePrefix, hash.Bytes()...), code)
+}
+
+func (b *badgerStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	return b.Get(append(codePrefix, hash.Bytes()...))
+}
+
+func (b *badgerStorage) Batch() Batch {
+	return &badgerBatch{batch: b.db.NewWriteBatch()}
+}
+
+func (b *badgerStorage) Put(k, v []byte) {
+	_ = b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(k, v)
+	})
+}
+
+func (b *badgerStorage) Get(k []byte) ([]byte, bool) {
+	var data []byte
+
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(k)
+		if err != nil {
+			return err
+		}
+
+		data, err = item.ValueCopy(nil)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil, false
+		}
+
+		panic(err)
+	}
+
+	return data, true
+}
+
+func (b *badgerStorage) Delete(k []byte) {
+	_ = b.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(k)
+	})
+}
+
+// Iterate calls fn with every key of the storage until it returns false.
+// The key is only valid during the call
+func (b *badgerStorage) Iterate(fn func(k []byte) bool) {
+	_ = b.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{})
+		defer iter.Close()
+
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			if !fn(iter.Item().Key()) {
+				break
+			}
+		}
+
+		return nil
+	})
+}
+
+func (b *badgerStorage) Close() error {
+	return b.db.Close()
+}
+
+// NewBadgerStorage creates the trie storage with badger
+func NewBadgerStorage(path string, logger hclog.Logger) (Storage, error) {
+	db, err := badgerdb.OpenDB(path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &badgerStorage{db}, nil
+}
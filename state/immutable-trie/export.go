@@ -0,0 +1,35 @@
+package itrie
+
+import (
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+// emptyCodeHash is the code hash of the accounts without code
+var emptyCodeHash = types.BytesToHash(crypto.Keccak256(nil))
+
+// ExportState calls nodeFn with every trie node of the state at the given root,
+// including the storage tries of its accounts, and codeFn with the code of its contracts
+func (s *State) ExportState(
+	root types.Hash,
+	nodeFn func(hash types.Hash, data []byte) error,
+	codeFn func(hash types.Hash, code []byte) error,
+) error {
+	_, err := s.walkState([]types.Hash{root}, nodeFn, codeFn)
+
+	return err
+}
+
+// VerifyState checks the storage holds all the trie nodes
+// and the contract code of the state at the given root
+func (s *State) VerifyState(root types.Hash) error {
+	_, err := s.walkState(
+		[]types.Hash{root},
+		nil,
+		func(types.Hash, []byte) error {
+			return nil
+		},
+	)
+
+	return err
+}
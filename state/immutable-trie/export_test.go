@@ -0,0 +1,99 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportState(t *testing.T) {
+	t.Parallel()
+
+	st := NewState(NewMemoryStorage())
+
+	code := []byte{0x1, 0x2}
+	objs := make([]*state.Object, 0, 20)
+
+	for i := 1; i <= 20; i++ {
+		obj := &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i)).Bytes()),
+			Balance:  big.NewInt(int64(i)),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+		}
+
+		if i%2 == 0 {
+			obj.CodeHash = types.BytesToHash(crypto.Keccak256(code))
+			obj.Code = code
+			obj.DirtyCode = true
+			obj.Storage = []*state.StorageObject{
+				{
+					Key: types.BytesToHash(big.NewInt(int64(i)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(i)).Bytes()).Bytes(),
+				},
+			}
+		}
+
+		objs = append(objs, obj)
+	}
+
+	_, rootBytes := st.NewSnapshot().Commit(objs)
+	root := types.BytesToHash(rootBytes)
+
+	// copy the exported state to another storage
+	imported := NewMemoryStorage()
+	codes := 0
+
+	assert.NoError(t, st.ExportState(
+		root,
+		func(hash types.Hash, data []byte) error {
+			assert.Equal(t, hash, types.BytesToHash(crypto.Keccak256(data)))
+			imported.Put(hash.Bytes(), data)
+
+			return nil
+		},
+		func(hash types.Hash, code []byte) error {
+			imported.SetCode(hash, code)
+			codes++
+
+			return nil
+		},
+	))
+
+	// the code shared by the contracts is exported once
+	assert.Equal(t, 1, codes)
+
+	importedState := NewState(imported)
+	assert.NoError(t, importedState.VerifyState(root))
+
+	snap, err := importedState.NewSnapshotAt(root)
+	assert.NoError(t, err)
+
+	account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(2).Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), account.Balance)
+
+	key := types.BytesToHash(big.NewInt(2).Bytes())
+	assert.Equal(t, key, snap.GetStorage(types.BytesToAddress(big.NewInt(2).Bytes()), account.Root, key))
+
+	// the state is incomplete without the code
+	withoutCode := NewMemoryStorage()
+
+	assert.NoError(t, st.ExportState(
+		root,
+		func(hash types.Hash, data []byte) error {
+			withoutCode.Put(hash.Bytes(), data)
+
+			return nil
+		},
+		func(types.Hash, []byte) error {
+			return nil
+		},
+	))
+
+	assert.Error(t, NewState(withoutCode).VerifyState(root))
+}
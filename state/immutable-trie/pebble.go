@@ -0,0 +1,92 @@
+package itrie
+
+import (
+	"errors"
+
+	pebbledb "github.com/LaChain/polygon-edge/blockchain/storage/pebble"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+)
+
+// pebbleStorage is a k/v storage using pebble
+type pebbleStorage struct {
+	db *pebble.DB
+}
+
+// pebbleBatch is a batch write for pebble
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+func (b *pebbleBatch) Put(k, v []byte) {
+	_ = b.batch.Set(k, v, nil)
+}
+
+func (b *pebbleBatch) Write() {
+	_ = b.batch.Commit(pebble.NoSync)
+	_ = b.batch.Close()
+}
+
+func (p *pebbleStorage) SetCode(hash types.Hash, code []byte) {
+	p.Put(append(codePrefix, hash.Bytes()...), code)
+}
+
+func (p *pebbleStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	return p.Get(append(codePrefix, hash.Bytes()...))
+}
+
+func (p *pebbleStorage) Batch() Batch {
+	return &pebbleBatch{batch: p.db.NewBatch()}
+}
+
+func (p *pebbleStorage) Put(k, v []byte) {
+	_ = p.db.Set(k, v, pebble.NoSync)
+}
+
+func (p *pebbleStorage) Get(k []byte) ([]byte, bool) {
+	data, closer, err := p.db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false
+		}
+
+		panic(err)
+	}
+
+	defer closer.Close()
+
+	// the data is only valid until the closer is closed
+	return append([]byte{}, data...), true
+}
+
+func (p *pebbleStorage) Delete(k []byte) {
+	_ = p.db.Delete(k, pebble.NoSync)
+}
+
+// Iterate calls fn with every key of the storage until it returns false.
+// The key is only valid during the call
+func (p *pebbleStorage) Iterate(fn func(k []byte) bool) {
+	iter := p.db.NewIter(nil)
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !fn(iter.Key()) {
+			return
+		}
+	}
+}
+
+func (p *pebbleStorage) Close() error {
+	return p.db.Close()
+}
+
+// NewPebbleStorage creates the trie storage with pebble
+func NewPebbleStorage(path string, logger hclog.Logger) (Storage, error) {
+	db, err := pebbledb.OpenDB(path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pebbleStorage{db}, nil
+}
@@ -0,0 +1,173 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var (
+	ErrInvalidProofNode    = errors.New("proof node does not match the expected hash")
+	ErrIncompleteProof     = errors.New("proof ends before reaching the key")
+	ErrUnexpectedProofNode = errors.New("proof has nodes after the end of the path")
+)
+
+// GetProof returns the RLP encoded nodes along the path of the key in the trie
+// with the given root, starting with the root node. If the key is not in the trie,
+// the nodes prove its absence
+func (s *State) GetProof(root types.Hash, key []byte) ([][]byte, error) {
+	return getProof(root.Bytes(), key, s.storage)
+}
+
+func getProof(root []byte, key []byte, storage Storage) ([][]byte, error) {
+	proof := [][]byte{}
+
+	if bytes.Equal(root, emptyRoot) {
+		return proof, nil
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	next := root
+	search := bytesToHexNibbles(key)
+
+	for next != nil {
+		data, ok := storage.Get(next)
+		if !ok {
+			return nil, fmt.Errorf("%w: trie node %s not found", state.ErrStateNotFound, hex.EncodeToHex(next))
+		}
+
+		proof = append(proof, data)
+
+		v, err := p.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if next, search, _, err = walkNode(v, search); err != nil {
+			return nil, err
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks the proof of the key against the root of the trie
+// and returns the value of the key, or nil if the proof shows it is not in the trie
+func VerifyProof(root types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == types.EmptyRootHash && len(proof) == 0 {
+		return nil, nil
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	expected := root.Bytes()
+	search := bytesToHexNibbles(key)
+
+	for i, data := range proof {
+		if !bytes.Equal(crypto.Keccak256(data), expected) {
+			return nil, fmt.Errorf("%w: node %d", ErrInvalidProofNode, i)
+		}
+
+		v, err := p.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+
+		next, rest, value, err := walkNode(v, search)
+		if err != nil {
+			return nil, err
+		}
+
+		if next == nil {
+			if i != len(proof)-1 {
+				return nil, ErrUnexpectedProofNode
+			}
+
+			return value, nil
+		}
+
+		expected, search = next, rest
+	}
+
+	return nil, ErrIncompleteProof
+}
+
+// walkNode follows the search path through the node and its embedded children.
+// It returns either the hash of the next node in the path along with the remaining
+// path, or the value of the key (nil if the key is not in the trie)
+func walkNode(v *fastrlp.Value, search []byte) ([]byte, []byte, []byte, error) {
+	for {
+		if v.Type() != fastrlp.TypeArray {
+			return nil, nil, nil, fmt.Errorf("trie node expected to be an array")
+		}
+
+		var child *fastrlp.Value
+
+		switch v.Elems() {
+		case 2:
+			key := v.Get(0)
+			if key.Type() != fastrlp.TypeBytes {
+				return nil, nil, nil, fmt.Errorf("short key expected to be bytes")
+			}
+
+			nodeKey := decodeCompact(key.Raw())
+			if !bytes.HasPrefix(search, nodeKey) {
+				return nil, nil, nil, nil
+			}
+
+			search = search[len(nodeKey):]
+			child = v.Get(1)
+
+			if hasTerminator(nodeKey) {
+				// leaf node
+				return nil, nil, copyBytes(child.Raw()), nil
+			}
+
+		case 17:
+			if len(search) == 0 {
+				return nil, nil, nil, fmt.Errorf("search path ended in a full node")
+			}
+
+			if search[0] == 16 {
+				// the value is stored in the full node
+				if value := v.Get(16).Raw(); len(value) != 0 {
+					return nil, nil, copyBytes(value), nil
+				}
+
+				return nil, nil, nil, nil
+			}
+
+			child = v.Get(int(search[0]))
+			search = search[1:]
+
+		default:
+			return nil, nil, nil, fmt.Errorf("node has incorrect number of leafs")
+		}
+
+		if child.Type() == fastrlp.TypeBytes {
+			if len(child.Raw()) == 0 {
+				// empty edge
+				return nil, nil, nil, nil
+			}
+
+			// reference to a node stored by its hash
+			return copyBytes(child.Raw()), search, nil, nil
+		}
+
+		// embedded node
+		v = child
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	return append([]byte{}, b...)
+}
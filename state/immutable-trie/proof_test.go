@@ -0,0 +1,127 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProof(t *testing.T) {
+	t.Parallel()
+
+	st := NewState(NewMemoryStorage())
+
+	objs := []*state.Object{}
+
+	for i := 1; i <= 50; i++ {
+		obj := &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i)).Bytes()),
+			Balance:  big.NewInt(int64(i)),
+			Nonce:    uint64(i),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+		}
+
+		if i == 1 {
+			for j := 1; j <= 20; j++ {
+				obj.Storage = append(obj.Storage, &state.StorageObject{
+					Key: types.BytesToHash(big.NewInt(int64(j)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(j * 2)).Bytes()).Bytes(),
+				})
+			}
+		}
+
+		objs = append(objs, obj)
+	}
+
+	snap, rawRoot := st.NewSnapshot().Commit(objs)
+	root := types.BytesToHash(rawRoot)
+
+	t.Run("account proof", func(t *testing.T) {
+		t.Parallel()
+
+		addr := types.BytesToAddress(big.NewInt(7).Bytes())
+		key := crypto.Keccak256(addr.Bytes())
+
+		proof, err := st.GetProof(root, key)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, proof)
+
+		value, err := VerifyProof(root, key, proof)
+		assert.NoError(t, err)
+
+		var account state.Account
+		assert.NoError(t, account.UnmarshalRlp(value))
+		assert.Equal(t, uint64(7), account.Nonce)
+	})
+
+	t.Run("storage proof", func(t *testing.T) {
+		t.Parallel()
+
+		account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(1).Bytes()))
+		assert.NoError(t, err)
+
+		slot := types.BytesToHash(big.NewInt(3).Bytes())
+		key := crypto.Keccak256(slot.Bytes())
+
+		proof, err := st.GetProof(account.Root, key)
+		assert.NoError(t, err)
+
+		value, err := VerifyProof(account.Root, key, proof)
+		assert.NoError(t, err)
+		// the value is the RLP encoding of the slot value
+		assert.Equal(t, []byte{0x06}, value)
+	})
+
+	t.Run("absence proof", func(t *testing.T) {
+		t.Parallel()
+
+		key := crypto.Keccak256(types.StringToAddress("0xdead").Bytes())
+
+		proof, err := st.GetProof(root, key)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, proof)
+
+		value, err := VerifyProof(root, key, proof)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("empty trie", func(t *testing.T) {
+		t.Parallel()
+
+		proof, err := st.GetProof(types.EmptyRootHash, crypto.Keccak256([]byte{1}))
+		assert.NoError(t, err)
+		assert.Empty(t, proof)
+
+		value, err := VerifyProof(types.EmptyRootHash, crypto.Keccak256([]byte{1}), proof)
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("invalid proofs", func(t *testing.T) {
+		t.Parallel()
+
+		key := crypto.Keccak256(types.BytesToAddress(big.NewInt(9).Bytes()).Bytes())
+
+		proof, err := st.GetProof(root, key)
+		assert.NoError(t, err)
+
+		_, err = VerifyProof(root, key, proof[:len(proof)-1])
+		assert.ErrorIs(t, err, ErrIncompleteProof)
+
+		tampered := append([][]byte{}, proof...)
+		tampered[len(tampered)-1] = append([]byte{}, tampered[len(tampered)-1]...)
+		tampered[len(tampered)-1][len(tampered[len(tampered)-1])-1]++
+
+		_, err = VerifyProof(root, key, tampered)
+		assert.ErrorIs(t, err, ErrInvalidProofNode)
+
+		_, err = VerifyProof(types.StringToHash("1"), key, proof)
+		assert.ErrorIs(t, err, ErrInvalidProofNode)
+	})
+}
@@ -0,0 +1,296 @@
+package itrie
+
+import (
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// pruneItem is a node pending to be marked during the prune
+type pruneItem struct {
+	hash types.Hash
+
+	// account is true if the node belongs to the account trie,
+	// whose leaves reference the storage tries
+	account bool
+}
+
+// pruneBatchSize is the number of unreachable nodes deleted at once,
+// while the commits are held back
+const pruneBatchSize = 10000
+
+// Prune removes from the storage all the trie nodes that are not reachable
+// from the given state roots, nor from the roots committed until the nodes are deleted.
+// Contract code is kept. It returns the number of deleted nodes.
+// The reachable nodes are marked while the state keeps being committed,
+// and the commits are only held back while a batch of the unreachable nodes is deleted
+func (s *State) Prune(roots []types.Hash) (int, error) {
+	s.pruneLock.Lock()
+	defer s.pruneLock.Unlock()
+
+	reachable, err := s.markReachable(append(roots, s.takeCommitted()...), map[types.Hash]struct{}{})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		deleted    = 0
+		candidates = make([][]byte, 0, pruneBatchSize)
+	)
+
+	s.storage.Iterate(func(k []byte) bool {
+		if len(k) != types.HashLength {
+			// not a trie node
+			return true
+		}
+
+		if _, ok := reachable[types.BytesToHash(k)]; !ok {
+			candidates = append(candidates, copyBytes(k))
+		}
+
+		if len(candidates) < pruneBatchSize {
+			return true
+		}
+
+		var n int
+		if n, err = s.deleteUnreachable(candidates, reachable); err != nil {
+			return false
+		}
+
+		deleted += n
+		candidates = candidates[:0]
+
+		return true
+	})
+
+	if err != nil {
+		return deleted, err
+	}
+
+	n, err := s.deleteUnreachable(candidates, reachable)
+	deleted += n
+
+	// the cached tries might reference deleted nodes
+	s.cache.Purge()
+
+	return deleted, err
+}
+
+// deleteUnreachable deletes the nodes which are still unreachable, holding back the commits.
+// The nodes of the roots committed since they were marked are marked first, as they are live
+func (s *State) deleteUnreachable(candidates [][]byte, reachable map[types.Hash]struct{}) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.markReachable(s.takeCommitted(), reachable); err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+
+	for _, k := range candidates {
+		if _, ok := reachable[types.BytesToHash(k)]; !ok {
+			s.storage.Delete(k)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// takeCommitted returns the roots committed since it was called last
+func (s *State) takeCommitted() []types.Hash {
+	s.committedLock.Lock()
+	defer s.committedLock.Unlock()
+
+	roots := make([]types.Hash, 0, len(s.committed))
+	for root := range s.committed {
+		roots = append(roots, root)
+	}
+
+	s.committed = map[types.Hash]struct{}{}
+
+	return roots
+}
+
+// markReachable adds to the reachable nodes all the nodes in the account tries
+// of the roots and in the storage tries of their accounts.
+// The nodes already reachable are not visited again
+func (s *State) markReachable(
+	roots []types.Hash,
+	reachable map[types.Hash]struct{},
+) (map[types.Hash]struct{}, error) {
+	return s.walk(roots, reachable, nil, nil)
+}
+
+// walkState visits all the nodes in the account tries of the roots and in the storage tries
+// of their accounts, and returns their hashes. nodeFn is called with every node and codeFn
+// with the code of every contract, if set
+func (s *State) walkState(
+	roots []types.Hash,
+	nodeFn func(hash types.Hash, data []byte) error,
+	codeFn func(hash types.Hash, code []byte) error,
+) (map[types.Hash]struct{}, error) {
+	return s.walk(roots, map[types.Hash]struct{}{}, nodeFn, codeFn)
+}
+
+// walk visits the nodes of the roots like walkState, skipping the nodes already visited
+func (s *State) walk(
+	roots []types.Hash,
+	reachable map[types.Hash]struct{},
+	nodeFn func(hash types.Hash, data []byte) error,
+	codeFn func(hash types.Hash, code []byte) error,
+) (map[types.Hash]struct{}, error) {
+	codes := map[types.Hash]struct{}{}
+
+	stack := make([]pruneItem, 0, len(roots))
+	for _, root := range roots {
+		stack = append(stack, pruneItem{hash: root, account: true})
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	for len(stack) != 0 {
+		item := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if item.hash == types.EmptyRootHash {
+			continue
+		}
+
+		if _, ok := reachable[item.hash]; ok {
+			continue
+		}
+
+		data, ok := s.storage.Get(item.hash.Bytes())
+		if !ok {
+			return nil, fmt.Errorf("trie node %s not found", hex.EncodeToHex(item.hash.Bytes()))
+		}
+
+		reachable[item.hash] = struct{}{}
+
+		if nodeFn != nil {
+			if err := nodeFn(item.hash, data); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := p.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+
+		children, values, err := collectReferences(v, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range children {
+			stack = append(stack, pruneItem{hash: child, account: item.account})
+		}
+
+		if !item.account {
+			continue
+		}
+
+		for _, value := range values {
+			var account state.Account
+			if err := account.UnmarshalRlp(value); err != nil {
+				return nil, err
+			}
+
+			stack = append(stack, pruneItem{hash: account.Root})
+
+			if codeFn == nil {
+				continue
+			}
+
+			codeHash := types.BytesToHash(account.CodeHash)
+			if _, ok := codes[codeHash]; ok || codeHash == emptyCodeHash || codeHash == types.ZeroHash {
+				continue
+			}
+
+			code, ok := s.storage.GetCode(codeHash)
+			if !ok {
+				return nil, fmt.Errorf("code %s not found", codeHash)
+			}
+
+			codes[codeHash] = struct{}{}
+
+			if err := codeFn(codeHash, code); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return reachable, nil
+}
+
+// collectReferences appends the hashes of the nodes referenced by the node
+// and the values of its leaves, including those of its embedded nodes
+func collectReferences(
+	v *fastrlp.Value,
+	children []types.Hash,
+	values [][]byte,
+) ([]types.Hash, [][]byte, error) {
+	if v.Type() != fastrlp.TypeArray {
+		return nil, nil, fmt.Errorf("trie node expected to be an array")
+	}
+
+	var err error
+
+	switch v.Elems() {
+	case 2:
+		key := v.Get(0)
+		if key.Type() != fastrlp.TypeBytes {
+			return nil, nil, fmt.Errorf("short key expected to be bytes")
+		}
+
+		if hasTerminator(decodeCompact(key.Raw())) {
+			// leaf node
+			values = append(values, copyBytes(v.Get(1).Raw()))
+
+			return children, values, nil
+		}
+
+		return collectChild(v.Get(1), children, values)
+
+	case 17:
+		for i := 0; i < 16; i++ {
+			if children, values, err = collectChild(v.Get(i), children, values); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if value := v.Get(16).Raw(); len(value) != 0 {
+			values = append(values, copyBytes(value))
+		}
+
+		return children, values, nil
+
+	default:
+		return nil, nil, fmt.Errorf("node has incorrect number of leafs")
+	}
+}
+
+func collectChild(
+	child *fastrlp.Value,
+	children []types.Hash,
+	values [][]byte,
+) ([]types.Hash, [][]byte, error) {
+	if child.Type() == fastrlp.TypeBytes {
+		if len(child.Raw()) != 0 {
+			// reference to a node stored by its hash
+			children = append(children, types.BytesToHash(child.Raw()))
+		}
+
+		return children, values, nil
+	}
+
+	// embedded node
+	return collectReferences(child, children, values)
+}
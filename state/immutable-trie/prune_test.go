@@ -0,0 +1,213 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+// commitBalance commits the balance of the account on top of the snapshot
+func commitBalance(snap state.Snapshot, addr types.Address, balance int64) (state.Snapshot, types.Hash) {
+	snap, root := snap.Commit([]*state.Object{
+		{
+			Address:  addr,
+			Balance:  big.NewInt(balance),
+			Root:     types.EmptyRootHash,
+			CodeHash: emptyCodeHash,
+		},
+	})
+
+	return snap, types.BytesToHash(root)
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	st := NewState(storage)
+
+	addr := types.StringToAddress("1")
+	codeHash := types.BytesToHash(crypto.Keccak256([]byte{0x1}))
+
+	roots := []types.Hash{}
+	snap := st.NewSnapshot()
+
+	for i := 1; i <= 5; i++ {
+		obj := &state.Object{
+			Address:   addr,
+			Balance:   big.NewInt(int64(i)),
+			Nonce:     uint64(i),
+			Root:      types.EmptyRootHash,
+			CodeHash:  codeHash,
+			Code:      []byte{0x1},
+			DirtyCode: i == 1,
+			Storage: []*state.StorageObject{
+				{
+					Key: types.BytesToHash(big.NewInt(int64(i)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(i)).Bytes()).Bytes(),
+				},
+			},
+		}
+
+		if i > 1 {
+			// keep the storage of the previous state
+			account, err := snap.GetAccount(addr)
+			assert.NoError(t, err)
+
+			obj.Root = account.Root
+		}
+
+		var root []byte
+		snap, root = snap.Commit([]*state.Object{obj})
+
+		roots = append(roots, types.BytesToHash(root))
+	}
+
+	// roots committed since the last prune are always kept
+	deleted, err := st.Prune(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, deleted)
+
+	deleted, err = st.Prune(roots[3:])
+	assert.NoError(t, err)
+	assert.NotZero(t, deleted)
+
+	for i, root := range roots {
+		_, err := st.NewSnapshotAt(root)
+		if i < 3 {
+			assert.ErrorIs(t, err, state.ErrStateNotFound)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+
+		snap, _ := st.NewSnapshotAt(root)
+
+		account, err := snap.GetAccount(addr)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(i+1), account.Nonce)
+
+		// the storage written in all the previous states is kept
+		for j := 1; j <= i+1; j++ {
+			slot := types.BytesToHash(big.NewInt(int64(j)).Bytes())
+			assert.Equal(t, slot, snap.GetStorage(addr, account.Root, slot))
+		}
+	}
+
+	code, ok := st.GetCode(codeHash)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x1}, code)
+
+	// pruning again with the same roots has no effect
+	deleted, err = st.Prune(roots[3:])
+	assert.NoError(t, err)
+	assert.Zero(t, deleted)
+}
+
+func TestPrune_CommitWhileMarking(t *testing.T) {
+	t.Parallel()
+
+	st := NewState(NewMemoryStorage())
+
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+	)
+
+	snap, oldRoot := commitBalance(st.NewSnapshot(), addr1, 1)
+	snap, liveRoot := commitBalance(snap, addr1, 2)
+
+	// the nodes are marked from the live root only
+	st.takeCommitted()
+
+	reachable, err := st.markReachable([]types.Hash{liveRoot}, map[types.Hash]struct{}{})
+	assert.NoError(t, err)
+
+	// a block is committed on top of the live root before the nodes are deleted
+	_, newRoot := commitBalance(snap, addr2, 3)
+
+	candidates := [][]byte{}
+
+	st.storage.Iterate(func(k []byte) bool {
+		if _, ok := reachable[types.BytesToHash(k)]; !ok && len(k) == types.HashLength {
+			candidates = append(candidates, copyBytes(k))
+		}
+
+		return true
+	})
+
+	deleted, err := st.deleteUnreachable(candidates, reachable)
+	assert.NoError(t, err)
+	assert.NotZero(t, deleted)
+
+	st.cache.Purge()
+
+	_, err = st.NewSnapshotAt(oldRoot)
+	assert.ErrorIs(t, err, state.ErrStateNotFound)
+
+	for _, root := range []types.Hash{liveRoot, newRoot} {
+		_, err := st.NewSnapshotAt(root)
+		assert.NoError(t, err)
+	}
+}
+
+func TestPrune_ConcurrentCommits(t *testing.T) {
+	t.Parallel()
+
+	storage, err := NewLevelDBStorage(t.TempDir(), hclog.NewNullLogger())
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = storage.Close()
+	})
+
+	st := NewState(storage)
+	addr := types.StringToAddress("1")
+
+	snap, root := commitBalance(st.NewSnapshot(), addr, 0)
+	for i := int64(1); i < 100; i++ {
+		snap, root = commitBalance(snap, addr, i)
+	}
+
+	_, err = st.Prune([]types.Hash{root})
+	assert.NoError(t, err)
+
+	var (
+		roots = make([]types.Hash, 0, 100)
+		done  = make(chan struct{})
+	)
+
+	// the blocks keep being committed while the state is pruned
+	go func() {
+		defer close(done)
+
+		snap := snap
+
+		for i := int64(100); i < 200; i++ {
+			var committed types.Hash
+
+			snap, committed = commitBalance(snap, addr, i)
+			roots = append(roots, committed)
+		}
+	}()
+
+	_, err = st.Prune([]types.Hash{root})
+	assert.NoError(t, err)
+
+	<-done
+
+	for i, root := range roots {
+		snap, err := st.NewSnapshotAt(root)
+		assert.NoError(t, err)
+
+		account, err := snap.GetAccount(addr)
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(int64(i+100)), account.Balance)
+	}
+}
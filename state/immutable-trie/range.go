@@ -0,0 +1,581 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var (
+	ErrInvalidRange      = errors.New("invalid range")
+	ErrRangeRootMismatch = errors.New("the range does not match the root of the trie")
+)
+
+// GetRange returns at most limit keys and values of the trie with the given root,
+// in ascending order from the origin, with the nodes proving the origin and the last key.
+// No proof is returned if the trie is empty
+func (s *State) GetRange(root types.Hash, origin []byte, limit int) ([][]byte, [][]byte, [][]byte, error) {
+	keys, values := [][]byte{}, [][]byte{}
+
+	if root == types.EmptyRootHash {
+		return keys, values, [][]byte{}, nil
+	}
+
+	err := iterateRange(s.storage, root.Bytes(), origin, func(key, value []byte) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+
+		return len(keys) < limit
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proof, err := getProof(root.Bytes(), origin, s.storage)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(keys) > 0 {
+		last, err := getProof(root.Bytes(), keys[len(keys)-1], s.storage)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		proof = appendProofNodes(proof, last)
+	}
+
+	return keys, values, proof, nil
+}
+
+// appendProofNodes appends the nodes which are not in the proof yet
+func appendProofNodes(proof [][]byte, nodes [][]byte) [][]byte {
+	seen := make(map[string]struct{}, len(proof))
+	for _, node := range proof {
+		seen[string(node)] = struct{}{}
+	}
+
+	for _, node := range nodes {
+		if _, ok := seen[string(node)]; !ok {
+			proof = append(proof, node)
+		}
+	}
+
+	return proof
+}
+
+// iterateRange calls fn with the keys and values of the trie with the given root
+// in ascending order from the origin, until fn returns false
+func iterateRange(storage Storage, root, origin []byte, fn func(key, value []byte) bool) error {
+	node, ok, err := GetNode(root, storage)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("trie node %s not found", hex.EncodeToHex(root))
+	}
+
+	_, err = iterateNode(storage, node, []byte{}, bytesToHexNibbles(origin), fn)
+
+	return err
+}
+
+// iterateNode visits the leaves under the node whose path is not before the origin.
+// It returns false if fn stopped the iteration
+func iterateNode(storage Storage, node Node, path, origin []byte, fn func(key, value []byte) bool) (bool, error) {
+	switch n := node.(type) {
+	case nil:
+		return true, nil
+
+	case *ValueNode:
+		if n.hash {
+			child, ok, err := GetNode(n.buf, storage)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return false, fmt.Errorf("trie node %s not found", hex.EncodeToHex(n.buf))
+			}
+
+			return iterateNode(storage, child, path, origin, fn)
+		}
+
+		return fn(hexNibblesToBytes(path), n.buf), nil
+
+	case *ShortNode:
+		childPath := concat(path, n.key)
+		if isPathBefore(childPath, origin) {
+			return true, nil
+		}
+
+		return iterateNode(storage, n.child, childPath, origin, fn)
+
+	case *FullNode:
+		if n.value != nil && !isPathBefore(concat(path, []byte{16}), origin) {
+			if next, err := iterateNode(storage, n.value, concat(path, []byte{16}), origin, fn); !next || err != nil {
+				return next, err
+			}
+		}
+
+		for i, child := range n.children {
+			if child == nil {
+				continue
+			}
+
+			childPath := concat(path, []byte{byte(i)})
+			if isPathBefore(childPath, origin) {
+				continue
+			}
+
+			if next, err := iterateNode(storage, child, childPath, origin, fn); !next || err != nil {
+				return next, err
+			}
+		}
+
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("unknown node type %T", n)
+	}
+}
+
+// isPathBefore returns true if all the keys under the path are before the origin
+func isPathBefore(path, origin []byte) bool {
+	n := len(path)
+	if len(origin) < n {
+		n = len(origin)
+	}
+
+	return bytes.Compare(path[:n], origin[:n]) < 0
+}
+
+// hexNibblesToBytes packs the nibbles of a key, with the terminator flag, into bytes
+func hexNibblesToBytes(nibbles []byte) []byte {
+	if hasTerminator(nibbles) {
+		nibbles = nibbles[:len(nibbles)-1]
+	}
+
+	key := make([]byte, len(nibbles)/2)
+	for i := range key {
+		key[i] = nibbles[2*i]<<4 | nibbles[2*i+1]
+	}
+
+	return key
+}
+
+// VerifyRangeProof checks the keys and values, in ascending order from the origin,
+// are all the entries of the trie with the given root between the origin and the last key.
+// Without proof, they are expected to be all the entries of the trie.
+// It returns true if the trie has more entries after the last key
+func VerifyRangeProof(root types.Hash, origin []byte, keys, values, proof [][]byte) (bool, error) {
+	if len(keys) != len(values) {
+		return false, fmt.Errorf("%w: %d keys but %d values", ErrInvalidRange, len(keys), len(values))
+	}
+
+	for i := range keys {
+		if len(values[i]) == 0 {
+			return false, fmt.Errorf("%w: empty value", ErrInvalidRange)
+		}
+
+		if i > 0 && bytes.Compare(keys[i-1], keys[i]) >= 0 {
+			return false, fmt.Errorf("%w: the keys are not in ascending order", ErrInvalidRange)
+		}
+	}
+
+	// the range is the whole trie
+	if len(proof) == 0 {
+		if hash := BuildTrie(NewMemoryStorage(), keys, values); hash != root {
+			return false, fmt.Errorf("%w: expected %s but got %s", ErrRangeRootMismatch, root, hash)
+		}
+
+		return false, nil
+	}
+
+	proofDB := make(map[string][]byte, len(proof))
+	for _, node := range proof {
+		proofDB[string(crypto.Keccak256(node))] = node
+	}
+
+	originPath := bytesToHexNibbles(origin)
+
+	// the trie has no entry from the origin
+	if len(keys) == 0 {
+		rootNode, value, err := proofToPath(root, nil, originPath, proofDB)
+		if err != nil {
+			return false, err
+		}
+
+		if value != nil || hasRightElement(rootNode, originPath) {
+			return false, fmt.Errorf("%w: the trie has entries after the origin", ErrInvalidRange)
+		}
+
+		return false, nil
+	}
+
+	if bytes.Compare(origin, keys[0]) > 0 {
+		return false, fmt.Errorf("%w: the first key is before the origin", ErrInvalidRange)
+	}
+
+	lastPath := bytesToHexNibbles(keys[len(keys)-1])
+
+	// the range has a single key, proven on its own
+	if len(keys) == 1 && bytes.Equal(origin, keys[0]) {
+		rootNode, value, err := proofToPath(root, nil, originPath, proofDB)
+		if err != nil {
+			return false, err
+		}
+
+		if !bytes.Equal(value, values[0]) {
+			return false, fmt.Errorf("%w: the value of the key does not match the proof", ErrInvalidRange)
+		}
+
+		return hasRightElement(rootNode, originPath), nil
+	}
+
+	if len(origin) != len(keys[len(keys)-1]) {
+		return false, fmt.Errorf("%w: the origin and the keys have different lengths", ErrInvalidRange)
+	}
+
+	// resolve the paths of the edges of the range, then remove all the nodes between them
+	// so that the trie is rebuilt with the entries of the range
+	rootNode, _, err := proofToPath(root, nil, originPath, proofDB)
+	if err != nil {
+		return false, err
+	}
+
+	if rootNode, _, err = proofToPath(root, rootNode, lastPath, proofDB); err != nil {
+		return false, err
+	}
+
+	empty, err := unsetInternal(rootNode, originPath, lastPath)
+	if err != nil {
+		return false, err
+	}
+
+	if empty {
+		rootNode = nil
+	}
+
+	// nodes out of the proof are never resolved
+	txn := &Txn{root: rootNode, epoch: 1, storage: NewMemoryStorage()}
+	for i, key := range keys {
+		txn.Insert(key, values[i])
+	}
+
+	hash, err := txn.Hash()
+	if err != nil {
+		return false, err
+	}
+
+	if !bytes.Equal(hash, root.Bytes()) {
+		return false, fmt.Errorf("%w: expected %s but got %s", ErrRangeRootMismatch, root, hex.EncodeToHex(hash))
+	}
+
+	return hasRightElement(txn.root, lastPath), nil
+}
+
+// BuildTrie writes the trie with the given keys and values into the storage and returns its root
+func BuildTrie(storage Storage, keys, values [][]byte) types.Hash {
+	batch := storage.Batch()
+
+	txn := &Txn{storage: storage, batch: batch}
+	for i, key := range keys {
+		txn.Insert(key, values[i])
+	}
+
+	root, _ := txn.Hash()
+
+	batch.Write()
+
+	return types.BytesToHash(root)
+}
+
+// resolveProofNode decodes the proof node with the given hash
+func resolveProofNode(hash []byte, proofDB map[string][]byte) (Node, error) {
+	data, ok := proofDB[string(hash)]
+	if !ok {
+		return nil, fmt.Errorf("%w: node %s is missing", ErrIncompleteProof, hex.EncodeToHex(hash))
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Type() != fastrlp.TypeArray {
+		return nil, fmt.Errorf("proof node should be an array")
+	}
+
+	return decodeNode(v, nil)
+}
+
+// proofToPath resolves the proof nodes along the path into the trie with the given root node,
+// decoding the root node first if not given. It returns the root node and the value at the path,
+// or nil if the path is not in the trie
+func proofToPath(root types.Hash, rootNode Node, path []byte, proofDB map[string][]byte) (Node, []byte, error) {
+	if rootNode == nil {
+		node, err := resolveProofNode(root.Bytes(), proofDB)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rootNode = node
+	}
+
+	parent, key := rootNode, path
+
+	for {
+		rest, child := nextOnPath(parent, key)
+
+		switch c := child.(type) {
+		case nil:
+			return rootNode, nil, nil
+
+		case *ShortNode, *FullNode:
+			parent, key = child, rest
+
+			continue
+
+		case *ValueNode:
+			if !c.hash {
+				return rootNode, c.buf, nil
+			}
+
+			resolved, err := resolveProofNode(c.buf, proofDB)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// link the parent with the resolved node
+			switch p := parent.(type) {
+			case *ShortNode:
+				p.child = resolved
+			case *FullNode:
+				p.setEdge(key[0], resolved)
+			}
+
+			parent, key = resolved, rest
+		}
+	}
+}
+
+// nextOnPath returns the child of the node on the path with the rest of the path,
+// or nil if the path is not in the node
+func nextOnPath(node Node, path []byte) ([]byte, Node) {
+	switch n := node.(type) {
+	case *ShortNode:
+		if len(path) < len(n.key) || !bytes.Equal(n.key, path[:len(n.key)]) {
+			return nil, nil
+		}
+
+		return path[len(n.key):], n.child
+
+	case *FullNode:
+		if len(path) == 0 {
+			return nil, nil
+		}
+
+		return path[1:], n.getEdge(path[0])
+	}
+
+	return nil, nil
+}
+
+// hasRightElement returns true if the trie has entries after the path
+func hasRightElement(node Node, path []byte) bool {
+	pos := 0
+
+	for node != nil && pos < len(path) {
+		switch n := node.(type) {
+		case *FullNode:
+			for i := int(path[pos]) + 1; i < 16; i++ {
+				if n.children[i] != nil {
+					return true
+				}
+			}
+
+			node, pos = n.getEdge(path[pos]), pos+1
+
+		case *ShortNode:
+			if len(path)-pos < len(n.key) || !bytes.Equal(n.key, path[pos:pos+len(n.key)]) {
+				return bytes.Compare(n.key, path[pos:]) > 0
+			}
+
+			node, pos = n.child, pos+len(n.key)
+
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// unsetInternal removes all the nodes between the left and right paths, which have been
+// resolved in the trie. It returns true if the whole trie is between the paths
+func unsetInternal(node Node, left, right []byte) (bool, error) {
+	var (
+		pos    = 0
+		parent Node
+
+		shortForkLeft, shortForkRight int
+	)
+
+	// find the fork point of the paths
+findFork:
+	for {
+		switch n := node.(type) {
+		case *ShortNode:
+			shortForkLeft = compareNodeKey(left[pos:], n.key)
+			shortForkRight = compareNodeKey(right[pos:], n.key)
+
+			if shortForkLeft != 0 || shortForkRight != 0 {
+				break findFork
+			}
+
+			parent, node, pos = n, n.child, pos+len(n.key)
+
+		case *FullNode:
+			leftNode, rightNode := n.getEdge(left[pos]), n.getEdge(right[pos])
+			if leftNode == nil || rightNode == nil || leftNode != rightNode {
+				break findFork
+			}
+
+			parent, node, pos = n, leftNode, pos+1
+
+		default:
+			return false, fmt.Errorf("%w: unexpected node %T on the path", ErrInvalidRange, n)
+		}
+	}
+
+	switch n := node.(type) {
+	case *ShortNode:
+		if (shortForkLeft == -1 && shortForkRight == -1) || (shortForkLeft == 1 && shortForkRight == 1) {
+			return false, fmt.Errorf("%w: empty range", ErrInvalidRange)
+		}
+
+		// both the paths are not in the trie, the node is between them
+		if shortForkLeft != 0 && shortForkRight != 0 {
+			return unsetChild(parent, left, pos)
+		}
+
+		// only the right path is not in the trie
+		if shortForkRight != 0 {
+			if isLeafValue(n.child) {
+				return unsetChild(parent, left, pos)
+			}
+
+			return false, unset(n, n.child, left[pos:], len(n.key), false)
+		}
+
+		// only the left path is not in the trie
+		if shortForkLeft != 0 {
+			if isLeafValue(n.child) {
+				return unsetChild(parent, right, pos)
+			}
+
+			return false, unset(n, n.child, right[pos:], len(n.key), true)
+		}
+
+		return false, nil
+
+	case *FullNode:
+		for i := int(left[pos]) + 1; i < int(right[pos]); i++ {
+			n.children[i] = nil
+		}
+
+		if err := unset(n, n.getEdge(left[pos]), left[pos:], 1, false); err != nil {
+			return false, err
+		}
+
+		return false, unset(n, n.getEdge(right[pos]), right[pos:], 1, true)
+	}
+
+	return false, nil
+}
+
+// unsetChild removes the child of the parent on the path, or the whole trie if there is no parent
+func unsetChild(parent Node, path []byte, pos int) (bool, error) {
+	if parent == nil {
+		return true, nil
+	}
+
+	full, ok := parent.(*FullNode)
+	if !ok {
+		return false, fmt.Errorf("%w: unexpected node %T on the path", ErrInvalidRange, parent)
+	}
+
+	full.setEdge(path[pos-1], nil)
+
+	return false, nil
+}
+
+// unset removes the nodes on the left (or right) side of the path under the child
+func unset(parent Node, child Node, path []byte, pos int, removeLeft bool) error {
+	switch c := child.(type) {
+	case *FullNode:
+		if removeLeft {
+			for i := 0; i < int(path[pos]); i++ {
+				c.children[i] = nil
+			}
+		} else {
+			for i := int(path[pos]) + 1; i < 16; i++ {
+				c.children[i] = nil
+			}
+		}
+
+		return unset(c, c.getEdge(path[pos]), path, pos+1, removeLeft)
+
+	case *ShortNode:
+		if len(path[pos:]) < len(c.key) || !bytes.Equal(c.key, path[pos:pos+len(c.key)]) {
+			// the path is not in the trie, the node is removed if it is in the range
+			cmp := bytes.Compare(c.key, path[pos:])
+			if (removeLeft && cmp < 0) || (!removeLeft && cmp > 0) {
+				_, err := unsetChild(parent, path, pos)
+
+				return err
+			}
+
+			return nil
+		}
+
+		if isLeafValue(c.child) {
+			_, err := unsetChild(parent, path, pos)
+
+			return err
+		}
+
+		return unset(c, c.child, path, pos+len(c.key), removeLeft)
+
+	case nil:
+		return nil
+	}
+
+	return fmt.Errorf("%w: unexpected node %T on the path", ErrInvalidRange, child)
+}
+
+// isLeafValue returns true if the node is the value of a leaf, not a reference to another node
+func isLeafValue(node Node) bool {
+	value, ok := node.(*ValueNode)
+
+	return ok && !value.hash
+}
+
+// compareNodeKey compares the path with the key of a short node
+func compareNodeKey(path, key []byte) int {
+	if len(path) < len(key) {
+		return bytes.Compare(path, key)
+	}
+
+	return bytes.Compare(path[:len(key)], key)
+}
@@ -0,0 +1,177 @@
+package itrie
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRangeTestTrie writes a trie with the given number of entries
+// and returns its root with the sorted keys and their values
+func newRangeTestTrie(t *testing.T, storage Storage, size int) (types.Hash, [][]byte, [][]byte) {
+	t.Helper()
+
+	keys := make([][]byte, size)
+	for i := range keys {
+		keys[i] = crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	values := make([][]byte, size)
+	for i := range values {
+		values[i] = append([]byte{0x1}, keys[i][:i%8+1]...)
+	}
+
+	return BuildTrie(storage, keys, values), keys, values
+}
+
+func TestRangeProof(t *testing.T) {
+	t.Parallel()
+
+	st := NewState(NewMemoryStorage())
+	root, keys, values := newRangeTestTrie(t, st.storage, 200)
+
+	t.Run("should download the trie by ranges", func(t *testing.T) {
+		t.Parallel()
+
+		for _, limit := range []int{1, 7, 64, 500} {
+			var (
+				origin     = make([]byte, types.HashLength)
+				downloaded = [][]byte{}
+			)
+
+			for {
+				rangeKeys, rangeValues, proof, err := st.GetRange(root, origin, limit)
+				assert.NoError(t, err)
+
+				more, err := VerifyRangeProof(root, origin, rangeKeys, rangeValues, proof)
+				assert.NoError(t, err)
+
+				downloaded = append(downloaded, rangeKeys...)
+
+				if !more {
+					break
+				}
+
+				origin = incrementKey(rangeKeys[len(rangeKeys)-1])
+			}
+
+			assert.Equal(t, keys, downloaded, "limit %d", limit)
+		}
+	})
+
+	t.Run("should prove a range starting at a key", func(t *testing.T) {
+		t.Parallel()
+
+		for _, limit := range []int{1, 10} {
+			rangeKeys, rangeValues, proof, err := st.GetRange(root, keys[50], limit)
+			assert.NoError(t, err)
+			assert.Equal(t, keys[50:50+limit], rangeKeys)
+			assert.Equal(t, values[50:50+limit], rangeValues)
+
+			more, err := VerifyRangeProof(root, keys[50], rangeKeys, rangeValues, proof)
+			assert.NoError(t, err)
+			assert.True(t, more)
+		}
+	})
+
+	t.Run("should prove there is no entry after the origin", func(t *testing.T) {
+		t.Parallel()
+
+		origin := incrementKey(keys[len(keys)-1])
+
+		rangeKeys, rangeValues, proof, err := st.GetRange(root, origin, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, rangeKeys)
+
+		more, err := VerifyRangeProof(root, origin, rangeKeys, rangeValues, proof)
+		assert.NoError(t, err)
+		assert.False(t, more)
+
+		// the entries after the origin can't be hidden
+		_, _, proof, err = st.GetRange(root, keys[150], 10)
+		assert.NoError(t, err)
+
+		_, err = VerifyRangeProof(root, keys[150], [][]byte{}, [][]byte{}, proof)
+		assert.ErrorIs(t, err, ErrInvalidRange)
+	})
+
+	t.Run("should verify the whole trie without proof", func(t *testing.T) {
+		t.Parallel()
+
+		more, err := VerifyRangeProof(root, nil, keys, values, nil)
+		assert.NoError(t, err)
+		assert.False(t, more)
+
+		_, err = VerifyRangeProof(root, nil, keys[1:], values[1:], nil)
+		assert.ErrorIs(t, err, ErrRangeRootMismatch)
+
+		more, err = VerifyRangeProof(types.EmptyRootHash, nil, [][]byte{}, [][]byte{}, nil)
+		assert.NoError(t, err)
+		assert.False(t, more)
+	})
+
+	t.Run("should reject tampered ranges", func(t *testing.T) {
+		t.Parallel()
+
+		origin := keys[20]
+
+		rangeKeys, rangeValues, proof, err := st.GetRange(root, origin, 30)
+		assert.NoError(t, err)
+
+		copyRange := func() ([][]byte, [][]byte) {
+			return append([][]byte{}, rangeKeys...), append([][]byte{}, rangeValues...)
+		}
+
+		// missing entry in the middle
+		tamperedKeys, tamperedValues := copyRange()
+		tamperedKeys = append(tamperedKeys[:10], tamperedKeys[11:]...)
+		tamperedValues = append(tamperedValues[:10], tamperedValues[11:]...)
+
+		_, err = VerifyRangeProof(root, origin, tamperedKeys, tamperedValues, proof)
+		assert.ErrorIs(t, err, ErrRangeRootMismatch)
+
+		// missing first entry
+		tamperedKeys, tamperedValues = copyRange()
+
+		_, err = VerifyRangeProof(root, origin, tamperedKeys[1:], tamperedValues[1:], proof)
+		assert.ErrorIs(t, err, ErrRangeRootMismatch)
+
+		// modified value
+		tamperedKeys, tamperedValues = copyRange()
+		tamperedValues[5] = []byte{0x2}
+
+		_, err = VerifyRangeProof(root, origin, tamperedKeys, tamperedValues, proof)
+		assert.ErrorIs(t, err, ErrRangeRootMismatch)
+
+		// keys out of order
+		tamperedKeys, tamperedValues = copyRange()
+		tamperedKeys[3], tamperedKeys[4] = tamperedKeys[4], tamperedKeys[3]
+
+		_, err = VerifyRangeProof(root, origin, tamperedKeys, tamperedValues, proof)
+		assert.ErrorIs(t, err, ErrInvalidRange)
+
+		// incomplete proof
+		_, err = VerifyRangeProof(root, origin, rangeKeys, rangeValues, proof[1:])
+		assert.ErrorIs(t, err, ErrIncompleteProof)
+
+		// another trie
+		_, err = VerifyRangeProof(types.StringToHash("1"), origin, rangeKeys, rangeValues, proof)
+		assert.ErrorIs(t, err, ErrIncompleteProof)
+	})
+}
+
+// incrementKey returns the key following the given one
+func incrementKey(key []byte) []byte {
+	next := new(big.Int).Add(new(big.Int).SetBytes(key), big.NewInt(1))
+
+	return types.BytesToHash(next.Bytes()).Bytes()
+}
@@ -2,6 +2,7 @@ package itrie
 
 import (
 	"fmt"
+	"sync"
 
 	lru "github.com/hashicorp/golang-lru"
 
@@ -12,14 +13,26 @@ import (
 type State struct {
 	storage Storage
 	cache   *lru.Cache
+
+	// lock prevents commits from writing nodes while the pruned nodes are deleted
+	lock sync.RWMutex
+
+	// pruneLock allows a single prune at a time
+	pruneLock sync.Mutex
+
+	// committed holds the roots committed since the last prune, which
+	// might not be referenced by the blockchain yet
+	committed     map[types.Hash]struct{}
+	committedLock sync.Mutex
 }
 
 func NewState(storage Storage) *State {
 	cache, _ := lru.New(128)
 
 	s := &State{
-		storage: storage,
-		cache:   cache,
+		storage:   storage,
+		cache:     cache,
+		committed: map[types.Hash]struct{}{},
 	}
 
 	return s
@@ -83,7 +96,7 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 	}
 
 	if !ok {
-		return nil, fmt.Errorf("state not found at hash %s", root)
+		return nil, fmt.Errorf("%w at hash %s", state.ErrStateNotFound, root)
 	}
 
 	t := &Trie{
@@ -98,3 +111,10 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 func (s *State) AddState(root types.Hash, t *Trie) {
 	s.cache.Add(root, t)
 }
+
+func (s *State) addCommitted(root types.Hash) {
+	s.committedLock.Lock()
+	defer s.committedLock.Unlock()
+
+	s.committed[root] = struct{}{}
+}
@@ -26,6 +26,8 @@ type Batch interface {
 type Storage interface {
 	Put(k, v []byte)
 	Get(k []byte) ([]byte, bool)
+	Delete(k []byte)
+	Iterate(fn func(k []byte) bool)
 	Batch() Batch
 	SetCode(hash types.Hash, code []byte)
 	GetCode(hash types.Hash) ([]byte, bool)
@@ -81,6 +83,23 @@ func (kv *KVStorage) Get(k []byte) ([]byte, bool) {
 	return data, true
 }
 
+func (kv *KVStorage) Delete(k []byte) {
+	_ = kv.db.Delete(k, nil)
+}
+
+// Iterate calls fn with every key of the storage until it returns false.
+// The key is only valid during the call
+func (kv *KVStorage) Iterate(fn func(k []byte) bool) {
+	iter := kv.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !fn(iter.Key()) {
+			return
+		}
+	}
+}
+
 func (kv *KVStorage) Close() error {
 	return kv.db.Close()
 }
@@ -123,6 +142,19 @@ func (m *memStorage) Get(p []byte) ([]byte, bool) {
 	return v, true
 }
 
+func (m *memStorage) Delete(p []byte) {
+	delete(m.db, hex.EncodeToHex(p))
+}
+
+func (m *memStorage) Iterate(fn func(k []byte) bool) {
+	for k := range m.db {
+		key, _ := hex.DecodeHex(k)
+		if !fn(key) {
+			return
+		}
+	}
+}
+
 func (m *memStorage) SetCode(hash types.Hash, code []byte) {
 	m.code[hash.String()] = code
 }
@@ -0,0 +1,73 @@
+package itrie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageEngines(t *testing.T) {
+	t.Parallel()
+
+	engines := map[string]func(path string, logger hclog.Logger) (Storage, error){
+		"leveldb": NewLevelDBStorage,
+		"pebble":  NewPebbleStorage,
+		"badger":  NewBadgerStorage,
+	}
+
+	for name, open := range engines {
+		name, open := name, open
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := t.TempDir()
+
+			storage, err := open(path, hclog.NewNullLogger())
+			assert.NoError(t, err)
+
+			storage.Put([]byte{0x1}, []byte{0x11})
+
+			batch := storage.Batch()
+			batch.Put([]byte{0x2}, []byte{0x22})
+			batch.Put([]byte{0x3}, []byte{0x33})
+			batch.Write()
+
+			storage.Delete([]byte{0x3})
+			storage.SetCode(types.StringToHash("0x4"), []byte{0x44})
+
+			value, ok := storage.Get([]byte{0x2})
+			assert.True(t, ok)
+			assert.Equal(t, []byte{0x22}, value)
+
+			_, ok = storage.Get([]byte{0x3})
+			assert.False(t, ok)
+
+			keys := [][]byte{}
+
+			storage.Iterate(func(k []byte) bool {
+				keys = append(keys, append([]byte{}, k...))
+
+				return true
+			})
+
+			assert.Len(t, keys, 3)
+			assert.True(t, bytes.Equal([]byte{0x1}, keys[0]))
+
+			assert.NoError(t, storage.Close())
+
+			// the data is persisted
+			storage, err = open(path, hclog.NewNullLogger())
+			assert.NoError(t, err)
+
+			code, ok := storage.GetCode(types.StringToHash("0x4"))
+			assert.True(t, ok)
+			assert.Equal(t, []byte{0x44}, code)
+
+			assert.NoError(t, storage.Close())
+		})
+	}
+}
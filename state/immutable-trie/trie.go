@@ -119,6 +119,9 @@ var accountArenaPool fastrlp.ArenaPool
 var stateArenaPool fastrlp.ArenaPool // TODO, Remove once we do update in fastrlp
 
 func (t *Trie) Commit(objs []*state.Object) (*Trie, []byte) {
+	t.state.lock.RLock()
+	defer t.state.lock.RUnlock()
+
 	// Create an insertion batch for all the entries
 	batch := t.storage.Batch()
 
@@ -192,6 +195,7 @@ func (t *Trie) Commit(objs []*state.Object) (*Trie, []byte) {
 	batch.Write()
 
 	t.state.AddState(types.BytesToHash(root), nTrie)
+	t.state.addCommitted(types.BytesToHash(root))
 
 	return nTrie, root
 }
@@ -98,6 +98,51 @@ func (m *mockHost) GetRefund() uint64 {
 	panic("Not implemented in tests")
 }
 
+func (m *mockHost) AddressInAccessList(addr types.Address) bool {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) AddAddressToAccessList(addr types.Address) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) GetTransientStorage(addr types.Address, key types.Hash) types.Hash {
+	panic("Not implemented in tests")
+}
+
+func (m *mockHost) SetTransientStorage(addr types.Address, key types.Hash, value types.Hash) {
+	panic("Not implemented in tests")
+}
+
+// mockHostForTransientStorage keeps the transient storage and the base fee in memory
+type mockHostForTransientStorage struct {
+	mockHost
+	baseFee   uint64
+	transient map[types.Hash]types.Hash
+}
+
+func (m *mockHostForTransientStorage) GetTxContext() runtime.TxContext {
+	return runtime.TxContext{
+		BaseFee: types.BytesToHash(new(big.Int).SetUint64(m.baseFee).Bytes()),
+	}
+}
+
+func (m *mockHostForTransientStorage) GetTransientStorage(addr types.Address, key types.Hash) types.Hash {
+	return m.transient[key]
+}
+
+func (m *mockHostForTransientStorage) SetTransientStorage(addr types.Address, key types.Hash, value types.Hash) {
+	m.transient[key] = value
+}
+
 func TestRun(t *testing.T) {
 	t.Parallel()
 
@@ -164,6 +209,104 @@ func TestRun(t *testing.T) {
 				Err:     errRevert,
 			},
 		},
+		{
+			name:  "should push zero with PUSH0",
+			value: big.NewInt(0),
+			gas:   5000,
+			code: []byte{
+				PUSH1, 0x01, PUSH0, MSTORE8,
+				PUSH1, 0x01, PUSH0, RETURN,
+			},
+			config: &chain.ForksInTime{
+				Shanghai: true,
+			},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: []uint8{0x01},
+				GasLeft:     4984,
+				GasUsed:     16,
+			},
+		},
+		{
+			name:  "should fail with PUSH0 before Shanghai",
+			value: big.NewInt(0),
+			gas:   5000,
+			code:  []byte{PUSH0},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: nil,
+				GasLeft:     0,
+				GasUsed:     5000,
+				Err:         errOpCodeNotFound,
+			},
+		},
+		{
+			name:  "should push the base fee with BASEFEE",
+			value: big.NewInt(0),
+			gas:   5000,
+			code: []byte{
+				BASEFEE, PUSH0, MSTORE8,
+				PUSH1, 0x01, PUSH0, RETURN,
+			},
+			config: &chain.ForksInTime{
+				London:   true,
+				Shanghai: true,
+			},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: []uint8{0x07},
+				GasLeft:     4985,
+				GasUsed:     15,
+			},
+		},
+		{
+			name:  "should copy memory with MCOPY",
+			value: big.NewInt(0),
+			gas:   5000,
+			code: []byte{
+				PUSH1, 0x2a, PUSH0, MSTORE8,
+				// copy 1 byte from offset 0 to offset 32
+				PUSH1, 0x01, PUSH0, PUSH1, 0x20, MCOPY,
+				PUSH1, 0x01, PUSH1, 0x20, RETURN,
+			},
+			config: &chain.ForksInTime{
+				Shanghai: true,
+				Cancun:   true,
+			},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: []uint8{0x2a},
+				GasLeft:     4966,
+				GasUsed:     34,
+			},
+		},
+		{
+			name:  "should read the transient storage written with TSTORE",
+			value: big.NewInt(0),
+			gas:   5000,
+			code: []byte{
+				PUSH1, 0x2a, PUSH1, 0x01, TSTORE,
+				PUSH1, 0x01, TLOAD, PUSH0, MSTORE8,
+				PUSH1, 0x01, PUSH0, RETURN,
+			},
+			config: &chain.ForksInTime{
+				Shanghai: true,
+				Cancun:   true,
+			},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: []uint8{0x2a},
+				GasLeft:     4778,
+				GasUsed:     222,
+			},
+		},
+		{
+			name:  "should fail with TLOAD before Cancun",
+			value: big.NewInt(0),
+			gas:   5000,
+			code:  []byte{PUSH1, 0x01, TLOAD},
+			expected: &runtime.ExecutionResult{
+				ReturnValue: nil,
+				GasLeft:     0,
+				GasUsed:     5000,
+				Err:         errOpCodeNotFound,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,7 +316,10 @@ func TestRun(t *testing.T) {
 
 			evm := NewEVM()
 			contract := newMockContract(tt.value, tt.gas, tt.code)
-			host := &mockHost{}
+			host := &mockHostForTransientStorage{
+				baseFee:   7,
+				transient: map[types.Hash]types.Hash{},
+			}
 			config := tt.config
 			if config == nil {
 				config = &chain.ForksInTime{}
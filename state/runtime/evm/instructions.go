@@ -22,6 +22,25 @@ var (
 	wordSize = big.NewInt(32)
 )
 
+// eip-2929 access costs
+const (
+	coldAccountAccessCost uint64 = 2600
+	coldSloadCost         uint64 = 2100
+	warmStorageReadCost   uint64 = 100
+)
+
+// accountAccessCost returns the cost of accessing the given account and
+// adds it to the access list if it was not there yet (eip-2929)
+func (c *state) accountAccessCost(addr types.Address) uint64 {
+	if c.host.AddressInAccessList(addr) {
+		return warmStorageReadCost
+	}
+
+	c.host.AddAddressToAccessList(addr)
+
+	return coldAccountAccessCost
+}
+
 func opAdd(c *state) {
 	a := c.pop()
 	b := c.top()
@@ -459,13 +478,85 @@ func opMStore8(c *state) {
 	c.memory[offset.Uint64()] = byte(val.Uint64() & 0xff)
 }
 
+func opMCopy(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	dstOffset := c.pop()
+	srcOffset := c.pop()
+	length := c.pop()
+
+	// eip-5656
+	if !c.allocateMemory(srcOffset, length) || !c.allocateMemory(dstOffset, length) {
+		return
+	}
+
+	size := length.Uint64()
+	if !c.consumeGas(((size + 31) / 32) * copyGas) {
+		return
+	}
+
+	if size != 0 {
+		src := srcOffset.Uint64()
+		dst := dstOffset.Uint64()
+
+		copy(c.memory[dst:dst+size], c.memory[src:src+size])
+	}
+}
+
+// --- transient storage ---
+
+func opTload(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	loc := c.top()
+
+	val := c.host.GetTransientStorage(c.msg.Address, bigToHash(loc))
+	loc.SetBytes(val.Bytes())
+}
+
+func opTstore(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	if c.inStaticCall() {
+		c.exit(errWriteProtection)
+
+		return
+	}
+
+	key := c.popHash()
+	val := c.popHash()
+
+	c.host.SetTransientStorage(c.msg.Address, key, val)
+}
+
 // --- storage ---
 
 func opSload(c *state) {
 	loc := c.top()
+	key := bigToHash(loc)
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		if _, slotOk := c.host.SlotInAccessList(c.msg.Address, key); slotOk {
+			gas = warmStorageReadCost
+		} else {
+			c.host.AddSlotToAccessList(c.msg.Address, key)
+			gas = coldSloadCost
+		}
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 800
 	} else if c.config.EIP150 {
@@ -478,7 +569,7 @@ func opSload(c *state) {
 		return
 	}
 
-	val := c.host.GetStorage(c.msg.Address, bigToHash(loc))
+	val := c.host.GetStorage(c.msg.Address, key)
 	loc.SetBytes(val.Bytes())
 }
 
@@ -500,12 +591,23 @@ func opSStore(c *state) {
 
 	legacyGasMetering := !c.config.Istanbul && (c.config.Petersburg || !c.config.Constantinople)
 
-	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
 	cost := uint64(0)
 
+	if c.config.Berlin {
+		// eip-2929
+		if _, slotOk := c.host.SlotInAccessList(c.msg.Address, key); !slotOk {
+			c.host.AddSlotToAccessList(c.msg.Address, key)
+			cost = coldSloadCost
+		}
+	}
+
+	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
+
 	switch status {
 	case runtime.StorageUnchanged:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -515,10 +617,16 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageModified:
-		cost = 5000
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 
 	case runtime.StorageModifiedAgain:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -528,10 +636,14 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageAdded:
-		cost = 20000
+		cost += 20000
 
 	case runtime.StorageDeleted:
-		cost = 5000
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 	}
 
 	if !c.consumeGas(cost) {
@@ -575,7 +687,10 @@ func opBalance(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(addr)
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 700
 	} else if c.config.EIP150 {
@@ -658,7 +773,10 @@ func opExtCodeSize(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -693,7 +811,10 @@ func opExtCodeHash(c *state) {
 	address, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(address)
+	} else if c.config.Istanbul {
 		gas = 700
 	} else {
 		gas = 400
@@ -767,7 +888,10 @@ func opExtCodeCopy(c *state) {
 	}
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(address)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -905,6 +1029,16 @@ func opGasLimit(c *state) {
 	c.push1().SetInt64(c.host.GetTxContext().GasLimit)
 }
 
+func opBaseFee(c *state) {
+	if !c.config.London {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	c.push1().SetBytes(c.host.GetTxContext().BaseFee.Bytes())
+}
+
 func opSelfDestruct(c *state) {
 	if c.inStaticCall() {
 		c.exit(errWriteProtection)
@@ -931,6 +1065,12 @@ func opSelfDestruct(c *state) {
 		}
 	}
 
+	// eip-2929
+	if c.config.Berlin && !c.host.AddressInAccessList(address) {
+		c.host.AddAddressToAccessList(address)
+		gas += coldAccountAccessCost
+	}
+
 	if !c.consumeGas(gas) {
 		return
 	}
@@ -963,6 +1103,16 @@ func opJumpi(c *state) {
 func opJumpDest(c *state) {
 }
 
+func opPush0(c *state) {
+	if !c.config.Shanghai {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	c.push1().Set(zero)
+}
+
 func opPush(n int) instruction {
 	return func(c *state) {
 		ins := c.code
@@ -1082,6 +1232,9 @@ func opCreate(op OpCode) instruction {
 		}
 
 		contract.Type = runtime.Create
+		if op == CREATE2 {
+			contract.Type = runtime.Create2
+		}
 
 		// Correct call
 		result := c.host.Callx(contract, c.host)
@@ -1212,7 +1365,10 @@ func (c *state) buildCallContract(op OpCode) (*runtime.Contract, uint64, uint64,
 	}
 
 	var gasCost uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gasCost = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gasCost = 700
 	} else {
 		gasCost = 40
@@ -574,3 +574,113 @@ func Test_opReturnDataCopy(t *testing.T) {
 		})
 	}
 }
+
+type mockHostForAccessList struct {
+	mockHost
+	addresses map[types.Address]struct{}
+	slots     map[types.Address]map[types.Hash]struct{}
+}
+
+func newMockHostForAccessList() *mockHostForAccessList {
+	return &mockHostForAccessList{
+		addresses: map[types.Address]struct{}{},
+		slots:     map[types.Address]map[types.Hash]struct{}{},
+	}
+}
+
+func (m *mockHostForAccessList) GetBalance(types.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+func (m *mockHostForAccessList) GetStorage(types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+func (m *mockHostForAccessList) AddressInAccessList(addr types.Address) bool {
+	_, ok := m.addresses[addr]
+
+	return ok
+}
+
+func (m *mockHostForAccessList) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	_, addrOk := m.addresses[addr]
+	_, slotOk := m.slots[addr][slot]
+
+	return addrOk, slotOk
+}
+
+func (m *mockHostForAccessList) AddAddressToAccessList(addr types.Address) {
+	m.addresses[addr] = struct{}{}
+}
+
+func (m *mockHostForAccessList) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	m.AddAddressToAccessList(addr)
+
+	if _, ok := m.slots[addr]; !ok {
+		m.slots[addr] = map[types.Hash]struct{}{}
+	}
+
+	m.slots[addr][slot] = struct{}{}
+}
+
+func TestAccessListGas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		op       instruction
+		arg      *big.Int
+		berlin   bool
+		expected []uint64
+	}{
+		{
+			name:     "sload cold then warm",
+			op:       opSload,
+			arg:      big.NewInt(1),
+			berlin:   true,
+			expected: []uint64{coldSloadCost, warmStorageReadCost},
+		},
+		{
+			name:     "balance cold then warm",
+			op:       opBalance,
+			arg:      new(big.Int).SetBytes(addr1.Bytes()),
+			berlin:   true,
+			expected: []uint64{coldAccountAccessCost, warmStorageReadCost},
+		},
+		{
+			name:     "sload before berlin",
+			op:       opSload,
+			arg:      big.NewInt(1),
+			berlin:   false,
+			expected: []uint64{800, 800},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, closeFn := getState()
+			defer closeFn()
+
+			config := allEnabledForks
+			config.Berlin = tt.berlin
+
+			s.config = &config
+			s.host = newMockHostForAccessList()
+			s.msg = &runtime.Contract{Address: addr1}
+
+			for _, expected := range tt.expected {
+				s.gas = 10000
+				s.push(new(big.Int).Set(tt.arg))
+
+				tt.op(s)
+
+				assert.Equal(t, 10000-expected, s.gas)
+				s.pop()
+			}
+		})
+	}
+}
@@ -161,6 +161,9 @@ const (
 	// SELFBALANCE returns the balance of the current account
 	SELFBALANCE = 0x47
 
+	// BASEFEE returns the base fee of the current block
+	BASEFEE = 0x48
+
 	// POP pops a (u)int256 off the stack and discards it
 	POP = 0x50
 
@@ -197,6 +200,18 @@ const (
 	// JUMPDEST corresponds to a possible jump destination
 	JUMPDEST = 0x5B
 
+	// TLOAD reads a (u)int256 from transient storage
+	TLOAD = 0x5C
+
+	// TSTORE writes a (u)int256 to transient storage
+	TSTORE = 0x5D
+
+	// MCOPY copies an area of memory to another
+	MCOPY = 0x5E
+
+	// PUSH0 pushes a zero value onto the stack
+	PUSH0 = 0x5F
+
 	// PUSH1 pushes a 1-byte value onto the stack
 	PUSH1 = 0x60
 
@@ -331,6 +346,11 @@ var opCodeToString = map[OpCode]string{
 	SELFDESTRUCT:   "SELFDESTRUCT",
 	CHAINID:        "CHAINID",
 	SELFBALANCE:    "SELFBALANCE",
+	BASEFEE:        "BASEFEE",
+	TLOAD:          "TLOAD",
+	TSTORE:         "TSTORE",
+	MCOPY:          "MCOPY",
+	PUSH0:          "PUSH0",
 }
 
 func opCodesToString(from, to OpCode, str string) {
@@ -45,6 +45,16 @@ func (p *Precompiled) setupContracts() {
 	p.register("9", &blake2f{p})
 }
 
+// Addresses returns the addresses of all the precompiled contracts
+func (p *Precompiled) Addresses() []types.Address {
+	addrs := make([]types.Address, 0, len(p.contracts))
+	for addr := range p.contracts {
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
+
 func (p *Precompiled) register(addrStr string, b contract) {
 	if len(p.contracts) == 0 {
 		p.contracts = map[types.Address]contract{}
@@ -19,6 +19,7 @@ type TxContext struct {
 	GasLimit   int64
 	ChainID    int64
 	Difficulty types.Hash
+	BaseFee    types.Hash
 	Tracer     tracer.Tracer
 }
 
@@ -73,6 +74,12 @@ type Host interface {
 	GetNonce(addr types.Address) uint64
 	GetTracer() VMTracer
 	GetRefund() uint64
+	AddressInAccessList(addr types.Address) bool
+	SlotInAccessList(addr types.Address, slot types.Hash) (addressOk bool, slotOk bool)
+	AddAddressToAccessList(addr types.Address)
+	AddSlotToAccessList(addr types.Address, slot types.Hash)
+	GetTransientStorage(addr types.Address, key types.Hash) types.Hash
+	SetTransientStorage(addr types.Address, key types.Hash, value types.Hash)
 }
 
 type VMTracer interface {
@@ -105,6 +112,8 @@ type ExecutionResult struct {
 	GasLeft     uint64 // Total gas left as result of execution
 	GasUsed     uint64 // Total gas used as result of execution
 	Err         error  // Any error encountered during the execution, listed below
+
+	AccessList types.TxAccessList // Accounts and storage slots accessed by the transaction (EIP-2929)
 }
 
 func (r *ExecutionResult) Succeeded() bool { return r.Err == nil }
@@ -0,0 +1,352 @@
+package calltracer
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/umbracle/ethgo/abi"
+)
+
+type Config struct {
+	OnlyTopCall bool `json:"onlyTopCall"` // trace only the top-level call
+	WithLog     bool `json:"withLog"`     // include the logs emitted by the calls
+}
+
+// CallFrame is a call in the call tree
+type CallFrame struct {
+	Type         string         `json:"type"`
+	From         types.Address  `json:"from"`
+	To           *types.Address `json:"to,omitempty"`
+	Value        string         `json:"value,omitempty"`
+	Gas          string         `json:"gas"`
+	GasUsed      string         `json:"gasUsed"`
+	Input        string         `json:"input"`
+	Output       string         `json:"output,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+	Calls        []*CallFrame   `json:"calls,omitempty"`
+	Logs         []*CallLog     `json:"logs,omitempty"`
+}
+
+// CallLog is a log emitted by a call
+type CallLog struct {
+	Address types.Address `json:"address"`
+	Topics  []types.Hash  `json:"topics"`
+	Data    string        `json:"data"`
+}
+
+// pendingLog is a log captured before its opcode is executed
+type pendingLog struct {
+	address types.Address
+	topics  []types.Hash
+	data    []byte
+	size    uint64
+}
+
+// CallTracer records the tree of the calls made by a transaction
+type CallTracer struct {
+	Config Config
+
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	gasLimit uint64
+
+	// root is the top-level call, calls are the calls in progress
+	root  *CallFrame
+	calls []*CallFrame
+
+	pendingLog *pendingLog
+}
+
+func NewCallTracer(config Config) *CallTracer {
+	return &CallTracer{
+		Config:     config,
+		cancelLock: sync.RWMutex{},
+	}
+}
+
+func (t *CallTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *CallTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+// cancelReason returns the error the tracer was cancelled with, if any
+func (t *CallTracer) cancelReason() error {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.reason
+}
+
+func (t *CallTracer) Clear() {
+	t.cancelLock.Lock()
+	t.reason = nil
+	t.interrupt = false
+	t.cancelLock.Unlock()
+
+	t.gasLimit = 0
+	t.root = nil
+	t.calls = nil
+	t.pendingLog = nil
+}
+
+func (t *CallTracer) TxStart(tx *types.Transaction, host tracer.RuntimeHost) {
+	t.gasLimit = tx.Gas
+
+	t.root = &CallFrame{
+		Type:  "CALL",
+		From:  tx.From,
+		To:    tx.To,
+		Gas:   hex.EncodeUint64(tx.Gas),
+		Input: hex.EncodeToHex(tx.Input),
+	}
+
+	if tx.IsContractCreation() {
+		to := crypto.CreateAddress(tx.From, host.GetNonce(tx.From))
+
+		t.root.Type = "CREATE"
+		t.root.To = &to
+	}
+
+	if tx.Value != nil {
+		t.root.Value = hex.EncodeBig(tx.Value)
+	}
+
+	t.calls = []*CallFrame{t.root}
+}
+
+func (t *CallTracer) TxEnd(gasLeft uint64) {
+	if t.root == nil {
+		return
+	}
+
+	t.root.GasUsed = hex.EncodeUint64(t.gasLimit - gasLeft)
+}
+
+func (t *CallTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	// the top-level call is built from the transaction
+	if depth == 1 || t.Config.OnlyTopCall || len(t.calls) == 0 {
+		return
+	}
+
+	frame := &CallFrame{
+		Type:  callTypeName(callType),
+		From:  from,
+		To:    &to,
+		Gas:   hex.EncodeUint64(gas),
+		Input: hex.EncodeToHex(input),
+	}
+
+	if value != nil && callType != int(runtime.StaticCall) {
+		frame.Value = hex.EncodeBig(value)
+	}
+
+	parent := t.calls[len(t.calls)-1]
+	parent.Calls = append(parent.Calls, frame)
+
+	t.calls = append(t.calls, frame)
+}
+
+func (t *CallTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasUsed uint64,
+	err error,
+) {
+	if depth > 1 && t.Config.OnlyTopCall {
+		return
+	}
+
+	if len(t.calls) == 0 {
+		return
+	}
+
+	frame := t.calls[len(t.calls)-1]
+
+	if depth > 1 {
+		frame.GasUsed = hex.EncodeUint64(gasUsed)
+		t.calls = t.calls[:len(t.calls)-1]
+	}
+
+	setCallResult(frame, output, err)
+}
+
+func (t *CallTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	t.pendingLog = nil
+
+	if !t.Config.WithLog || opCode < evm.LOG0 || opCode > evm.LOG4 {
+		return
+	}
+
+	topicsCount := opCode - evm.LOG0
+	if sp < 2+topicsCount {
+		return
+	}
+
+	offset, size := stack[sp-1], stack[sp-2]
+	if !offset.IsUint64() || !size.IsUint64() {
+		return
+	}
+
+	topics := make([]types.Hash, topicsCount)
+	for i := range topics {
+		topics[i] = types.BytesToHash(stack[sp-3-i].Bytes())
+	}
+
+	// the memory is not expanded yet, data beyond its end is zero
+	var data []byte
+	if start := offset.Uint64(); start < uint64(len(memory)) {
+		end := start + size.Uint64()
+		if end > uint64(len(memory)) || end < start {
+			end = uint64(len(memory))
+		}
+
+		data = append(data, memory[start:end]...)
+	}
+
+	t.pendingLog = &pendingLog{
+		address: contractAddress,
+		topics:  topics,
+		data:    data,
+		size:    size.Uint64(),
+	}
+}
+
+func (t *CallTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opCode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+	log := t.pendingLog
+	t.pendingLog = nil
+
+	if log == nil || err != nil || len(t.calls) == 0 {
+		return
+	}
+
+	if depth > 1 && t.Config.OnlyTopCall {
+		return
+	}
+
+	// the log has been emitted so the memory could be expanded to its size
+	data := make([]byte, log.size)
+	copy(data, log.data)
+
+	frame := t.calls[len(t.calls)-1]
+	frame.Logs = append(frame.Logs, &CallLog{
+		Address: log.address,
+		Topics:  log.topics,
+		Data:    hex.EncodeToHex(data),
+	})
+}
+
+func (t *CallTracer) GetResult() (interface{}, error) {
+	if reason := t.cancelReason(); reason != nil {
+		return nil, reason
+	}
+
+	if t.root == nil {
+		return nil, errors.New("no transaction traced")
+	}
+
+	clearFailedLogs(t.root, false)
+
+	return t.root, nil
+}
+
+// setCallResult sets the output and the error of the finished call
+func setCallResult(frame *CallFrame, output []byte, err error) {
+	if err == nil {
+		frame.Output = hex.EncodeToHex(output)
+
+		return
+	}
+
+	frame.Error = err.Error()
+
+	if !errors.Is(err, runtime.ErrExecutionReverted) {
+		return
+	}
+
+	frame.Output = hex.EncodeToHex(output)
+
+	if reason, unpackErr := abi.UnpackRevertError(output); unpackErr == nil {
+		frame.RevertReason = reason
+	}
+}
+
+// clearFailedLogs removes the logs of the failed calls and their subcalls,
+// as they are reverted
+func clearFailedLogs(frame *CallFrame, parentFailed bool) {
+	failed := parentFailed || frame.Error != ""
+	if failed {
+		frame.Logs = nil
+	}
+
+	for _, call := range frame.Calls {
+		clearFailedLogs(call, failed)
+	}
+}
+
+func callTypeName(callType int) string {
+	switch callType {
+	case int(runtime.CallCode):
+		return "CALLCODE"
+	case int(runtime.DelegateCall):
+		return "DELEGATECALL"
+	case int(runtime.StaticCall):
+		return "STATICCALL"
+	case evm.CREATE:
+		return "CREATE"
+	case evm.CREATE2:
+		return "CREATE2"
+	default:
+		return "CALL"
+	}
+}
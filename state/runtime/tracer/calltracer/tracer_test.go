@@ -0,0 +1,263 @@
+package calltracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testFrom  = types.StringToAddress("1")
+	testTo    = types.StringToAddress("2")
+	testOther = types.StringToAddress("3")
+
+	testTopic = types.StringToHash("4")
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+type mockHost struct {
+	nonce uint64
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+func (m *mockHost) GetBalance(types.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(types.Address) uint64 {
+	return m.nonce
+}
+
+func (m *mockHost) GetCode(types.Address) []byte {
+	return nil
+}
+
+// revertOutput returns the output of a revert with the given reason
+func revertOutput(reason string) []byte {
+	output := hex.MustDecodeHex("0x08c379a0")
+	output = append(output, types.BytesToHash([]byte{0x20}).Bytes()...)
+	output = append(output, types.BytesToHash([]byte{byte(len(reason))}).Bytes()...)
+	output = append(output, types.BytesToHash(nil).Bytes()...)
+	copy(output[len(output)-32:], reason)
+
+	return output
+}
+
+// emitLog simulates the execution of a LOG1 opcode
+func emitLog(tracer *CallTracer, address types.Address, data []byte, depth int, err error) {
+	memory := append([]byte{}, data...)
+	stack := []*big.Int{
+		new(big.Int).SetBytes(testTopic.Bytes()),
+		big.NewInt(int64(len(data))),
+		big.NewInt(0),
+	}
+
+	tracer.CaptureState(memory, stack, evm.LOG1, address, len(stack), &mockHost{}, &mockState{})
+	tracer.ExecuteState(address, 0, "LOG1", 0, 0, nil, depth, err, &mockHost{})
+}
+
+func TestCallTracer_CallTree(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewCallTracer(Config{WithLog: true})
+
+	tracer.TxStart(&types.Transaction{
+		From:  testFrom,
+		To:    &testTo,
+		Gas:   100000,
+		Value: big.NewInt(1),
+		Input: []byte{0x1},
+	}, &mockHost{})
+
+	tracer.CallStart(1, testFrom, testTo, int(runtime.Call), 79000, big.NewInt(1), []byte{0x1})
+	emitLog(tracer, testTo, []byte{0x2}, 1, nil)
+
+	// a successful subcall
+	tracer.CallStart(2, testTo, testOther, int(runtime.StaticCall), 5000, big.NewInt(0), []byte{0x3})
+	tracer.CallEnd(2, []byte{0x4}, 1000, nil)
+
+	// a reverted subcall whose logs are discarded
+	tracer.CallStart(2, testTo, testOther, evm.CREATE2, 6000, big.NewInt(2), nil)
+	emitLog(tracer, testOther, []byte{0x5}, 2, nil)
+	tracer.CallEnd(2, revertOutput("reason"), 2000, runtime.ErrExecutionReverted)
+
+	tracer.CallEnd(1, []byte{0x6}, 30000, nil)
+	tracer.TxEnd(40000)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, &CallFrame{
+		Type:    "CALL",
+		From:    testFrom,
+		To:      &testTo,
+		Value:   "0x1",
+		Gas:     "0x186a0",
+		GasUsed: "0xea60",
+		Input:   "0x01",
+		Output:  "0x06",
+		Calls: []*CallFrame{
+			{
+				Type:    "STATICCALL",
+				From:    testTo,
+				To:      &testOther,
+				Gas:     "0x1388",
+				GasUsed: "0x3e8",
+				Input:   "0x03",
+				Output:  "0x04",
+			},
+			{
+				Type:         "CREATE2",
+				From:         testTo,
+				To:           &testOther,
+				Value:        "0x2",
+				Gas:          "0x1770",
+				GasUsed:      "0x7d0",
+				Input:        "0x",
+				Output:       hex.EncodeToHex(revertOutput("reason")),
+				Error:        runtime.ErrExecutionReverted.Error(),
+				RevertReason: "reason",
+			},
+		},
+		Logs: []*CallLog{
+			{
+				Address: testTo,
+				Topics:  []types.Hash{testTopic},
+				Data:    "0x02",
+			},
+		},
+	}, res)
+}
+
+func TestCallTracer_OnlyTopCall(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewCallTracer(Config{OnlyTopCall: true, WithLog: true})
+
+	tracer.TxStart(&types.Transaction{
+		From: testFrom,
+		Gas:  100000,
+	}, &mockHost{nonce: 1})
+
+	tracer.CallStart(1, testFrom, testTo, evm.CREATE, 79000, nil, nil)
+
+	tracer.CallStart(2, testTo, testOther, int(runtime.Call), 5000, nil, nil)
+	emitLog(tracer, testOther, []byte{0x1}, 2, nil)
+	tracer.CallEnd(2, nil, 1000, nil)
+
+	tracer.CallEnd(1, nil, 30000, errors.New("out of gas"))
+	tracer.TxEnd(0)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	frame, ok := res.(*CallFrame)
+	assert.True(t, ok)
+
+	assert.Equal(t, "CREATE", frame.Type)
+	assert.Equal(t, "0x186a0", frame.GasUsed)
+	assert.Equal(t, "out of gas", frame.Error)
+	assert.Empty(t, frame.Output)
+	assert.Empty(t, frame.Calls)
+	assert.Empty(t, frame.Logs)
+
+	// the created address is derived from the nonce of the sender
+	assert.NotNil(t, frame.To)
+	assert.NotEqual(t, testTo, *frame.To)
+}
+
+func TestCallTracer_LogNotEmitted(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewCallTracer(Config{WithLog: true})
+
+	tracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, &mockHost{})
+
+	// the data beyond the end of the memory is zero
+	memory := []byte{0x1}
+	stack := []*big.Int{big.NewInt(2), big.NewInt(0)}
+
+	tracer.CaptureState(memory, stack, evm.LOG0, testTo, len(stack), &mockHost{}, &mockState{})
+	tracer.ExecuteState(testTo, 0, "LOG0", 0, 0, nil, 1, nil, &mockHost{})
+
+	// the log is not recorded if the opcode fails
+	emitLog(tracer, testTo, []byte{0x2}, 1, errors.New("out of gas"))
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*CallLog{
+		{
+			Address: testTo,
+			Topics:  []types.Hash{},
+			Data:    "0x0100",
+		},
+	}, res.(*CallFrame).Logs)
+}
+
+func TestCallTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+
+	var callTracer tracer.Tracer = NewCallTracer(Config{})
+
+	state := &mockState{}
+
+	callTracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, &mockHost{})
+	callTracer.Cancel(err)
+
+	callTracer.CaptureState(nil, nil, evm.ADD, testTo, 0, &mockHost{}, state)
+	assert.True(t, state.halted)
+
+	res, resErr := callTracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+}
+
+func TestCallTracer_CancelWhileGettingResult(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+
+	callTracer := NewCallTracer(Config{})
+	callTracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, &mockHost{})
+
+	done := make(chan struct{})
+
+	// the tracer is cancelled by the timer of the endpoint while the result is read
+	go func() {
+		defer close(done)
+
+		callTracer.Cancel(err)
+	}()
+
+	_, _ = callTracer.GetResult()
+
+	<-done
+
+	res, resErr := callTracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+}
@@ -0,0 +1,320 @@
+package flattracer
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	callTraceType    = "call"
+	createTraceType  = "create"
+	suicideTraceType = "suicide"
+)
+
+// Trace is a call made by a transaction, in the format of the OpenEthereum trace module
+type Trace struct {
+	Action              Action      `json:"action"`
+	BlockHash           *types.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64     `json:"blockNumber,omitempty"`
+	Error               string      `json:"error,omitempty"`
+	Result              *Result     `json:"result"`
+	Subtraces           int         `json:"subtraces"`
+	TraceAddress        []int       `json:"traceAddress"`
+	TransactionHash     *types.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64     `json:"transactionPosition,omitempty"`
+	Type                string      `json:"type"`
+
+	// calls are the subtraces, flattened in the result
+	calls []*Trace
+	// created is the address of the contract created by the call
+	created types.Address
+}
+
+// Action contains the arguments of a call, a creation or a self destruct
+type Action struct {
+	CallType      string         `json:"callType,omitempty"`
+	From          *types.Address `json:"from,omitempty"`
+	To            *types.Address `json:"to,omitempty"`
+	Gas           string         `json:"gas,omitempty"`
+	Input         string         `json:"input,omitempty"`
+	Init          string         `json:"init,omitempty"`
+	Value         string         `json:"value,omitempty"`
+	Address       *types.Address `json:"address,omitempty"`
+	RefundAddress *types.Address `json:"refundAddress,omitempty"`
+	Balance       string         `json:"balance,omitempty"`
+}
+
+// Result contains the result of a successful call or creation
+type Result struct {
+	GasUsed string         `json:"gasUsed"`
+	Output  string         `json:"output,omitempty"`
+	Address *types.Address `json:"address,omitempty"`
+	Code    string         `json:"code,omitempty"`
+}
+
+// pendingSuicide is a self destruct captured before its opcode is executed
+type pendingSuicide struct {
+	address     types.Address
+	beneficiary types.Address
+	balance     *big.Int
+}
+
+// FlatTracer records the calls made by a transaction as a flat list of traces
+type FlatTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	// root is the top-level call, calls are the calls in progress
+	root  *Trace
+	calls []*Trace
+
+	pendingSuicide *pendingSuicide
+}
+
+func NewFlatTracer() *FlatTracer {
+	return &FlatTracer{
+		cancelLock: sync.RWMutex{},
+	}
+}
+
+func (t *FlatTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *FlatTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+// cancelReason returns the error the tracer was cancelled with, if any
+func (t *FlatTracer) cancelReason() error {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.reason
+}
+
+func (t *FlatTracer) Clear() {
+	t.cancelLock.Lock()
+	t.reason = nil
+	t.interrupt = false
+	t.cancelLock.Unlock()
+
+	t.root = nil
+	t.calls = nil
+	t.pendingSuicide = nil
+}
+
+func (t *FlatTracer) TxStart(tx *types.Transaction, host tracer.RuntimeHost) {
+}
+
+func (t *FlatTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *FlatTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	trace := &Trace{
+		Action: Action{
+			From:  &from,
+			Gas:   hex.EncodeUint64(gas),
+			Value: hex.EncodeBig(value),
+		},
+		TraceAddress: []int{},
+	}
+
+	if callType == evm.CREATE || callType == evm.CREATE2 {
+		trace.Type = createTraceType
+		trace.Action.Init = hex.EncodeToHex(input)
+		trace.created = to
+	} else {
+		trace.Type = callTraceType
+		trace.Action.CallType = callTypeName(callType)
+		trace.Action.To = &to
+		trace.Action.Input = hex.EncodeToHex(input)
+	}
+
+	if len(t.calls) == 0 {
+		t.root = trace
+	} else {
+		parent := t.calls[len(t.calls)-1]
+		parent.calls = append(parent.calls, trace)
+	}
+
+	t.calls = append(t.calls, trace)
+}
+
+func (t *FlatTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasUsed uint64,
+	err error,
+) {
+	if len(t.calls) == 0 {
+		return
+	}
+
+	trace := t.calls[len(t.calls)-1]
+	t.calls = t.calls[:len(t.calls)-1]
+
+	if err != nil {
+		trace.Error = errorString(err)
+
+		return
+	}
+
+	trace.Result = &Result{
+		GasUsed: hex.EncodeUint64(gasUsed),
+	}
+
+	if trace.Type == createTraceType {
+		trace.Result.Address = &trace.created
+		trace.Result.Code = hex.EncodeToHex(output)
+	} else {
+		trace.Result.Output = hex.EncodeToHex(output)
+	}
+}
+
+func (t *FlatTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	t.pendingSuicide = nil
+
+	if opCode != evm.SELFDESTRUCT || sp < 1 {
+		return
+	}
+
+	t.pendingSuicide = &pendingSuicide{
+		address:     contractAddress,
+		beneficiary: types.BytesToAddress(stack[sp-1].Bytes()),
+		balance:     host.GetBalance(contractAddress),
+	}
+}
+
+func (t *FlatTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opCode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+	suicide := t.pendingSuicide
+	t.pendingSuicide = nil
+
+	if suicide == nil || err != nil || len(t.calls) == 0 {
+		return
+	}
+
+	parent := t.calls[len(t.calls)-1]
+	parent.calls = append(parent.calls, &Trace{
+		Type: suicideTraceType,
+		Action: Action{
+			Address:       &suicide.address,
+			RefundAddress: &suicide.beneficiary,
+			Balance:       hex.EncodeBig(suicide.balance),
+		},
+		TraceAddress: []int{},
+	})
+}
+
+// GetResult returns the traces of the calls in depth-first order
+func (t *FlatTracer) GetResult() (interface{}, error) {
+	if reason := t.cancelReason(); reason != nil {
+		return nil, reason
+	}
+
+	traces := []*Trace{}
+	if t.root != nil {
+		traces = flatten(t.root, []int{}, traces)
+	}
+
+	return traces, nil
+}
+
+// flatten appends the trace and its subtraces to the list
+func flatten(trace *Trace, traceAddress []int, traces []*Trace) []*Trace {
+	flat := *trace
+	flat.TraceAddress = traceAddress
+	flat.Subtraces = len(trace.calls)
+	flat.calls = nil
+
+	traces = append(traces, &flat)
+
+	for i, call := range trace.calls {
+		address := make([]int, len(traceAddress)+1)
+		copy(address, traceAddress)
+		address[len(traceAddress)] = i
+
+		traces = flatten(call, address, traces)
+	}
+
+	return traces
+}
+
+func callTypeName(callType int) string {
+	switch callType {
+	case int(runtime.CallCode):
+		return "callcode"
+	case int(runtime.DelegateCall):
+		return "delegatecall"
+	case int(runtime.StaticCall):
+		return "staticcall"
+	default:
+		return "call"
+	}
+}
+
+// errorString returns the error message used by the OpenEthereum traces
+func errorString(err error) string {
+	switch {
+	case errors.Is(err, runtime.ErrExecutionReverted):
+		return "Reverted"
+	case errors.Is(err, runtime.ErrOutOfGas), errors.Is(err, runtime.ErrCodeStoreOutOfGas):
+		return "Out of gas"
+	case errors.Is(err, runtime.ErrStackUnderflow):
+		return "Stack underflow"
+	case errors.Is(err, runtime.ErrStackOverflow):
+		return "Out of stack"
+	default:
+		return err.Error()
+	}
+}
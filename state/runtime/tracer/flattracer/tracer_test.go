@@ -0,0 +1,186 @@
+package flattracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testFrom    = types.StringToAddress("1")
+	testTo      = types.StringToAddress("2")
+	testCreated = types.StringToAddress("3")
+	testRefund  = types.StringToAddress("4")
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+type mockHost struct{}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+func (m *mockHost) GetBalance(types.Address) *big.Int {
+	return big.NewInt(5)
+}
+
+func (m *mockHost) GetNonce(types.Address) uint64 {
+	return 0
+}
+
+func (m *mockHost) GetCode(types.Address) []byte {
+	return nil
+}
+
+func TestFlatTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewFlatTracer()
+
+	tracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, &mockHost{})
+
+	tracer.CallStart(1, testFrom, testTo, int(runtime.Call), 1000, big.NewInt(1), []byte{0x1})
+
+	// a creation that self destructs
+	tracer.CallStart(2, testTo, testCreated, evm.CREATE, 500, nil, []byte{0x2})
+
+	stack := []*big.Int{new(big.Int).SetBytes(testRefund.Bytes())}
+	tracer.CaptureState(nil, stack, evm.SELFDESTRUCT, testCreated, len(stack), &mockHost{}, &mockState{})
+	tracer.ExecuteState(testCreated, 0, "SELFDESTRUCT", 0, 0, nil, 2, nil, &mockHost{})
+
+	tracer.CallEnd(2, []byte{0x3}, 100, nil)
+
+	// a reverted call
+	tracer.CallStart(2, testTo, testFrom, int(runtime.DelegateCall), 300, big.NewInt(1), nil)
+	tracer.CallEnd(2, nil, 300, runtime.ErrExecutionReverted)
+
+	tracer.CallEnd(1, []byte{0x4}, 800, nil)
+	tracer.TxEnd(0)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*Trace{
+		{
+			Type: "call",
+			Action: Action{
+				CallType: "call",
+				From:     &testFrom,
+				To:       &testTo,
+				Gas:      "0x3e8",
+				Input:    "0x01",
+				Value:    "0x1",
+			},
+			Result: &Result{
+				GasUsed: "0x320",
+				Output:  "0x04",
+			},
+			Subtraces:    2,
+			TraceAddress: []int{},
+		},
+		{
+			Type: "create",
+			Action: Action{
+				From:  &testTo,
+				Gas:   "0x1f4",
+				Init:  "0x02",
+				Value: "0x0",
+			},
+			Result: &Result{
+				GasUsed: "0x64",
+				Address: &testCreated,
+				Code:    "0x03",
+			},
+			Subtraces:    1,
+			TraceAddress: []int{0},
+			created:      testCreated,
+		},
+		{
+			Type: "suicide",
+			Action: Action{
+				Address:       &testCreated,
+				RefundAddress: &testRefund,
+				Balance:       "0x5",
+			},
+			TraceAddress: []int{0, 0},
+		},
+		{
+			Type: "call",
+			Action: Action{
+				CallType: "delegatecall",
+				From:     &testTo,
+				To:       &testFrom,
+				Gas:      "0x12c",
+				Input:    "0x",
+				Value:    "0x1",
+			},
+			Error:        "Reverted",
+			TraceAddress: []int{1},
+		},
+	}, res)
+}
+
+func TestFlatTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+	state := &mockState{}
+
+	tracer := NewFlatTracer()
+
+	tracer.Cancel(err)
+
+	tracer.CaptureState(nil, nil, evm.ADD, testTo, 0, &mockHost{}, state)
+	assert.True(t, state.halted)
+
+	res, resErr := tracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+
+	tracer.Clear()
+
+	res, resErr = tracer.GetResult()
+	assert.NoError(t, resErr)
+	assert.Equal(t, []*Trace{}, res)
+}
+
+func TestFlatTracer_CancelWhileGettingResult(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+
+	tracer := NewFlatTracer()
+
+	done := make(chan struct{})
+
+	// the tracer is cancelled by the timer of the endpoint while the result is read
+	go func() {
+		defer close(done)
+
+		tracer.Cancel(err)
+	}()
+
+	_, _ = tracer.GetResult()
+
+	<-done
+
+	res, resErr := tracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+}
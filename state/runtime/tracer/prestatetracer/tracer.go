@@ -0,0 +1,386 @@
+package prestatetracer
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/helper/hex"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/state/runtime/tracer"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+// maxInitCodeSize is the size limit of the init code read to compute CREATE2 addresses
+const maxInitCodeSize = 2 * 24576
+
+type Config struct {
+	DiffMode bool `json:"diffMode"` // return the state before and after the transaction
+}
+
+// Account is the state of an account touched by the transaction
+type Account struct {
+	Balance string                    `json:"balance,omitempty"`
+	Nonce   uint64                    `json:"nonce,omitempty"`
+	Code    string                    `json:"code,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+// DiffResult is the result of the tracer in diff mode
+type DiffResult struct {
+	Pre  map[types.Address]*Account `json:"pre"`
+	Post map[types.Address]*Account `json:"post"`
+}
+
+// account is the state of a touched account before the transaction
+type account struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+func (a *account) empty() bool {
+	return (a.balance == nil || a.balance.Sign() == 0) && a.nonce == 0 && len(a.code) == 0
+}
+
+// PrestateTracer records the accounts touched by a transaction
+// and their state before the transaction
+type PrestateTracer struct {
+	Config Config
+
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	host tracer.RuntimeHost
+
+	// pre is the state of the touched accounts before the transaction,
+	// post is their state after it (only set in diff mode)
+	pre  map[types.Address]*account
+	post map[types.Address]*account
+}
+
+func NewPrestateTracer(config Config) *PrestateTracer {
+	return &PrestateTracer{
+		Config:     config,
+		cancelLock: sync.RWMutex{},
+		pre:        make(map[types.Address]*account),
+	}
+}
+
+func (t *PrestateTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *PrestateTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+// cancelReason returns the error the tracer was cancelled with, if any
+func (t *PrestateTracer) cancelReason() error {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.reason
+}
+
+func (t *PrestateTracer) Clear() {
+	t.cancelLock.Lock()
+	t.reason = nil
+	t.interrupt = false
+	t.cancelLock.Unlock()
+
+	t.host = nil
+	t.pre = make(map[types.Address]*account)
+	t.post = nil
+}
+
+func (t *PrestateTracer) TxStart(tx *types.Transaction, host tracer.RuntimeHost) {
+	t.host = host
+
+	t.lookupAccount(tx.From)
+
+	if tx.IsContractCreation() {
+		t.lookupAccount(crypto.CreateAddress(tx.From, host.GetNonce(tx.From)))
+	} else {
+		t.lookupAccount(*tx.To)
+	}
+}
+
+func (t *PrestateTracer) TxEnd(gasLeft uint64) {
+	if !t.Config.DiffMode || t.host == nil {
+		return
+	}
+
+	t.post = make(map[types.Address]*account, len(t.pre))
+
+	for addr, pre := range t.pre {
+		post := &account{
+			balance: t.host.GetBalance(addr),
+			nonce:   t.host.GetNonce(addr),
+			code:    t.host.GetCode(addr),
+			storage: make(map[types.Hash]types.Hash, len(pre.storage)),
+		}
+
+		for slot := range pre.storage {
+			post.storage[slot] = t.host.GetStorage(addr, slot)
+		}
+
+		t.post[addr] = post
+	}
+}
+
+func (t *PrestateTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+}
+
+func (t *PrestateTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasUsed uint64,
+	err error,
+) {
+}
+
+func (t *PrestateTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	// the stack is not validated before the opcode is executed
+	stackAddress := func(pos int) (types.Address, bool) {
+		if sp < pos {
+			return types.ZeroAddress, false
+		}
+
+		return types.BytesToAddress(stack[sp-pos].Bytes()), true
+	}
+
+	switch opCode {
+	case evm.SLOAD, evm.SSTORE:
+		if sp < 1 {
+			return
+		}
+
+		t.lookupStorage(contractAddress, types.BytesToHash(stack[sp-1].Bytes()))
+
+	case evm.BALANCE, evm.EXTCODESIZE, evm.EXTCODEHASH, evm.EXTCODECOPY, evm.SELFDESTRUCT:
+		if addr, ok := stackAddress(1); ok {
+			t.lookupAccount(addr)
+		}
+
+	case evm.CALL, evm.CALLCODE, evm.DELEGATECALL, evm.STATICCALL:
+		if addr, ok := stackAddress(2); ok {
+			t.lookupAccount(addr)
+		}
+
+	case evm.CREATE:
+		t.lookupAccount(crypto.CreateAddress(contractAddress, host.GetNonce(contractAddress)))
+
+	case evm.CREATE2:
+		if sp < 4 {
+			return
+		}
+
+		initCode, ok := memorySlice(memory, stack[sp-2], stack[sp-3])
+		if !ok {
+			return
+		}
+
+		salt := types.BytesToHash(stack[sp-4].Bytes())
+
+		t.lookupAccount(crypto.CreateAddress2(contractAddress, salt, initCode))
+	}
+}
+
+func (t *PrestateTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opCode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// lookupAccount records the state of the account if it is touched for the first time
+func (t *PrestateTracer) lookupAccount(addr types.Address) {
+	if _, ok := t.pre[addr]; ok || t.host == nil {
+		return
+	}
+
+	t.pre[addr] = &account{
+		balance: t.host.GetBalance(addr),
+		nonce:   t.host.GetNonce(addr),
+		code:    t.host.GetCode(addr),
+		storage: make(map[types.Hash]types.Hash),
+	}
+}
+
+// lookupStorage records the value of the slot if it is touched for the first time
+func (t *PrestateTracer) lookupStorage(addr types.Address, slot types.Hash) {
+	t.lookupAccount(addr)
+
+	acc, ok := t.pre[addr]
+	if !ok {
+		return
+	}
+
+	if _, ok := acc.storage[slot]; ok {
+		return
+	}
+
+	acc.storage[slot] = t.host.GetStorage(addr, slot)
+}
+
+func (t *PrestateTracer) GetResult() (interface{}, error) {
+	if reason := t.cancelReason(); reason != nil {
+		return nil, reason
+	}
+
+	if !t.Config.DiffMode {
+		res := make(map[types.Address]*Account, len(t.pre))
+
+		for addr, acc := range t.pre {
+			res[addr] = formatAccount(acc)
+		}
+
+		return res, nil
+	}
+
+	if t.post == nil {
+		return nil, errors.New("no transaction traced")
+	}
+
+	res := &DiffResult{
+		Pre:  make(map[types.Address]*Account),
+		Post: make(map[types.Address]*Account),
+	}
+
+	for addr, pre := range t.pre {
+		post := t.post[addr]
+
+		// only the changed fields are included in the post state
+		// and only the changed slots in both states
+		diff := &account{storage: make(map[types.Hash]types.Hash)}
+		preDiff := &account{
+			balance: pre.balance,
+			nonce:   pre.nonce,
+			code:    pre.code,
+			storage: make(map[types.Hash]types.Hash),
+		}
+		modified := false
+
+		if pre.balance.Cmp(post.balance) != 0 {
+			diff.balance = post.balance
+			modified = true
+		}
+
+		if pre.nonce != post.nonce {
+			diff.nonce = post.nonce
+			modified = true
+		}
+
+		if !bytes.Equal(pre.code, post.code) {
+			diff.code = post.code
+			modified = true
+		}
+
+		for slot, value := range pre.storage {
+			if post.storage[slot] != value {
+				preDiff.storage[slot] = value
+				diff.storage[slot] = post.storage[slot]
+				modified = true
+			}
+		}
+
+		if !modified {
+			continue
+		}
+
+		res.Post[addr] = formatAccount(diff)
+
+		// accounts created by the transaction have no previous state
+		if !pre.empty() {
+			res.Pre[addr] = formatAccount(preDiff)
+		}
+	}
+
+	return res, nil
+}
+
+func formatAccount(acc *account) *Account {
+	res := &Account{
+		Nonce: acc.nonce,
+	}
+
+	if acc.balance != nil {
+		res.Balance = hex.EncodeBig(acc.balance)
+	}
+
+	if len(acc.code) != 0 {
+		res.Code = hex.EncodeToHex(acc.code)
+	}
+
+	if len(acc.storage) != 0 {
+		res.Storage = make(map[types.Hash]types.Hash, len(acc.storage))
+
+		for slot, value := range acc.storage {
+			res.Storage[slot] = value
+		}
+	}
+
+	return res
+}
+
+// memorySlice returns the memory range read by the opcode,
+// the memory is not expanded yet so data beyond its end is zero
+func memorySlice(memory []byte, offset, size *big.Int) ([]byte, bool) {
+	if !size.IsUint64() || size.Uint64() > maxInitCodeSize {
+		return nil, false
+	}
+
+	data := make([]byte, size.Uint64())
+	if size.Sign() == 0 {
+		return data, true
+	}
+
+	if !offset.IsUint64() {
+		return nil, false
+	}
+
+	if start := offset.Uint64(); start < uint64(len(memory)) {
+		copy(data, memory[start:])
+	}
+
+	return data, true
+}
@@ -0,0 +1,237 @@
+package prestatetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testFrom  = types.StringToAddress("1")
+	testTo    = types.StringToAddress("2")
+	testOther = types.StringToAddress("3")
+
+	testSlot = types.StringToHash("4")
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+type mockAccount struct {
+	balance int64
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+type mockHost struct {
+	accounts map[types.Address]*mockAccount
+}
+
+func (m *mockHost) account(addr types.Address) *mockAccount {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc
+	}
+
+	return &mockAccount{}
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(addr types.Address, slot types.Hash) types.Hash {
+	return m.account(addr).storage[slot]
+}
+
+func (m *mockHost) GetBalance(addr types.Address) *big.Int {
+	return big.NewInt(m.account(addr).balance)
+}
+
+func (m *mockHost) GetNonce(addr types.Address) uint64 {
+	return m.account(addr).nonce
+}
+
+func (m *mockHost) GetCode(addr types.Address) []byte {
+	return m.account(addr).code
+}
+
+func newTestHost() *mockHost {
+	return &mockHost{
+		accounts: map[types.Address]*mockAccount{
+			testFrom: {balance: 100, nonce: 1},
+			testTo: {
+				balance: 10,
+				code:    []byte{0x1},
+				storage: map[types.Hash]types.Hash{
+					testSlot: types.StringToHash("5"),
+				},
+			},
+			testOther: {balance: 1},
+		},
+	}
+}
+
+// trace simulates a transaction that reads a slot of the called contract,
+// checks the balance of another account and creates a contract with CREATE2
+func trace(tracer *PrestateTracer, host *mockHost) types.Address {
+	tracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, host)
+
+	stack := []*big.Int{new(big.Int).SetBytes(testSlot.Bytes())}
+	tracer.CaptureState(nil, stack, evm.SLOAD, testTo, len(stack), host, &mockState{})
+
+	stack = []*big.Int{new(big.Int).SetBytes(testOther.Bytes())}
+	tracer.CaptureState(nil, stack, evm.BALANCE, testTo, len(stack), host, &mockState{})
+
+	// salt, length, offset, value
+	initCode := []byte{0x2, 0x3}
+	stack = []*big.Int{big.NewInt(7), big.NewInt(2), big.NewInt(0), big.NewInt(0)}
+	tracer.CaptureState(initCode, stack, evm.CREATE2, testTo, len(stack), host, &mockState{})
+
+	return crypto.CreateAddress2(testTo, types.BytesToHash([]byte{7}), initCode)
+}
+
+func TestPrestateTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewPrestateTracer(Config{})
+
+	created := trace(tracer, newTestHost())
+
+	tracer.TxEnd(0)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[types.Address]*Account{
+		testFrom: {
+			Balance: "0x64",
+			Nonce:   1,
+		},
+		testTo: {
+			Balance: "0xa",
+			Code:    "0x01",
+			Storage: map[types.Hash]types.Hash{
+				testSlot: types.StringToHash("5"),
+			},
+		},
+		testOther: {
+			Balance: "0x1",
+		},
+		created: {
+			Balance: "0x0",
+		},
+	}, res)
+}
+
+func TestPrestateTracer_DiffMode(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewPrestateTracer(Config{DiffMode: true})
+	host := newTestHost()
+
+	created := trace(tracer, host)
+
+	// apply the transaction
+	host.accounts[testFrom].balance = 90
+	host.accounts[testFrom].nonce = 2
+	host.accounts[testTo].storage[testSlot] = types.StringToHash("6")
+	host.accounts[created] = &mockAccount{nonce: 1, code: []byte{0x4}}
+
+	tracer.TxEnd(0)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	assert.Equal(t, &DiffResult{
+		Pre: map[types.Address]*Account{
+			testFrom: {
+				Balance: "0x64",
+				Nonce:   1,
+			},
+			testTo: {
+				Balance: "0xa",
+				Code:    "0x01",
+				Storage: map[types.Hash]types.Hash{
+					testSlot: types.StringToHash("5"),
+				},
+			},
+		},
+		Post: map[types.Address]*Account{
+			testFrom: {
+				Balance: "0x5a",
+				Nonce:   2,
+			},
+			testTo: {
+				Storage: map[types.Hash]types.Hash{
+					testSlot: types.StringToHash("6"),
+				},
+			},
+			created: {
+				Nonce: 1,
+				Code:  "0x04",
+			},
+		},
+	}, res)
+}
+
+func TestPrestateTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+	state := &mockState{}
+
+	tracer := NewPrestateTracer(Config{})
+
+	tracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, newTestHost())
+	tracer.Cancel(err)
+
+	tracer.CaptureState(nil, nil, evm.ADD, testTo, 0, newTestHost(), state)
+	assert.True(t, state.halted)
+
+	res, resErr := tracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+
+	tracer.Clear()
+
+	res, resErr = tracer.GetResult()
+	assert.NoError(t, resErr)
+	assert.Empty(t, res)
+}
+
+func TestPrestateTracer_CancelWhileGettingResult(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+
+	tracer := NewPrestateTracer(Config{})
+	tracer.TxStart(&types.Transaction{From: testFrom, To: &testTo}, newTestHost())
+
+	done := make(chan struct{})
+
+	// the tracer is cancelled by the timer of the endpoint while the result is read
+	go func() {
+		defer close(done)
+
+		tracer.Cancel(err)
+	}()
+
+	_, _ = tracer.GetResult()
+
+	<-done
+
+	res, resErr := tracer.GetResult()
+	assert.Nil(t, res)
+	assert.Equal(t, err, resErr)
+}
@@ -97,8 +97,8 @@ func (t *StructTracer) Clear() {
 	t.currentStack = t.currentStack[:0]
 }
 
-func (t *StructTracer) TxStart(gasLimit uint64) {
-	t.gasLimit = gasLimit
+func (t *StructTracer) TxStart(tx *types.Transaction, host tracer.RuntimeHost) {
+	t.gasLimit = tx.Gas
 }
 
 func (t *StructTracer) TxEnd(gasLeft uint64) {
@@ -118,6 +118,7 @@ func (t *StructTracer) CallStart(
 func (t *StructTracer) CallEnd(
 	depth int,
 	output []byte,
+	gasUsed uint64,
 	err error,
 ) {
 	if depth == 1 {
@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -12,10 +13,15 @@ import (
 	"github.com/LaChain/polygon-edge/types"
 )
 
+// ErrStateNotFound is returned when the state of a root is not in the storage,
+// e.g. because it has been pruned
+var ErrStateNotFound = errors.New("state not found")
+
 type State interface {
 	NewSnapshotAt(types.Hash) (Snapshot, error)
 	NewSnapshot() Snapshot
 	GetCode(hash types.Hash) ([]byte, bool)
+	GetProof(root types.Hash, key []byte) ([][]byte, error)
 }
 
 type Snapshot interface {
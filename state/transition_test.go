@@ -4,7 +4,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
 	"github.com/LaChain/polygon-edge/state/runtime"
+	"github.com/LaChain/polygon-edge/state/runtime/evm"
+	"github.com/LaChain/polygon-edge/state/runtime/precompiled"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +25,20 @@ func newTestTransition(preState map[types.Address]*PreState) *Transition {
 	}
 }
 
+// newTestEVMTransition returns a transition running the transactions in the EVM, with all forks enabled
+func newTestEVMTransition(preState map[types.Address]*PreState) *Transition {
+	transition := newTestTransition(preState)
+	transition.config = chain.AllForksEnabled.At(0)
+	transition.ctx = runtime.TxContext{
+		GasLimit: 1000000,
+	}
+	transition.gasPool = 1000000
+	transition.evm = evm.NewEVM()
+	transition.precompiles = precompiled.NewPrecompiled()
+
+	return transition
+}
+
 func TestSubGasLimitPrice(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +106,41 @@ func TestSubGasLimitPrice(t *testing.T) {
 	}
 }
 
+func TestSubGasLimitPrice_DynamicFee(t *testing.T) {
+	t.Parallel()
+
+	// the max gas cost is 100 and the value is 50
+	tests := []struct {
+		name        string
+		balance     uint64
+		expectedErr error
+	}{
+		{"should fail if the balance covers only the max gas cost", 120, ErrNotEnoughFundsForGas},
+		{"should succeed if the balance covers the max gas cost and the value", 150, nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {Balance: tt.balance},
+			})
+			msg := &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				From:      addr1,
+				Gas:       10,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(10),
+				Value:     big.NewInt(50),
+			}
+
+			assert.Equal(t, tt.expectedErr, transition.subGasLimitPrice(msg))
+		})
+	}
+}
+
 func TestTransfer(t *testing.T) {
 	t.Parallel()
 
@@ -159,3 +212,374 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		berlin      bool
+		london      bool
+		noBaseFee   bool
+		baseFee     int64
+		msg         *types.Transaction
+		expectedErr error
+	}{
+		{
+			name:    "should accept legacy transaction before London",
+			london:  false,
+			baseFee: 0,
+			msg: &types.Transaction{
+				GasPrice: big.NewInt(1),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "should reject dynamic fee transaction before London",
+			london:  false,
+			baseFee: 0,
+			msg: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(10),
+			},
+			expectedErr: ErrTxTypeNotSupported,
+		},
+		{
+			name:    "should reject access list transaction before Berlin",
+			berlin:  false,
+			london:  false,
+			baseFee: 0,
+			msg: &types.Transaction{
+				Type:     types.AccessListTx,
+				GasPrice: big.NewInt(1),
+			},
+			expectedErr: ErrTxTypeNotSupported,
+		},
+		{
+			name:    "should accept access list transaction after Berlin",
+			berlin:  true,
+			london:  false,
+			baseFee: 0,
+			msg: &types.Transaction{
+				Type:     types.AccessListTx,
+				GasPrice: big.NewInt(1),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "should reject tip above fee cap",
+			london:  true,
+			baseFee: 5,
+			msg: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasTipCap: big.NewInt(11),
+				GasFeeCap: big.NewInt(10),
+			},
+			expectedErr: ErrTipAboveFeeCap,
+		},
+		{
+			name:    "should reject fee cap below base fee",
+			london:  true,
+			baseFee: 11,
+			msg: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(10),
+			},
+			expectedErr: ErrFeeCapTooLow,
+		},
+		{
+			name:    "should reject legacy gas price below base fee",
+			london:  true,
+			baseFee: 11,
+			msg: &types.Transaction{
+				GasPrice: big.NewInt(10),
+			},
+			expectedErr: ErrFeeCapTooLow,
+		},
+		{
+			name:    "should accept dynamic fee transaction after London",
+			london:  true,
+			baseFee: 5,
+			msg: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(10),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "should reject legacy transaction without gas price after London",
+			london:  true,
+			baseFee: 5,
+			msg: &types.Transaction{
+				GasPrice: big.NewInt(0),
+			},
+			expectedErr: ErrFeeCapTooLow,
+		},
+		{
+			name:      "should accept legacy call without gas price in no base fee mode",
+			london:    true,
+			noBaseFee: true,
+			baseFee:   5,
+			msg: &types.Transaction{
+				GasPrice: big.NewInt(0),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "should accept dynamic fee call without fee caps in no base fee mode",
+			london:    true,
+			noBaseFee: true,
+			baseFee:   5,
+			msg: &types.Transaction{
+				Type:      types.DynamicFeeTx,
+				GasTipCap: big.NewInt(0),
+				GasFeeCap: big.NewInt(0),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "should reject gas price below base fee in no base fee mode",
+			london:    true,
+			noBaseFee: true,
+			baseFee:   11,
+			msg: &types.Transaction{
+				GasPrice: big.NewInt(10),
+			},
+			expectedErr: ErrFeeCapTooLow,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(nil)
+			transition.config.Berlin = tt.berlin
+			transition.config.London = tt.london
+			transition.noBaseFee = tt.noBaseFee
+			transition.ctx.BaseFee = types.BytesToHash(big.NewInt(tt.baseFee).Bytes())
+
+			err := transition.feeCheck(tt.msg)
+			if tt.expectedErr == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err.Err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestApply_NoBaseFee(t *testing.T) {
+	t.Parallel()
+
+	var (
+		coinbase     = types.StringToAddress("3")
+		burnContract = types.StringToAddress("4")
+	)
+
+	transition := newTestEVMTransition(map[types.Address]*PreState{
+		addr1: {Balance: 1000},
+	})
+	transition.ctx.Coinbase = coinbase
+	transition.ctx.BaseFee = types.BytesToHash(big.NewInt(10).Bytes())
+	transition.burnContract = &burnContract
+	transition.SetNoBaseFee(true)
+
+	result, err := transition.apply(&types.Transaction{
+		From:     addr1,
+		To:       &addr2,
+		Value:    big.NewInt(0),
+		Gas:      30000,
+		GasPrice: big.NewInt(0),
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+
+	// the call pays neither the base fee nor the coinbase
+	assert.Equal(t, big.NewInt(1000), transition.GetBalance(addr1))
+	assert.Zero(t, transition.GetBalance(coinbase).Sign())
+	assert.Zero(t, transition.GetBalance(burnContract).Sign())
+
+	// the base fee of the block is still visible to the call
+	assert.Equal(t, uint64(10), transition.baseFee())
+}
+
+func TestTransactionGasCost_AccessList(t *testing.T) {
+	t.Parallel()
+
+	msg := &types.Transaction{
+		To: &addr2,
+		AccessList: types.TxAccessList{
+			{Address: addr1, StorageKeys: []types.Hash{hash1, hash2}},
+			{Address: addr2},
+		},
+	}
+
+	cost, err := TransactionGasCost(msg, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, TxGas+2*TxAccessListAddressGas+2*TxAccessListStorageKeyGas, cost)
+}
+
+func TestApplyCreate_Whitelists(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(nil)
+	transition.whitelists = &chain.Whitelists{
+		Deployment:      []types.Address{addr2},
+		InternalCreates: true,
+	}
+
+	contract := runtime.NewContractCreation(2, addr2, addr1, types.StringToAddress("3"), big.NewInt(0), 1000, nil)
+
+	result := transition.applyCreate(contract, transition)
+
+	// the caller is not allowed to deploy contracts, so the create fails without consuming gas
+	assert.ErrorIs(t, result.Err, chain.ErrDeploymentRestricted)
+	assert.Equal(t, uint64(1000), result.GasLeft)
+	assert.Equal(t, defaultPreState[addr1].Nonce, transition.GetNonce(addr1))
+}
+
+func TestApply_FactoryDeploymentBeforeInternalCreatesFork(t *testing.T) {
+	t.Parallel()
+
+	var (
+		factory = types.StringToAddress("100")
+
+		// the factory creates an empty contract and stores its address in the slot 0
+		factoryCode = []byte{
+			byte(evm.PUSH1), 0x00,
+			byte(evm.PUSH1), 0x00,
+			byte(evm.PUSH1), 0x00,
+			byte(evm.CREATE),
+			byte(evm.PUSH1), 0x00,
+			byte(evm.SSTORE),
+			byte(evm.STOP),
+		}
+
+		// the deployment whitelist applies to the internal creates from the block 10
+		whitelists = &chain.Whitelists{
+			Deployment: []types.Address{addr1},
+			Forks: []*chain.WhitelistsFork{
+				{
+					Block: 10,
+					Whitelists: &chain.Whitelists{
+						Deployment:      []types.Address{addr1},
+						InternalCreates: true,
+					},
+				},
+			},
+		}
+	)
+
+	cases := []struct {
+		name    string
+		block   uint64
+		created bool
+	}{
+		{"before the fork", 9, true},
+		{"after the fork", 10, false},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestEVMTransition(map[types.Address]*PreState{
+				addr1: {Balance: 1000},
+			})
+			transition.ctx.Number = int64(c.block)
+			transition.whitelists = whitelists.At(c.block)
+			transition.state.SetCode(factory, factoryCode)
+
+			// the sender is whitelisted, while the factory is not
+			result, err := transition.apply(&types.Transaction{
+				From:     addr1,
+				To:       &factory,
+				Value:    big.NewInt(0),
+				Gas:      100000,
+				GasPrice: big.NewInt(0),
+			})
+
+			assert.NoError(t, err)
+			assert.NoError(t, result.Err)
+
+			created := transition.GetStorage(factory, types.Hash{})
+			assert.Equal(t, c.created, created != types.Hash{})
+		})
+	}
+}
+
+func TestApply_FactoryDeploymentWithAccessControl(t *testing.T) {
+	t.Parallel()
+
+	var (
+		factory       = types.StringToAddress("100")
+		accessControl = types.StringToAddress("101")
+
+		// the factory creates an empty contract and stores its address in the slot 0
+		factoryCode = []byte{
+			byte(evm.PUSH1), 0x00,
+			byte(evm.PUSH1), 0x00,
+			byte(evm.PUSH1), 0x00,
+			byte(evm.CREATE),
+			byte(evm.PUSH1), 0x00,
+			byte(evm.SSTORE),
+			byte(evm.STOP),
+		}
+	)
+
+	cases := []struct {
+		name            string
+		internalCreates bool
+		created         bool
+	}{
+		{"not applied to internal creates", false, true},
+		{"applied to internal creates", true, false},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestEVMTransition(map[types.Address]*PreState{
+				addr1: {Balance: 1000},
+			})
+			transition.accessControlContract = &accessControl
+			transition.accessControlInternalCreates = c.internalCreates
+			transition.state.SetCode(factory, factoryCode)
+
+			// the deployer role is granted to the sender only, not to the factory
+			transition.state.SetState(
+				accessControl,
+				accesscontrol.MemberIndex(accesscontrol.RoleDeployer, addr1),
+				types.BytesToHash([]byte{1}),
+			)
+			transition.state.SetState(
+				accessControl,
+				accesscontrol.MemberCountIndex(accesscontrol.RoleDeployer),
+				types.BytesToHash([]byte{1}),
+			)
+
+			result, err := transition.apply(&types.Transaction{
+				From:     addr1,
+				To:       &factory,
+				Value:    big.NewInt(0),
+				Gas:      100000,
+				GasPrice: big.NewInt(0),
+			})
+
+			assert.NoError(t, err)
+			assert.NoError(t, result.Err)
+
+			created := transition.GetStorage(factory, types.Hash{})
+			assert.Equal(t, c.created, created != types.Hash{})
+		})
+	}
+}
@@ -26,6 +26,12 @@ var (
 
 	// refundIndex is the index of the refund
 	refundIndex = types.BytesToHash([]byte{3}).Bytes()
+
+	// accessListIndex is the prefix of the access list entries in the trie (EIP-2929)
+	accessListIndex = types.BytesToHash([]byte{4}).Bytes()
+
+	// transientStorageIndex is the prefix of the transient storage entries in the trie (EIP-1153)
+	transientStorageIndex = types.BytesToHash([]byte{5}).Bytes()
 )
 
 // Txn is a reference of the state
@@ -283,13 +289,19 @@ func (txn *Txn) SetStorage(
 	if original == value {
 		if original == zeroHash { // reset to original nonexistent slot (2.2.2.1)
 			// Storage was used as memory (allocation and deallocation occurred within the same contract)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(19900)
+			} else if config.Istanbul {
 				txn.AddRefund(19200)
 			} else {
 				txn.AddRefund(19800)
 			}
 		} else { // reset to original existing slot (2.2.2.2)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(2800)
+			} else if config.Istanbul {
 				txn.AddRefund(4200)
 			} else {
 				txn.AddRefund(4800)
@@ -473,6 +485,107 @@ func (txn *Txn) GetRefund() uint64 {
 	return data.(uint64)
 }
 
+// Access list (EIP-2929)
+//
+// The accessed addresses and storage slots are kept in the radix tree,
+// so they are reverted along with the state changes of a failed call
+
+func accessListKey(addr types.Address, slot ...types.Hash) []byte {
+	key := append(append([]byte{}, accessListIndex...), addr.Bytes()...)
+	if len(slot) != 0 {
+		key = append(key, slot[0].Bytes()...)
+	}
+
+	return key
+}
+
+// AddressInAccessList checks if the address is in the access list
+func (txn *Txn) AddressInAccessList(addr types.Address) bool {
+	_, ok := txn.txn.Get(accessListKey(addr))
+
+	return ok
+}
+
+// SlotInAccessList checks if the address and the storage slot are in the access list
+func (txn *Txn) SlotInAccessList(addr types.Address, slot types.Hash) (addressOk bool, slotOk bool) {
+	addressOk = txn.AddressInAccessList(addr)
+	_, slotOk = txn.txn.Get(accessListKey(addr, slot))
+
+	return
+}
+
+// AddAddressToAccessList adds the address to the access list
+func (txn *Txn) AddAddressToAccessList(addr types.Address) {
+	txn.txn.Insert(accessListKey(addr), struct{}{})
+}
+
+// AddSlotToAccessList adds the address and the storage slot to the access list
+func (txn *Txn) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	txn.AddAddressToAccessList(addr)
+	txn.txn.Insert(accessListKey(addr, slot), struct{}{})
+}
+
+// ClearAccessList removes all the entries of the access list
+func (txn *Txn) ClearAccessList() {
+	txn.txn.DeletePrefix(accessListIndex)
+}
+
+// AccessList returns the entries of the access list sorted by address and storage slot
+func (txn *Txn) AccessList() types.TxAccessList {
+	list := types.TxAccessList{}
+
+	txn.txn.Root().WalkPrefix(accessListIndex, func(k []byte, _ interface{}) bool {
+		key := k[len(accessListIndex):]
+
+		switch len(key) {
+		case types.AddressLength:
+			list = append(list, types.AccessTuple{
+				Address:     types.BytesToAddress(key),
+				StorageKeys: []types.Hash{},
+			})
+		case types.AddressLength + types.HashLength:
+			// the address entry is always walked before its storage slots
+			last := &list[len(list)-1]
+			last.StorageKeys = append(last.StorageKeys, types.BytesToHash(key[types.AddressLength:]))
+		}
+
+		return false
+	})
+
+	return list
+}
+
+func transientStorageKey(addr types.Address, key types.Hash) []byte {
+	return append(append(append([]byte{}, transientStorageIndex...), addr.Bytes()...), key.Bytes()...)
+}
+
+// GetTransientState returns the value of the transient storage slot of the address
+func (txn *Txn) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	val, ok := txn.txn.Get(transientStorageKey(addr, key))
+	if !ok {
+		return types.Hash{}
+	}
+
+	return val.(types.Hash) //nolint:forcetypeassert
+}
+
+// SetTransientState sets the value of the transient storage slot of the address.
+// The transient storage is discarded at the end of the transaction
+func (txn *Txn) SetTransientState(addr types.Address, key, value types.Hash) {
+	if value == types.ZeroHash {
+		txn.txn.Delete(transientStorageKey(addr, key))
+
+		return
+	}
+
+	txn.txn.Insert(transientStorageKey(addr, key), value)
+}
+
+// ClearTransientStorage removes all the entries of the transient storage
+func (txn *Txn) ClearTransientStorage() {
+	txn.txn.DeletePrefix(transientStorageIndex)
+}
+
 // GetCommittedState returns the state of the address in the trie
 func (txn *Txn) GetCommittedState(addr types.Address, key types.Hash) types.Hash {
 	obj, ok := txn.getStateObject(addr)
@@ -567,6 +680,10 @@ func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) {
 
 	// delete refunds
 	txn.txn.Delete(refundIndex)
+
+	// the access list and the transient storage only live for a transaction
+	txn.ClearAccessList()
+	txn.ClearTransientStorage()
 }
 
 func (txn *Txn) Commit(deleteEmptyObjects bool) []*Object {
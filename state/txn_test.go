@@ -66,3 +66,50 @@ func TestSnapshotUpdateData(t *testing.T) {
 	txn.RevertToSnapshot(ss)
 	assert.Equal(t, hash1, txn.GetState(addr1, hash1))
 }
+
+func TestAccessList(t *testing.T) {
+	txn := newTestTxn(defaultPreState)
+
+	txn.AddSlotToAccessList(addr1, hash1)
+
+	addrOk, slotOk := txn.SlotInAccessList(addr1, hash1)
+	assert.True(t, addrOk)
+	assert.True(t, slotOk)
+	assert.False(t, txn.AddressInAccessList(addr2))
+
+	ss := txn.Snapshot()
+	txn.AddAddressToAccessList(addr2)
+	assert.True(t, txn.AddressInAccessList(addr2))
+
+	txn.RevertToSnapshot(ss)
+	assert.False(t, txn.AddressInAccessList(addr2))
+
+	assert.Equal(t, types.TxAccessList{
+		{Address: addr1, StorageKeys: []types.Hash{hash1}},
+	}, txn.AccessList())
+
+	txn.ClearAccessList()
+	assert.False(t, txn.AddressInAccessList(addr1))
+
+	_, slotOk = txn.SlotInAccessList(addr1, hash1)
+	assert.False(t, slotOk)
+}
+
+func TestTransientStorage(t *testing.T) {
+	txn := newTestTxn(defaultPreState)
+
+	txn.SetTransientState(addr1, hash1, hash2)
+	assert.Equal(t, hash2, txn.GetTransientState(addr1, hash1))
+	assert.Equal(t, types.Hash{}, txn.GetTransientState(addr2, hash1))
+
+	ss := txn.Snapshot()
+	txn.SetTransientState(addr1, hash1, hash1)
+	assert.Equal(t, hash1, txn.GetTransientState(addr1, hash1))
+
+	txn.RevertToSnapshot(ss)
+	assert.Equal(t, hash2, txn.GetTransientState(addr1, hash1))
+
+	// the transient storage is discarded at the end of the transaction
+	txn.CleanDeleteObjects(true)
+	assert.Equal(t, types.Hash{}, txn.GetTransientState(addr1, hash1))
+}
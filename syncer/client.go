@@ -294,6 +294,62 @@ func (m *syncPeerClient) GetBlocks(
 	return blockCh, nil
 }
 
+// GetBlockRange returns the blocks in the given range, or up to peer's latest if it has less.
+// Each block has to arrive within the timeout
+func (m *syncPeerClient) GetBlockRange(
+	peerID peer.ID,
+	from, to uint64,
+	timeoutPerBlock time.Duration,
+) ([]*types.Block, error) {
+	// a dedicated connection, as the range requests to the peer aren't tied to the saved stream
+	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := proto.NewSyncPeerClient(conn).GetBlocks(ctx, &proto.GetBlocksRequest{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GetBlocks stream: %w", err)
+	}
+
+	streamBlockCh, streamErrorCh := blockStreamToChannel(stream)
+
+	defer func() {
+		// unblock the stream reader, which stops once the stream is canceled
+		cancel()
+
+		for range streamBlockCh {
+		}
+	}()
+
+	blocks := make([]*types.Block, 0, to-from+1)
+
+	for uint64(len(blocks)) <= to-from {
+		select {
+		case block, ok := <-streamBlockCh:
+			if !ok {
+				return blocks, nil
+			}
+
+			blocks = append(blocks, block)
+		case err := <-streamErrorCh:
+			return nil, err
+		case <-time.After(timeoutPerBlock):
+			return nil, errTimeout
+		}
+	}
+
+	return blocks, nil
+}
+
 // newSyncPeerClient creates gRPC client
 func (m *syncPeerClient) newSyncPeerClient(peerID peer.ID) (proto.SyncPeerClient, error) {
 	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
@@ -523,3 +523,51 @@ func Test_syncPeerClient_GetBlocks(t *testing.T) {
 
 	assert.Equal(t, expected, blocks)
 }
+
+func Test_syncPeerClient_GetBlockRange(t *testing.T) {
+	t.Parallel()
+
+	clientSrv := newTestNetwork(t)
+	client := newTestSyncPeerClient(clientSrv, nil)
+
+	peerLatest := uint64(10)
+
+	_, peerSrv := createTestSyncerService(t, &mockBlockchain{
+		headerHandler: newSimpleHeaderHandler(peerLatest),
+		getBlockByNumberHandler: func(u uint64, b bool) (*types.Block, bool) {
+			if u <= peerLatest {
+				return &types.Block{
+					Header: &types.Header{
+						Number: u,
+					},
+				}, true
+			}
+
+			return nil, false
+		},
+	})
+
+	err := network.JoinAndWait(
+		clientSrv,
+		peerSrv,
+		network.DefaultBufferTimeout,
+		network.DefaultJoinTimeout,
+	)
+
+	assert.NoError(t, err)
+
+	// hash is calculated on unmarshaling
+	expected := createMockBlocks(10)
+	for _, b := range expected {
+		b.Header.ComputeHash()
+	}
+
+	blocks, err := client.GetBlockRange(peerSrv.AddrInfo().ID, 3, 6, 5*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[2:6], blocks)
+
+	// the peer returns the blocks up to its latest
+	blocks, err = client.GetBlockRange(peerSrv.AddrInfo().ID, 8, 20, 5*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, expected[7:], blocks)
+}
@@ -0,0 +1,394 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	downloaderName = "block-downloader"
+
+	// downloadBatchSize is the number of blocks requested from a peer at once
+	downloadBatchSize = 32
+	// maxBufferedBatches is the number of batches downloaded ahead of the next block to write
+	maxBufferedBatches = 16
+	// maxDownloadPeerFailures is the number of consecutive failed requests after which a peer is not used anymore
+	maxDownloadPeerFailures = 3
+	// minThroughputRatio is the ratio of the throughput of the fastest peer below which a peer is penalized
+	minThroughputRatio = 0.1
+	// throughputWeight is the weight of the latest measurement in the average throughput of a peer
+	throughputWeight = 0.3
+)
+
+var (
+	errNoDownloadPeers  = errors.New("no peer to download the blocks from")
+	errUnexpectedBlocks = errors.New("the peer returned unexpected blocks")
+	errIncompleteRange  = errors.New("the peer returned part of the range")
+	errSlowPeer         = errors.New("the peer is too slow")
+)
+
+// blockRange is a range of blocks requested from a single peer
+type blockRange struct {
+	from uint64
+	to   uint64
+}
+
+// rangeResponse is the response of a peer to the request of a range
+type rangeResponse struct {
+	peer    *NoForkPeer
+	rng     blockRange
+	blocks  []*types.Block
+	elapsed time.Duration
+	err     error
+}
+
+// downloadedBlock is a block waiting for the previous ones to be written
+type downloadedBlock struct {
+	block *types.Block
+	peer  peer.ID
+}
+
+// blockDownloader downloads ranges of blocks from several peers concurrently and writes them in order.
+// The earliest ranges are assigned to the fastest peers, and the peers returning invalid blocks,
+// failing or being too slow are penalized
+type blockDownloader struct {
+	logger       hclog.Logger
+	blockchain   Blockchain
+	client       SyncPeerClient
+	blockTimeout time.Duration
+
+	// throughputs are the average numbers of blocks per second downloaded from the peers
+	throughputs     map[peer.ID]float64
+	throughputsLock sync.Mutex
+}
+
+func newBlockDownloader(
+	logger hclog.Logger,
+	blockchain Blockchain,
+	client SyncPeerClient,
+	blockTimeout time.Duration,
+) *blockDownloader {
+	return &blockDownloader{
+		logger:       logger.Named(downloaderName),
+		blockchain:   blockchain,
+		client:       client,
+		blockTimeout: blockTimeout,
+		throughputs:  make(map[peer.ID]float64),
+	}
+}
+
+// Sync downloads the blocks after the local head up to the latest block of the peers and writes them.
+// It returns the number of the last written block, the result of the callback for it
+// and the peers which aren't used anymore for returning invalid blocks or failing too often
+func (d *blockDownloader) Sync(
+	peers []*NoForkPeer,
+	callback func(*types.Block) bool,
+) (uint64, bool, []peer.ID, error) {
+	var (
+		lastNumber = d.blockchain.Header().Number
+		target     = lastNumber
+
+		idle     = make(map[peer.ID]*NoForkPeer, len(peers))
+		failures = make(map[peer.ID]int, len(peers))
+		dropped  = make(map[peer.ID]bool)
+		failed   = make([]peer.ID, 0, len(peers))
+
+		buffered = make(map[uint64]*downloadedBlock)
+		// each peer has one request in flight at most
+		responseCh = make(chan *rangeResponse, len(peers))
+		inFlight   int
+	)
+
+	for _, p := range peers {
+		idle[p.ID] = p
+
+		if p.Number > target {
+			target = p.Number
+		}
+	}
+
+	// penalize increases the failures of the peer, which is not used anymore after too many in a row
+	penalize := func(p *NoForkPeer) {
+		if failures[p.ID]++; failures[p.ID] >= maxDownloadPeerFailures {
+			drop(dropped, &failed, idle, p.ID)
+
+			return
+		}
+
+		idle[p.ID] = p
+	}
+
+	pending := splitRange(lastNumber+1, target)
+
+	for lastNumber < target {
+		// assign the earliest ranges to the fastest peers, without getting too far ahead of the written blocks
+		for len(pending) > 0 && pending[0].from <= lastNumber+maxBufferedBatches*downloadBatchSize {
+			p := d.fastestPeer(idle, pending[0].to)
+			if p == nil {
+				break
+			}
+
+			rng := pending[0]
+			pending = pending[1:]
+
+			delete(idle, p.ID)
+
+			inFlight++
+
+			go d.fetchRange(p, rng, responseCh)
+		}
+
+		if inFlight == 0 {
+			return lastNumber, false, failed, errNoDownloadPeers
+		}
+
+		res := <-responseCh
+		inFlight--
+
+		if dropped[res.peer.ID] {
+			pending = insertRanges(pending, res.rng)
+
+			continue
+		}
+
+		blocks, err := checkRangeResponse(res)
+
+		switch {
+		case err != nil:
+			d.logger.Warn(
+				"failed to download blocks",
+				"peer", res.peer.ID, "from", res.rng.from, "to", res.rng.to, "err", err,
+			)
+
+			// download again the blocks after the valid ones
+			pending = insertRanges(pending, blockRange{
+				from: res.rng.from + uint64(len(blocks)),
+				to:   res.rng.to,
+			})
+
+			penalize(res.peer)
+		case !d.updateThroughput(res.peer.ID, len(blocks), res.elapsed):
+			d.logger.Debug("peer penalized", "peer", res.peer.ID, "elapsed", res.elapsed, "err", errSlowPeer)
+
+			penalize(res.peer)
+		default:
+			d.logger.Debug("downloaded blocks", "peer", res.peer.ID, "from", res.rng.from, "to", res.rng.to)
+
+			failures[res.peer.ID] = 0
+			idle[res.peer.ID] = res.peer
+		}
+
+		for _, block := range blocks {
+			buffered[block.Number()] = &downloadedBlock{block: block, peer: res.peer.ID}
+		}
+
+		// write the blocks following the local head
+		for {
+			next, ok := buffered[lastNumber+1]
+			if !ok {
+				break
+			}
+
+			delete(buffered, lastNumber+1)
+
+			if err := d.blockchain.VerifyFinalizedBlock(next.block); err != nil {
+				d.logger.Warn("peer returned an invalid block", "peer", next.peer, "number", lastNumber+1, "err", err)
+
+				drop(dropped, &failed, idle, next.peer)
+
+				// download again the blocks returned by the peer
+				pending = insertRanges(pending, takeBlocksOf(buffered, next.peer, lastNumber+1)...)
+
+				break
+			}
+
+			if err := d.blockchain.WriteBlock(next.block, syncerName); err != nil {
+				return lastNumber, false, failed, fmt.Errorf("failed to write block while bulk syncing: %w", err)
+			}
+
+			lastNumber = next.block.Number()
+
+			// the responses in flight are dropped, the channel has room for all of them
+			if callback(next.block) {
+				return lastNumber, true, failed, nil
+			}
+		}
+	}
+
+	return lastNumber, false, failed, nil
+}
+
+// fetchRange requests the range from the peer and sends the response to the channel
+func (d *blockDownloader) fetchRange(p *NoForkPeer, rng blockRange, responseCh chan<- *rangeResponse) {
+	start := time.Now()
+	blocks, err := d.client.GetBlockRange(p.ID, rng.from, rng.to, d.blockTimeout)
+
+	responseCh <- &rangeResponse{
+		peer:    p,
+		rng:     rng,
+		blocks:  blocks,
+		elapsed: time.Since(start),
+		err:     err,
+	}
+}
+
+// fastestPeer returns the idle peer having the given block with the highest throughput.
+// The peers not measured yet come first
+func (d *blockDownloader) fastestPeer(idle map[peer.ID]*NoForkPeer, number uint64) *NoForkPeer {
+	d.throughputsLock.Lock()
+	defer d.throughputsLock.Unlock()
+
+	var (
+		best           *NoForkPeer
+		bestThroughput float64
+	)
+
+	for _, p := range idle {
+		if p.Number < number {
+			continue
+		}
+
+		throughput, measured := d.throughputs[p.ID]
+		if !measured {
+			throughput = math.MaxFloat64
+		}
+
+		if best == nil || throughput > bestThroughput || (throughput == bestThroughput && p.IsBetter(best)) {
+			best, bestThroughput = p, throughput
+		}
+	}
+
+	return best
+}
+
+// updateThroughput updates the average throughput of the peer with a response,
+// and returns false if the peer is much slower than the fastest one
+func (d *blockDownloader) updateThroughput(id peer.ID, blocks int, elapsed time.Duration) bool {
+	d.throughputsLock.Lock()
+	defer d.throughputsLock.Unlock()
+
+	throughput := float64(blocks) / elapsed.Seconds()
+
+	if average, ok := d.throughputs[id]; ok {
+		throughput = throughputWeight*throughput + (1-throughputWeight)*average
+	}
+
+	d.throughputs[id] = throughput
+
+	for _, other := range d.throughputs {
+		if throughput < minThroughputRatio*other {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RemovePeer forgets the throughput of the disconnected peer
+func (d *blockDownloader) RemovePeer(id peer.ID) {
+	d.throughputsLock.Lock()
+	defer d.throughputsLock.Unlock()
+
+	delete(d.throughputs, id)
+}
+
+// checkRangeResponse returns the consecutive blocks of the range in the response,
+// and an error if the request failed or some blocks are missing
+func checkRangeResponse(res *rangeResponse) ([]*types.Block, error) {
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	for i, block := range res.blocks {
+		if block.Number() != res.rng.from+uint64(i) {
+			return nil, errUnexpectedBlocks
+		}
+	}
+
+	if uint64(len(res.blocks)) < res.rng.to-res.rng.from+1 {
+		return res.blocks, errIncompleteRange
+	}
+
+	return res.blocks, nil
+}
+
+// drop stops using the peer in the sync
+func drop(dropped map[peer.ID]bool, failed *[]peer.ID, idle map[peer.ID]*NoForkPeer, id peer.ID) {
+	if dropped[id] {
+		return
+	}
+
+	dropped[id] = true
+	*failed = append(*failed, id)
+
+	delete(idle, id)
+}
+
+// takeBlocksOf removes the buffered blocks returned by the peer,
+// and returns the ranges to download again including the given block
+func takeBlocksOf(buffered map[uint64]*downloadedBlock, id peer.ID, number uint64) []blockRange {
+	numbers := []uint64{number}
+
+	for n, b := range buffered {
+		if b.peer == id {
+			numbers = append(numbers, n)
+
+			delete(buffered, n)
+		}
+	}
+
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+
+	ranges := []blockRange{{from: numbers[0], to: numbers[0]}}
+
+	for _, n := range numbers[1:] {
+		if last := &ranges[len(ranges)-1]; n == last.to+1 {
+			last.to = n
+		} else {
+			ranges = append(ranges, blockRange{from: n, to: n})
+		}
+	}
+
+	return ranges
+}
+
+// splitRange splits the blocks between from and to into the ranges requested at once
+func splitRange(from, to uint64) []blockRange {
+	if from > to {
+		return nil
+	}
+
+	ranges := make([]blockRange, 0, (to-from)/downloadBatchSize+1)
+
+	for ; from <= to; from += downloadBatchSize {
+		end := from + downloadBatchSize - 1
+		if end > to {
+			end = to
+		}
+
+		ranges = append(ranges, blockRange{from: from, to: end})
+	}
+
+	return ranges
+}
+
+// insertRanges adds the ranges to the pending ones, keeping the earliest first
+func insertRanges(pending []blockRange, ranges ...blockRange) []blockRange {
+	pending = append(pending, ranges...)
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].from < pending[j].from
+	})
+
+	return pending
+}
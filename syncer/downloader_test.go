@@ -0,0 +1,279 @@
+package syncer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_blockDownloader_Sync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks          = createMockBlocks(300)
+		errPeerFailure  = errors.New("peer failure")
+		errInvalidBlock = errors.New("invalid block")
+	)
+
+	// invalidBlocks are the blocks returned by a malicious peer
+	invalidBlocks := createMockBlocks(300)
+	for _, b := range invalidBlocks {
+		b.Header.ExtraData = []byte("invalid")
+	}
+
+	blocksInRange := func(blocks []*types.Block, from, to uint64) []*types.Block {
+		return blocks[from-1 : to]
+	}
+
+	honest := func(from, to uint64) ([]*types.Block, error) {
+		return blocksInRange(blocks, from, to), nil
+	}
+
+	tests := []struct {
+		name string
+
+		peers    []*NoForkPeer
+		handlers map[peer.ID]func(from, to uint64) ([]*types.Block, error)
+
+		failed []peer.ID
+		err    error
+	}{
+		{
+			name: "should download the blocks from all the peers",
+			peers: []*NoForkPeer{
+				{ID: "A", Number: 300, Distance: big.NewInt(0)},
+				{ID: "B", Number: 300, Distance: big.NewInt(1)},
+				{ID: "C", Number: 200, Distance: big.NewInt(2)},
+			},
+			handlers: map[peer.ID]func(from, to uint64) ([]*types.Block, error){
+				"A": honest,
+				"B": honest,
+				"C": honest,
+			},
+			failed: []peer.ID{},
+		},
+		{
+			name: "should drop the peer returning invalid blocks",
+			peers: []*NoForkPeer{
+				{ID: "A", Number: 300, Distance: big.NewInt(1)},
+				{ID: "B", Number: 300, Distance: big.NewInt(0)},
+			},
+			handlers: map[peer.ID]func(from, to uint64) ([]*types.Block, error){
+				"A": honest,
+				"B": func(from, to uint64) ([]*types.Block, error) {
+					return blocksInRange(invalidBlocks, from, to), nil
+				},
+			},
+			failed: []peer.ID{"B"},
+		},
+		{
+			name: "should drop the peer failing too many times",
+			peers: []*NoForkPeer{
+				{ID: "A", Number: 300, Distance: big.NewInt(1)},
+				{ID: "B", Number: 300, Distance: big.NewInt(0)},
+			},
+			handlers: map[peer.ID]func(from, to uint64) ([]*types.Block, error){
+				"A": honest,
+				"B": func(from, to uint64) ([]*types.Block, error) {
+					return nil, errPeerFailure
+				},
+			},
+			failed: []peer.ID{"B"},
+		},
+		{
+			name: "should download again the blocks missing in the responses",
+			peers: []*NoForkPeer{
+				{ID: "A", Number: 300, Distance: big.NewInt(1)},
+				{ID: "B", Number: 300, Distance: big.NewInt(0)},
+			},
+			handlers: map[peer.ID]func(from, to uint64) ([]*types.Block, error){
+				"A": honest,
+				"B": func(from, to uint64) ([]*types.Block, error) {
+					return blocksInRange(blocks, from, from), nil
+				},
+			},
+			failed: []peer.ID{"B"},
+		},
+		{
+			name: "should fail if no peer returns the blocks",
+			peers: []*NoForkPeer{
+				{ID: "A", Number: 300, Distance: big.NewInt(0)},
+				{ID: "B", Number: 300, Distance: big.NewInt(1)},
+			},
+			handlers: map[peer.ID]func(from, to uint64) ([]*types.Block, error){
+				"A": func(from, to uint64) ([]*types.Block, error) {
+					return nil, errPeerFailure
+				},
+				"B": func(from, to uint64) ([]*types.Block, error) {
+					return blocksInRange(invalidBlocks, from, to), nil
+				},
+			},
+			failed: []peer.ID{"B", "A"},
+			err:    errNoDownloadPeers,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			written := make([]*types.Block, 0, len(blocks))
+
+			local := &mockBlockchain{
+				headerHandler: func() *types.Header {
+					return &types.Header{Number: uint64(len(written))}
+				},
+				verifyFinalizedBlockHandler: func(b *types.Block) error {
+					if string(b.Header.ExtraData) == "invalid" {
+						return errInvalidBlock
+					}
+
+					return nil
+				},
+				writeBlockHandler: func(b *types.Block) error {
+					written = append(written, b)
+
+					return nil
+				},
+			}
+
+			client := &mockSyncPeerClient{
+				getBlockRangeHandler: func(id peer.ID, from, to uint64) ([]*types.Block, error) {
+					return test.handlers[id](from, to)
+				},
+			}
+
+			downloader := newBlockDownloader(hclog.NewNullLogger(), local, client, time.Second)
+
+			lastNumber, shouldTerminate, failed, err := downloader.Sync(test.peers, func(b *types.Block) bool {
+				return b.Number() == 300
+			})
+
+			assert.ErrorIs(t, err, test.err)
+			assert.ElementsMatch(t, test.failed, failed)
+
+			if test.err != nil {
+				assert.Equal(t, uint64(len(written)), lastNumber)
+
+				return
+			}
+
+			assert.Equal(t, uint64(300), lastNumber)
+			assert.True(t, shouldTerminate)
+			assert.Equal(t, blocks, written)
+		})
+	}
+}
+
+func Test_blockDownloader_SyncTerminated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks  = createMockBlocks(300)
+		written = make([]*types.Block, 0, len(blocks))
+	)
+
+	local := &mockBlockchain{
+		headerHandler: func() *types.Header {
+			return &types.Header{Number: uint64(len(written))}
+		},
+		verifyFinalizedBlockHandler: func(b *types.Block) error {
+			return nil
+		},
+		writeBlockHandler: func(b *types.Block) error {
+			written = append(written, b)
+
+			return nil
+		},
+	}
+
+	client := &mockSyncPeerClient{
+		getBlockRangeHandler: func(id peer.ID, from, to uint64) ([]*types.Block, error) {
+			return blocks[from-1 : to], nil
+		},
+	}
+
+	peers := []*NoForkPeer{
+		{ID: "A", Number: 300, Distance: big.NewInt(0)},
+		{ID: "B", Number: 300, Distance: big.NewInt(1)},
+	}
+
+	downloader := newBlockDownloader(hclog.NewNullLogger(), local, client, time.Second)
+
+	// the blocks downloaded after the termination are not written
+	lastNumber, shouldTerminate, failed, err := downloader.Sync(peers, func(b *types.Block) bool {
+		return b.Number() == 50
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, failed)
+	assert.Equal(t, uint64(50), lastNumber)
+	assert.True(t, shouldTerminate)
+	assert.Equal(t, blocks[:50], written)
+}
+
+func Test_blockDownloader_fastestPeer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks = createMockBlocks(100)
+		peers  = []*NoForkPeer{
+			{ID: "A", Number: 100, Distance: big.NewInt(1)},
+			{ID: "B", Number: 100, Distance: big.NewInt(0)},
+		}
+		written uint64
+	)
+
+	local := &mockBlockchain{
+		headerHandler: func() *types.Header {
+			return &types.Header{Number: written}
+		},
+		verifyFinalizedBlockHandler: func(b *types.Block) error {
+			return nil
+		},
+		writeBlockHandler: func(b *types.Block) error {
+			written = b.Number()
+
+			return nil
+		},
+	}
+
+	client := &mockSyncPeerClient{
+		getBlockRangeHandler: func(id peer.ID, from, to uint64) ([]*types.Block, error) {
+			if id == "B" {
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			return blocks[from-1 : to], nil
+		},
+	}
+
+	downloader := newBlockDownloader(hclog.NewNullLogger(), local, client, time.Second)
+
+	// the closest peer is tried first while the throughputs are unknown
+	assert.Equal(t, peers[1], downloader.fastestPeer(map[peer.ID]*NoForkPeer{"A": peers[0], "B": peers[1]}, 100))
+
+	lastNumber, _, _, err := downloader.Sync(peers, func(*types.Block) bool {
+		return false
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), lastNumber)
+	assert.Greater(t, downloader.throughputs["A"], downloader.throughputs["B"])
+
+	// the faster peer is preferred once measured, unless it doesn't have the blocks
+	assert.Equal(t, peers[0], downloader.fastestPeer(map[peer.ID]*NoForkPeer{"A": peers[0], "B": peers[1]}, 100))
+	assert.Nil(t, downloader.fastestPeer(map[peer.ID]*NoForkPeer{"A": peers[0], "B": peers[1]}, 101))
+
+	downloader.RemovePeer("A")
+
+	assert.Equal(t, peers[0], downloader.fastestPeer(map[peer.ID]*NoForkPeer{"A": peers[0], "B": peers[1]}, 100))
+}
@@ -0,0 +1,107 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/syncer/proto"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rawGrpc "google.golang.org/grpc"
+)
+
+const (
+	headerSyncerName = "header-syncer"
+)
+
+// headerSyncer syncs the verified headers of the chain without the bodies, for the light nodes.
+// The headers are served by the snap protocol of the full nodes
+type headerSyncer struct {
+	logger     hclog.Logger
+	blockchain Blockchain
+
+	// dial opens the connection to the snap protocol of the peer
+	dial func(peer.ID) (*rawGrpc.ClientConn, error)
+}
+
+func newHeaderSyncer(
+	logger hclog.Logger,
+	network Network,
+	blockchain Blockchain,
+) *headerSyncer {
+	return &headerSyncer{
+		logger:     logger.Named(headerSyncerName),
+		blockchain: blockchain,
+		dial: func(peerID peer.ID) (*rawGrpc.ClientConn, error) {
+			return network.NewProtoConnection(snapProto, peerID)
+		},
+	}
+}
+
+// Sync downloads the headers after the local head up to the given height from the peer,
+// verifies and writes them one by one, and calls the callback with a block made of each header.
+// It returns the height of the last written header and the result of the last callback
+func (s *headerSyncer) Sync(
+	peerID peer.ID,
+	target uint64,
+	newHeaderCallback func(*types.Block) bool,
+) (uint64, bool, error) {
+	var (
+		next            = s.blockchain.Header().Number + 1
+		lastNumber      uint64
+		shouldTerminate bool
+	)
+
+	conn, err := s.dial(peerID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	client := proto.NewSnapSyncClient(conn)
+
+	for next <= target {
+		ctx, cancel := context.WithTimeout(context.Background(), snapRequestTimeout)
+		resp, err := client.GetHeaders(ctx, &proto.GetHeadersRequest{From: next, To: target})
+
+		cancel()
+
+		if err != nil {
+			return lastNumber, shouldTerminate, err
+		}
+
+		if len(resp.Headers) == 0 {
+			return lastNumber, shouldTerminate, errInvalidHeaders
+		}
+
+		for _, raw := range resp.Headers {
+			header := &types.Header{}
+			if err := header.UnmarshalRLP(raw); err != nil {
+				return lastNumber, shouldTerminate, fmt.Errorf("%w: %v", errInvalidHeaders, err)
+			}
+
+			if header.Number != next {
+				return lastNumber, shouldTerminate, fmt.Errorf(
+					"%w: expected header %d but got %d", errInvalidHeaders, next, header.Number,
+				)
+			}
+
+			if err := s.blockchain.VerifyFinalizedHeader(header); err != nil {
+				return lastNumber, shouldTerminate, fmt.Errorf("unable to verify header, %w", err)
+			}
+
+			if err := s.blockchain.WriteHeader(header, syncerName); err != nil {
+				return lastNumber, shouldTerminate, fmt.Errorf("failed to write header while syncing: %w", err)
+			}
+
+			shouldTerminate = newHeaderCallback(&types.Block{Header: header})
+
+			lastNumber = header.Number
+			next++
+		}
+	}
+
+	return lastNumber, shouldTerminate, nil
+}
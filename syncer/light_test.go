@@ -0,0 +1,94 @@
+package syncer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_headerSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	chain, stateStorage, headers := newSnapTestChain(t, 100, 100)
+	dial := newSnapTestDialer(t, map[peer.ID]*snapService{
+		"A": newSnapService(nil, chain, stateStorage),
+	})
+
+	errVerification := errors.New("verification failed")
+
+	newSyncer := func(verify func(*types.Header) error) (*headerSyncer, *[]*types.Header) {
+		written := []*types.Header{}
+
+		local := &mockBlockchain{
+			headerHandler: func() *types.Header {
+				if len(written) == 0 {
+					return headers[9]
+				}
+
+				return written[len(written)-1]
+			},
+			verifyFinalizedHeaderHandler: verify,
+			writeHeaderHandler: func(header *types.Header) error {
+				written = append(written, header)
+
+				return nil
+			},
+		}
+
+		return &headerSyncer{
+			logger:     hclog.NewNullLogger(),
+			blockchain: local,
+			dial:       dial,
+		}, &written
+	}
+
+	t.Run("should write the verified headers after the local head", func(t *testing.T) {
+		t.Parallel()
+
+		syncer, written := newSyncer(func(*types.Header) error {
+			return nil
+		})
+
+		callbacks := 0
+
+		lastNumber, shouldTerminate, err := syncer.Sync("A", 99, func(block *types.Block) bool {
+			callbacks++
+
+			return block.Number() == 99
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(99), lastNumber)
+		assert.True(t, shouldTerminate)
+		assert.Equal(t, 90, callbacks)
+		assert.Len(t, *written, 90)
+
+		for i, header := range *written {
+			assert.Equal(t, headers[i+10].Hash, header.Hash)
+		}
+	})
+
+	t.Run("should stop at the header failing verification", func(t *testing.T) {
+		t.Parallel()
+
+		syncer, written := newSyncer(func(header *types.Header) error {
+			if header.Number == 50 {
+				return errVerification
+			}
+
+			return nil
+		})
+
+		lastNumber, _, err := syncer.Sync("A", 99, func(*types.Block) bool {
+			return false
+		})
+
+		assert.ErrorIs(t, err, errVerification)
+		assert.Equal(t, uint64(49), lastNumber)
+		assert.Len(t, *written, 40)
+	})
+}
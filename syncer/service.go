@@ -56,8 +56,8 @@ func (s *syncPeerService) GetBlocks(
 	req *proto.GetBlocksRequest,
 	stream proto.SyncPeer_GetBlocksServer,
 ) error {
-	// from to latest
-	for i := req.From; i <= s.blockchain.Header().Number; i++ {
+	// from to the requested block or the latest
+	for i := req.From; i <= s.blockchain.Header().Number && (req.To == 0 || i <= req.To); i++ {
 		block, ok := s.blockchain.GetBlockByNumber(i, true)
 		if !ok {
 			return ErrBlockNotFound
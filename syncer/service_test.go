@@ -60,6 +60,7 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 	tests := []struct {
 		name           string
 		from           uint64
+		to             uint64
 		latest         uint64
 		blocks         []*types.Block
 		receivedBlocks []*types.Block
@@ -73,6 +74,15 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 			receivedBlocks: blocks[4:], // from 5
 			err:            io.EOF,
 		},
+		{
+			name:           "should send the blocks to the requested block",
+			from:           5,
+			to:             7,
+			latest:         10,
+			blocks:         blocks,
+			receivedBlocks: blocks[4:7], // from 5 to 7
+			err:            io.EOF,
+		},
 		{
 			name:           "should return ErrBlockNotFound",
 			from:           5,
@@ -112,6 +122,7 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 
 			stream, err := client.GetBlocks(context.Background(), &proto.GetBlocksRequest{
 				From: test.from,
+				To:   test.to,
 			})
 
 			assert.NoError(t, err)
@@ -0,0 +1,654 @@
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/syncer/proto"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rawGrpc "google.golang.org/grpc"
+)
+
+const (
+	snapSyncerName = "snap-syncer"
+	snapProto      = "/snap/0.1"
+
+	// snapPivotDistance is the number of blocks behind the best peer the state is downloaded at,
+	// so that the block is final and its state is still served by the peers
+	snapPivotDistance = 64
+	// snapRangeLimit is the number of trie entries requested at once
+	snapRangeLimit = 1024
+	// snapAccountTasks is the number of intervals of the account trie downloaded in parallel
+	snapAccountTasks = 16
+	// snapAnchorPeers is the number of peers which have to agree on the anchor block
+	snapAnchorPeers = 3
+	// snapByteCodesBatch is the number of contract codes requested at once
+	snapByteCodesBatch = 64
+	// maxSnapSyncAttempts is the number of attempts to download the state before falling back to full sync
+	maxSnapSyncAttempts = 3
+	// maxSnapPeerFailures is the number of consecutive failed requests after which a peer is not used anymore
+	maxSnapPeerFailures = 3
+	// snapRequestTimeout is the timeout of a request to a peer
+	snapRequestTimeout = 30 * time.Second
+)
+
+var (
+	errNoSnapPeers         = errors.New("no peer to download the state from")
+	errAnchorMismatch      = errors.New("the peers returned different anchor blocks")
+	errInvalidAnchor       = errors.New("invalid anchor block")
+	errInvalidHeaders      = errors.New("invalid headers")
+	errInvalidByteCodes    = errors.New("none of the requested contract code was returned")
+	errUnfinishedSnapTasks = errors.New("the state could not be downloaded from the peers")
+)
+
+// emptyCodeHash is the code hash of the accounts without code
+var emptyCodeHash = types.BytesToHash(crypto.Keccak256(nil))
+
+// snapPeer is a peer the state is downloaded from
+type snapPeer struct {
+	id     peer.ID
+	client proto.SnapSyncClient
+}
+
+// snapTask is a unit of work of the state download, run with the client of one of the peers.
+// It returns the task continuing the work, if any
+type snapTask func(client proto.SnapSyncClient) (snapTask, error)
+
+// rangeFetcher is the method of the client fetching a range of a trie
+type rangeFetcher func(
+	proto.SnapSyncClient,
+	context.Context,
+	*proto.GetRangeRequest,
+	...rawGrpc.CallOption,
+) (*proto.TrieRange, error)
+
+// snapSyncer downloads the state at a recent block from the peers, verifying the range proofs of the tries,
+// and writes the block as the head of the empty chain, so that the blocks after it are synced normally
+type snapSyncer struct {
+	logger     hclog.Logger
+	blockchain Blockchain
+	storage    itrie.Storage
+	epochSize  uint64
+
+	// dial opens the connection to the snap protocol of the peer
+	dial func(peer.ID) (*rawGrpc.ClientConn, error)
+}
+
+func newSnapSyncer(
+	logger hclog.Logger,
+	network Network,
+	blockchain Blockchain,
+	stateStorage itrie.Storage,
+	epochSize uint64,
+) *snapSyncer {
+	return &snapSyncer{
+		logger:     logger.Named(snapSyncerName),
+		blockchain: blockchain,
+		storage:    stateStorage,
+		epochSize:  epochSize,
+		dial: func(peerID peer.ID) (*rawGrpc.ClientConn, error) {
+			return network.NewProtoConnection(snapProto, peerID)
+		},
+	}
+}
+
+// Sync downloads the state at the pivot block from the given peers and writes the block
+// as the head of the chain. The first peers are asked for the block and have to agree on it,
+// and the block is verified by the consensus before any state is downloaded
+func (s *snapSyncer) Sync(peers []*NoForkPeer, pivot uint64) (*types.Block, error) {
+	clients := make([]*snapPeer, 0, len(peers))
+
+	for _, p := range peers {
+		conn, err := s.dial(p.ID)
+		if err != nil {
+			s.logger.Warn("failed to connect to peer", "id", p.ID, "err", err)
+
+			continue
+		}
+
+		defer conn.Close()
+
+		clients = append(clients, &snapPeer{id: p.ID, client: proto.NewSnapSyncClient(conn)})
+	}
+
+	if len(clients) == 0 {
+		return nil, errNoSnapPeers
+	}
+
+	anchor, receipts, td, err := s.fetchAnchor(clients, pivot)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := s.fetchAncestors(clients, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	// the ancestors are linked to the anchor, so they're verified along with it
+	if err := s.blockchain.VerifyAnchor(anchor.Header, s.headerGetter(clients, ancestors, anchor)); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidAnchor, err)
+	}
+
+	// the validators of the epoch are read from the state at the end of the previous one
+	roots := []types.Hash{anchor.Header.StateRoot}
+
+	if s.epochSize != 0 && pivot >= s.epochSize {
+		epochEnd := (pivot/s.epochSize)*s.epochSize - 1
+
+		if root := ancestors[epochEnd-ancestors[0].Number].StateRoot; root != roots[0] {
+			roots = append(roots, root)
+		}
+	}
+
+	for _, root := range roots {
+		s.logger.Info("downloading state", "root", root, "peers", len(clients))
+
+		if err := s.downloadState(clients, root); err != nil {
+			return nil, fmt.Errorf("unable to download state %s: %w", root, err)
+		}
+	}
+
+	if err := s.blockchain.WriteAnchor(ancestors, anchor, receipts, td); err != nil {
+		return nil, err
+	}
+
+	return anchor, nil
+}
+
+// fetchAnchor fetches the pivot block with its receipts and total difficulty
+// from the first peers, which have to return the same block
+func (s *snapSyncer) fetchAnchor(
+	clients []*snapPeer,
+	pivot uint64,
+) (*types.Block, []*types.Receipt, *big.Int, error) {
+	var (
+		anchor   *types.Block
+		receipts types.Receipts
+		td       *big.Int
+		lastErr  = errNoSnapPeers
+	)
+
+	if len(clients) > snapAnchorPeers {
+		clients = clients[:snapAnchorPeers]
+	}
+
+	for _, p := range clients {
+		ctx, cancel := context.WithTimeout(context.Background(), snapRequestTimeout)
+		resp, err := p.client.GetAnchor(ctx, &proto.GetAnchorRequest{Number: pivot})
+
+		cancel()
+
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		block := &types.Block{}
+		if err := block.UnmarshalRLP(resp.Block); err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %v", errInvalidAnchor, err)
+		}
+
+		if block.Number() != pivot {
+			return nil, nil, nil, fmt.Errorf("%w: expected block %d but got %d", errInvalidAnchor, pivot, block.Number())
+		}
+
+		if anchor != nil {
+			if block.Hash() != anchor.Hash() {
+				return nil, nil, nil, errAnchorMismatch
+			}
+
+			continue
+		}
+
+		blockReceipts := types.Receipts{}
+		if err := blockReceipts.UnmarshalStoreRLP(resp.Receipts); err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: %v", errInvalidAnchor, err)
+		}
+
+		anchor, receipts, td = block, blockReceipts, new(big.Int).SetBytes(resp.TotalDifficulty)
+	}
+
+	if anchor == nil {
+		return nil, nil, nil, lastErr
+	}
+
+	return anchor, receipts, td, nil
+}
+
+// fetchAncestors fetches the headers written before the anchor block, checking they are linked to it
+func (s *snapSyncer) fetchAncestors(clients []*snapPeer, anchor *types.Block) ([]*types.Header, error) {
+	var (
+		from      = blockchain.AnchorAncestorsFrom(anchor.Number(), s.epochSize)
+		ancestors = make([]*types.Header, 0, anchor.Number()-from)
+		lastErr   error
+	)
+
+	for _, p := range clients {
+		for next := from + uint64(len(ancestors)); next < anchor.Number(); next = from + uint64(len(ancestors)) {
+			headers, err := fetchHeaders(p.client, next, anchor.Number()-1)
+			if err != nil {
+				lastErr = err
+
+				break
+			}
+
+			ancestors = append(ancestors, headers...)
+		}
+
+		if from+uint64(len(ancestors)) == anchor.Number() {
+			break
+		}
+	}
+
+	if from+uint64(len(ancestors)) != anchor.Number() {
+		return nil, lastErr
+	}
+
+	headers := make([]*types.Header, 0, len(ancestors)+1)
+	headers = append(headers, ancestors...)
+	headers = append(headers, anchor.Header)
+
+	for i := 1; i < len(headers); i++ {
+		if headers[i].ParentHash != headers[i-1].Hash {
+			return nil, fmt.Errorf("%w: block %d is not the parent of the next one", errInvalidHeaders, headers[i-1].Number)
+		}
+	}
+
+	return ancestors, nil
+}
+
+// headerGetter returns the function getting the header of a block before the anchor,
+// from the ancestors if they include it or else from the peers
+func (s *snapSyncer) headerGetter(
+	clients []*snapPeer,
+	ancestors []*types.Header,
+	anchor *types.Block,
+) func(uint64) (*types.Header, error) {
+	return func(number uint64) (*types.Header, error) {
+		if number >= anchor.Number() {
+			return nil, fmt.Errorf("%w: block %d isn't before the anchor", errInvalidHeaders, number)
+		}
+
+		if len(ancestors) > 0 && number >= ancestors[0].Number {
+			return ancestors[number-ancestors[0].Number], nil
+		}
+
+		lastErr := errNoSnapPeers
+
+		for _, p := range clients {
+			headers, err := fetchHeaders(p.client, number, number)
+			if err != nil {
+				lastErr = err
+
+				continue
+			}
+
+			return headers[0], nil
+		}
+
+		return nil, lastErr
+	}
+}
+
+// fetchHeaders fetches the headers of a range of blocks from the peer,
+// which may return less than requested
+func fetchHeaders(client proto.SnapSyncClient, from, to uint64) ([]*types.Header, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), snapRequestTimeout)
+	defer cancel()
+
+	resp, err := client.GetHeaders(ctx, &proto.GetHeadersRequest{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Headers) == 0 || uint64(len(resp.Headers)) > to-from+1 {
+		return nil, fmt.Errorf("%w: unexpected number of headers %d", errInvalidHeaders, len(resp.Headers))
+	}
+
+	headers := make([]*types.Header, len(resp.Headers))
+
+	for i, data := range resp.Headers {
+		header := &types.Header{}
+		if err := header.UnmarshalRLP(data); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidHeaders, err)
+		}
+
+		if header.Number != from+uint64(i) {
+			return nil, fmt.Errorf("%w: expected block %d but got %d", errInvalidHeaders, from+uint64(i), header.Number)
+		}
+
+		headers[i] = header
+	}
+
+	return headers, nil
+}
+
+// downloadState downloads the state with the given root into the state storage.
+// The storage tries and the contract code are written first and the account trie last,
+// so that the state is complete once its root is found in the storage
+func (s *snapSyncer) downloadState(clients []*snapPeer, root types.Hash) error {
+	if _, ok := s.storage.Get(root.Bytes()); ok || root == types.EmptyRootHash {
+		return nil
+	}
+
+	// the account trie is split into intervals of the key space downloaded in parallel
+	var (
+		keys   = make([][][]byte, snapAccountTasks)
+		values = make([][][]byte, snapAccountTasks)
+		tasks  = make([]snapTask, snapAccountTasks)
+	)
+
+	for i := range tasks {
+		origin := make([]byte, types.HashLength)
+		origin[0] = byte(i * 256 / snapAccountTasks)
+
+		var end []byte
+		if i < snapAccountTasks-1 {
+			end = make([]byte, types.HashLength)
+			end[0] = byte((i + 1) * 256 / snapAccountTasks)
+		}
+
+		tasks[i] = newRangeTask(
+			proto.SnapSyncClient.GetAccountRange,
+			root,
+			origin,
+			end,
+			&keys[i],
+			&values[i],
+			nil,
+		)
+	}
+
+	if err := s.runTasks(clients, tasks); err != nil {
+		return err
+	}
+
+	var (
+		accountKeys   = [][]byte{}
+		accountValues = [][]byte{}
+		storageRoots  = map[types.Hash]struct{}{}
+		codeHashes    = map[types.Hash]struct{}{}
+	)
+
+	for i := range keys {
+		accountKeys = append(accountKeys, keys[i]...)
+		accountValues = append(accountValues, values[i]...)
+	}
+
+	for _, value := range accountValues {
+		account := &state.Account{}
+		if err := account.UnmarshalRlp(value); err != nil {
+			return err
+		}
+
+		if account.Root != types.EmptyRootHash && account.Root != types.ZeroHash {
+			if _, ok := s.storage.Get(account.Root.Bytes()); !ok {
+				storageRoots[account.Root] = struct{}{}
+			}
+		}
+
+		if codeHash := types.BytesToHash(account.CodeHash); codeHash != emptyCodeHash && codeHash != types.ZeroHash {
+			if _, ok := s.storage.GetCode(codeHash); !ok {
+				codeHashes[codeHash] = struct{}{}
+			}
+		}
+	}
+
+	s.logger.Info(
+		"downloaded accounts",
+		"root", root,
+		"accounts", len(accountKeys),
+		"storage tries", len(storageRoots),
+		"codes", len(codeHashes),
+	)
+
+	tasks = make([]snapTask, 0, len(storageRoots)+len(codeHashes)/snapByteCodesBatch+1)
+
+	for storageRoot := range storageRoots {
+		tasks = append(tasks, s.newStorageTask(storageRoot))
+	}
+
+	hashes := make([]types.Hash, 0, snapByteCodesBatch)
+
+	for codeHash := range codeHashes {
+		if hashes = append(hashes, codeHash); len(hashes) == snapByteCodesBatch {
+			tasks = append(tasks, s.newByteCodesTask(hashes))
+			hashes = make([]types.Hash, 0, snapByteCodesBatch)
+		}
+	}
+
+	if len(hashes) > 0 {
+		tasks = append(tasks, s.newByteCodesTask(hashes))
+	}
+
+	if err := s.runTasks(clients, tasks); err != nil {
+		return err
+	}
+
+	if hash := itrie.BuildTrie(s.storage, accountKeys, accountValues); hash != root {
+		return fmt.Errorf("%w: account trie %s", itrie.ErrRangeRootMismatch, hash)
+	}
+
+	return nil
+}
+
+// newStorageTask returns the task downloading the storage trie with the given root
+func (s *snapSyncer) newStorageTask(root types.Hash) snapTask {
+	var keys, values [][]byte
+
+	return newRangeTask(
+		proto.SnapSyncClient.GetStorageRange,
+		root,
+		make([]byte, types.HashLength),
+		nil,
+		&keys,
+		&values,
+		func() error {
+			if hash := itrie.BuildTrie(s.storage, keys, values); hash != root {
+				return fmt.Errorf("%w: storage trie %s", itrie.ErrRangeRootMismatch, hash)
+			}
+
+			return nil
+		},
+	)
+}
+
+// newRangeTask returns the task downloading the entries of a trie from the origin until the end,
+// or the last entry if the end is nil, and calling done once all of them are downloaded
+func newRangeTask(
+	getRange rangeFetcher,
+	root types.Hash,
+	origin []byte,
+	end []byte,
+	keys *[][]byte,
+	values *[][]byte,
+	done func() error,
+) snapTask {
+	// the entries downloaded by a failed run of the task are discarded when it is run again
+	size := len(*keys)
+
+	return func(client proto.SnapSyncClient) (snapTask, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), snapRequestTimeout)
+		defer cancel()
+
+		resp, err := getRange(client, ctx, &proto.GetRangeRequest{
+			Root:   root.Bytes(),
+			Origin: origin,
+			Limit:  snapRangeLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		more, err := itrie.VerifyRangeProof(root, origin, resp.Keys, resp.Values, resp.Proof)
+		if err != nil {
+			return nil, err
+		}
+
+		rangeKeys, rangeValues := resp.Keys, resp.Values
+
+		// the entries after the end belong to the next interval
+		for i, key := range rangeKeys {
+			if end != nil && bytes.Compare(key, end) >= 0 {
+				rangeKeys, rangeValues, more = rangeKeys[:i], rangeValues[:i], false
+
+				break
+			}
+		}
+
+		*keys = append((*keys)[:size], rangeKeys...)
+		*values = append((*values)[:size], rangeValues...)
+
+		if more && len(rangeKeys) > 0 {
+			next := new(big.Int).Add(new(big.Int).SetBytes(rangeKeys[len(rangeKeys)-1]), big.NewInt(1))
+
+			return newRangeTask(getRange, root, types.BytesToHash(next.Bytes()).Bytes(), end, keys, values, done), nil
+		}
+
+		if done != nil {
+			return nil, done()
+		}
+
+		return nil, nil
+	}
+}
+
+// newByteCodesTask returns the task downloading the contract code with the given hashes
+func (s *snapSyncer) newByteCodesTask(hashes []types.Hash) snapTask {
+	return func(client proto.SnapSyncClient) (snapTask, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), snapRequestTimeout)
+		defer cancel()
+
+		req := &proto.GetByteCodesRequest{
+			Hashes: make([][]byte, len(hashes)),
+		}
+
+		for i, hash := range hashes {
+			req.Hashes[i] = hash.Bytes()
+		}
+
+		resp, err := client.GetByteCodes(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining := make(map[types.Hash]struct{}, len(hashes))
+		for _, hash := range hashes {
+			remaining[hash] = struct{}{}
+		}
+
+		for _, code := range resp.Codes {
+			hash := types.BytesToHash(crypto.Keccak256(code))
+			if _, ok := remaining[hash]; !ok {
+				return nil, fmt.Errorf("unexpected contract code %s", hash)
+			}
+
+			s.storage.SetCode(hash, code)
+			delete(remaining, hash)
+		}
+
+		if len(remaining) == 0 {
+			return nil, nil
+		}
+
+		if len(remaining) == len(hashes) {
+			return nil, errInvalidByteCodes
+		}
+
+		next := make([]types.Hash, 0, len(remaining))
+		for _, hash := range hashes {
+			if _, ok := remaining[hash]; ok {
+				next = append(next, hash)
+			}
+		}
+
+		return s.newByteCodesTask(next), nil
+	}
+}
+
+// runTasks runs the tasks with the clients of the peers in parallel until all of them are done.
+// A failed task is run again, possibly with another peer,
+// and the peers failing repeatedly are not used anymore
+func (s *snapSyncer) runTasks(clients []*snapPeer, tasks []snapTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	var (
+		// each task is replaced by at most one task, so the queue never blocks
+		queue   = make(chan snapTask, len(tasks))
+		pending = int64(len(tasks))
+		doneCh  = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+
+	for _, task := range tasks {
+		queue <- task
+	}
+
+	for _, p := range clients {
+		p := p
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			failures := 0
+
+			for {
+				select {
+				case <-doneCh:
+					return
+				case task := <-queue:
+					next, err := task(p.client)
+					if err != nil {
+						queue <- task
+
+						if failures++; failures == maxSnapPeerFailures {
+							s.logger.Warn("failed to download the state from peer, skip", "id", p.id, "err", err)
+
+							return
+						}
+
+						continue
+					}
+
+					failures = 0
+
+					if next != nil {
+						queue <- next
+
+						continue
+					}
+
+					if atomic.AddInt64(&pending, -1) == 0 {
+						close(doneCh)
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if atomic.LoadInt64(&pending) != 0 {
+		return errUnfinishedSnapTasks
+	}
+
+	return nil
+}
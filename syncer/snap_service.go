@@ -0,0 +1,188 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/LaChain/polygon-edge/network/grpc"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/syncer/proto"
+	"github.com/LaChain/polygon-edge/types"
+)
+
+const (
+	// maxSnapRangeLimit is the maximum number of trie entries served at once
+	maxSnapRangeLimit = 4096
+	// maxSnapHeaders is the maximum number of headers served at once
+	maxSnapHeaders = 1024
+	// maxSnapByteCodesSize is the size of the contract code after which no more code is served at once
+	maxSnapByteCodesSize = 2 * 1024 * 1024
+)
+
+var (
+	ErrStateNotAvailable = errors.New("state not available")
+	ErrInvalidSnapRange  = errors.New("invalid range")
+)
+
+// snapService serves the state and the blocks it belongs to,
+// to the peers downloading the state by snap sync
+type snapService struct {
+	proto.UnimplementedSnapSyncServer
+
+	blockchain Blockchain       // reference to the blockchain module
+	network    Network          // reference to the network module
+	state      *itrie.State     // reference to the state served to the peers
+	stream     *grpc.GrpcStream // reference to the grpc stream
+}
+
+func newSnapService(
+	network Network,
+	blockchain Blockchain,
+	stateStorage itrie.Storage,
+) *snapService {
+	return &snapService{
+		blockchain: blockchain,
+		network:    network,
+		state:      itrie.NewState(stateStorage),
+	}
+}
+
+// Start starts snapService
+func (s *snapService) Start() {
+	s.stream = grpc.NewGrpcStream()
+
+	proto.RegisterSnapSyncServer(s.stream.GrpcServer(), s)
+	s.stream.Serve()
+	s.network.RegisterProtocol(snapProto, s.stream)
+}
+
+// Close closes snapService
+func (s *snapService) Close() error {
+	return s.stream.Close()
+}
+
+// GetAnchor is a gRPC endpoint to return the block the state is downloaded at
+func (s *snapService) GetAnchor(
+	ctx context.Context,
+	req *proto.GetAnchorRequest,
+) (*proto.Anchor, error) {
+	block, ok := s.blockchain.GetBlockByNumber(req.Number, true)
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	receipts, err := s.blockchain.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the receipts of block %d: %w", req.Number, err)
+	}
+
+	td, ok := s.blockchain.GetTD(block.Hash())
+	if !ok {
+		return nil, fmt.Errorf("total difficulty of block %d not found", req.Number)
+	}
+
+	return &proto.Anchor{
+		Block:           block.MarshalRLP(),
+		Receipts:        types.Receipts(receipts).MarshalStoreRLPTo(nil),
+		TotalDifficulty: td.Bytes(),
+	}, nil
+}
+
+// GetHeaders is a gRPC endpoint to return the headers of a range of blocks
+func (s *snapService) GetHeaders(
+	ctx context.Context,
+	req *proto.GetHeadersRequest,
+) (*proto.Headers, error) {
+	if req.From > req.To {
+		return nil, ErrInvalidSnapRange
+	}
+
+	to := req.To
+	if to-req.From >= maxSnapHeaders {
+		to = req.From + maxSnapHeaders - 1
+	}
+
+	headers := make([][]byte, 0, to-req.From+1)
+
+	for i := req.From; i <= to; i++ {
+		header, ok := s.blockchain.GetHeaderByNumber(i)
+		if !ok {
+			return nil, ErrBlockNotFound
+		}
+
+		headers = append(headers, header.MarshalRLP())
+	}
+
+	return &proto.Headers{
+		Headers: headers,
+	}, nil
+}
+
+// GetAccountRange is a gRPC endpoint to return a range of the accounts of the state trie
+func (s *snapService) GetAccountRange(
+	ctx context.Context,
+	req *proto.GetRangeRequest,
+) (*proto.TrieRange, error) {
+	return s.getRange(req)
+}
+
+// GetStorageRange is a gRPC endpoint to return a range of the slots of a storage trie
+func (s *snapService) GetStorageRange(
+	ctx context.Context,
+	req *proto.GetRangeRequest,
+) (*proto.TrieRange, error) {
+	return s.getRange(req)
+}
+
+// getRange returns the range of the requested trie with its proof
+func (s *snapService) getRange(req *proto.GetRangeRequest) (*proto.TrieRange, error) {
+	if len(req.Root) != types.HashLength || len(req.Origin) != types.HashLength || req.Limit == 0 {
+		return nil, ErrInvalidSnapRange
+	}
+
+	limit := req.Limit
+	if limit > maxSnapRangeLimit {
+		limit = maxSnapRangeLimit
+	}
+
+	keys, values, proof, err := s.state.GetRange(types.BytesToHash(req.Root), req.Origin, int(limit))
+	if err != nil {
+		// the state has been pruned or was never stored
+		return nil, fmt.Errorf("%w: %v", ErrStateNotAvailable, err)
+	}
+
+	return &proto.TrieRange{
+		Keys:   keys,
+		Values: values,
+		Proof:  proof,
+	}, nil
+}
+
+// GetByteCodes is a gRPC endpoint to return contract code
+func (s *snapService) GetByteCodes(
+	ctx context.Context,
+	req *proto.GetByteCodesRequest,
+) (*proto.ByteCodes, error) {
+	var (
+		codes = make([][]byte, 0, len(req.Hashes))
+		size  = 0
+	)
+
+	for _, hash := range req.Hashes {
+		code, ok := s.state.GetCode(types.BytesToHash(hash))
+		if !ok {
+			continue
+		}
+
+		codes = append(codes, code)
+
+		if size += len(code); size >= maxSnapByteCodesSize {
+			break
+		}
+	}
+
+	return &proto.ByteCodes{
+		Codes: codes,
+	}, nil
+}
@@ -0,0 +1,392 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/LaChain/polygon-edge/blockchain"
+	"github.com/LaChain/polygon-edge/crypto"
+	"github.com/LaChain/polygon-edge/state"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
+	"github.com/LaChain/polygon-edge/syncer/proto"
+	"github.com/LaChain/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newSnapTestChain returns a chain of the given length served by a mock blockchain, whose blocks
+// before the switch have the state with many accounts and the ones after it a modified state
+func newSnapTestChain(t *testing.T, length int, switchAt int) (*mockBlockchain, itrie.Storage, []*types.Header) {
+	t.Helper()
+
+	var (
+		stateStorage = itrie.NewMemoryStorage()
+		objects      = make([]*state.Object, 0, 503)
+		code         = []byte{0x1, 0x2, 0x3}
+	)
+
+	for i := 0; i < 500; i++ {
+		objects = append(objects, &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i + 1)).Bytes()),
+			Balance:  big.NewInt(int64(i + 1)),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+		})
+	}
+
+	// contracts sharing the code, one of them with more slots than requested at once
+	for i := 0; i < 3; i++ {
+		storage := make([]*state.StorageObject, 0, 1500)
+		for j := 0; j < 500*(i+1); j++ {
+			storage = append(storage, &state.StorageObject{
+				Key: types.BytesToHash(big.NewInt(int64(j + 1)).Bytes()).Bytes(),
+				Val: types.BytesToHash(big.NewInt(int64(i + 1)).Bytes()).Bytes(),
+			})
+		}
+
+		objects = append(objects, &state.Object{
+			Address:   types.StringToAddress("0xc0" + string(rune('1'+i))),
+			Balance:   big.NewInt(0),
+			Root:      types.EmptyRootHash,
+			CodeHash:  types.BytesToHash(crypto.Keccak256(code)),
+			Code:      code,
+			DirtyCode: true,
+			Storage:   storage,
+		})
+	}
+
+	snapshot, root := itrie.NewState(stateStorage).NewSnapshot().Commit(objects)
+
+	_, modifiedRoot := snapshot.Commit([]*state.Object{
+		{
+			Address:  types.StringToAddress("0xfff"),
+			Balance:  big.NewInt(1),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.BytesToHash(crypto.Keccak256(nil)),
+		},
+	})
+
+	headers := blockchain.NewTestHeaders(length)
+	for i, header := range headers {
+		header.StateRoot = types.BytesToHash(root)
+		if i >= switchAt {
+			header.StateRoot = types.BytesToHash(modifiedRoot)
+		}
+
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash
+		}
+
+		header.ComputeHash()
+	}
+
+	chain := &mockBlockchain{
+		getHeaderByNumberHandler: func(number uint64) (*types.Header, bool) {
+			if number >= uint64(len(headers)) {
+				return nil, false
+			}
+
+			return headers[number], true
+		},
+		getBlockByNumberHandler: func(number uint64, full bool) (*types.Block, bool) {
+			if number >= uint64(len(headers)) {
+				return nil, false
+			}
+
+			return &types.Block{Header: headers[number]}, true
+		},
+		getReceiptsByHashHandler: func(types.Hash) ([]*types.Receipt, error) {
+			return []*types.Receipt{}, nil
+		},
+		getTDHandler: func(hash types.Hash) (*big.Int, bool) {
+			return big.NewInt(100), true
+		},
+	}
+
+	return chain, stateStorage, headers
+}
+
+// newSnapTestDialer returns the function connecting to the snap services of the peers
+func newSnapTestDialer(t *testing.T, services map[peer.ID]*snapService) func(peer.ID) (*grpc.ClientConn, error) {
+	t.Helper()
+
+	listeners := make(map[peer.ID]*bufconn.Listener, len(services))
+
+	for id, service := range services {
+		lis := bufconn.Listen(bufSize)
+		s := grpc.NewServer()
+		proto.RegisterSnapSyncServer(s, service)
+
+		go func() {
+			_ = s.Serve(lis)
+		}()
+
+		t.Cleanup(s.Stop)
+
+		listeners[id] = lis
+	}
+
+	return func(id peer.ID) (*grpc.ClientConn, error) {
+		lis := listeners[id]
+
+		return grpc.DialContext(context.Background(), "bufnet",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(
+				func(ctx context.Context, address string) (net.Conn, error) {
+					return lis.Dial()
+				},
+			),
+		)
+	}
+}
+
+func Test_snapService(t *testing.T) {
+	t.Parallel()
+
+	chain, stateStorage, headers := newSnapTestChain(t, 1100, 1100)
+	service := newSnapService(nil, chain, stateStorage)
+
+	conn, err := newSnapTestDialer(t, map[peer.ID]*snapService{"A": service})("A")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	client := proto.NewSnapSyncClient(conn)
+	ctx := context.Background()
+
+	t.Run("should return the anchor block", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := client.GetAnchor(ctx, &proto.GetAnchorRequest{Number: 10})
+		assert.NoError(t, err)
+
+		block := &types.Block{}
+		assert.NoError(t, block.UnmarshalRLP(resp.Block))
+		assert.Equal(t, headers[10].Hash, block.Hash())
+		assert.Equal(t, big.NewInt(100).Bytes(), resp.TotalDifficulty)
+
+		_, err = client.GetAnchor(ctx, &proto.GetAnchorRequest{Number: 1100})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return the headers up to the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := client.GetHeaders(ctx, &proto.GetHeadersRequest{From: 5, To: 8})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Headers, 4)
+
+		resp, err = client.GetHeaders(ctx, &proto.GetHeadersRequest{From: 1, To: 1099})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Headers, maxSnapHeaders)
+
+		_, err = client.GetHeaders(ctx, &proto.GetHeadersRequest{From: 8, To: 5})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return the ranges with proofs", func(t *testing.T) {
+		t.Parallel()
+
+		root := headers[0].StateRoot
+		origin := make([]byte, types.HashLength)
+
+		resp, err := client.GetAccountRange(ctx, &proto.GetRangeRequest{
+			Root:   root.Bytes(),
+			Origin: origin,
+			Limit:  100000,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Keys, 503)
+
+		more, err := itrie.VerifyRangeProof(root, origin, resp.Keys, resp.Values, resp.Proof)
+		assert.NoError(t, err)
+		assert.False(t, more)
+
+		// unknown state
+		_, err = client.GetStorageRange(ctx, &proto.GetRangeRequest{
+			Root:   types.StringToHash("1").Bytes(),
+			Origin: origin,
+			Limit:  10,
+		})
+		assert.Error(t, err)
+
+		// invalid origin
+		_, err = client.GetAccountRange(ctx, &proto.GetRangeRequest{
+			Root:   root.Bytes(),
+			Origin: []byte{0x1},
+			Limit:  10,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return the known contract code", func(t *testing.T) {
+		t.Parallel()
+
+		code := []byte{0x1, 0x2, 0x3}
+
+		resp, err := client.GetByteCodes(ctx, &proto.GetByteCodesRequest{
+			Hashes: [][]byte{
+				types.StringToHash("1").Bytes(),
+				crypto.Keccak256(code),
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{code}, resp.Codes)
+	})
+}
+
+func Test_snapSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize = 10
+		pivot     = 75
+	)
+
+	newSyncer := func(
+		t *testing.T,
+		services map[peer.ID]*snapService,
+		verifyErr error,
+	) (*snapSyncer, itrie.Storage, *mockAnchor) {
+		t.Helper()
+
+		var (
+			stateStorage = itrie.NewMemoryStorage()
+			anchor       = &mockAnchor{}
+		)
+
+		chain := &mockBlockchain{
+			writeAnchorHandler: func(
+				ancestors []*types.Header,
+				block *types.Block,
+				receipts []*types.Receipt,
+				td *big.Int,
+			) error {
+				anchor.ancestors, anchor.block, anchor.td = ancestors, block, td
+
+				return nil
+			},
+			verifyAnchorHandler: func(header *types.Header, getHeader func(uint64) (*types.Header, error)) error {
+				// the first block of the epoch is verified along with the anchor
+				epochBegin, err := getHeader((header.Number / epochSize) * epochSize)
+				if err != nil {
+					return err
+				}
+
+				anchor.verified, anchor.epochBegin = header, epochBegin
+
+				return verifyErr
+			},
+		}
+
+		return &snapSyncer{
+			logger:     hclog.NewNullLogger(),
+			blockchain: chain,
+			storage:    stateStorage,
+			epochSize:  epochSize,
+			dial:       newSnapTestDialer(t, services),
+		}, stateStorage, anchor
+	}
+
+	t.Run("should download the states from the peers", func(t *testing.T) {
+		t.Parallel()
+
+		chain, stateStorage, headers := newSnapTestChain(t, 100, 70)
+
+		services := map[peer.ID]*snapService{
+			"A": newSnapService(nil, chain, stateStorage),
+			"B": newSnapService(nil, chain, stateStorage),
+		}
+
+		syncer, downloaded, anchor := newSyncer(t, services, nil)
+
+		block, err := syncer.Sync([]*NoForkPeer{{ID: "A"}, {ID: "B"}}, pivot)
+		assert.NoError(t, err)
+		assert.Equal(t, headers[pivot].Hash, block.Hash())
+
+		// the state of the anchor and the one at the end of the previous epoch
+		st := itrie.NewState(downloaded)
+		assert.NoError(t, st.VerifyState(headers[pivot].StateRoot))
+		assert.NoError(t, st.VerifyState(headers[69].StateRoot))
+
+		assert.Equal(t, headers[pivot].Hash, anchor.block.Hash())
+		assert.Equal(t, headers[pivot].Hash, anchor.verified.Hash)
+		assert.Equal(t, headers[70].Hash, anchor.epochBegin.Hash)
+		assert.Equal(t, big.NewInt(100), anchor.td)
+		assert.Len(t, anchor.ancestors, pivot)
+		assert.Equal(t, headers[0].Hash, anchor.ancestors[0].Hash)
+	})
+
+	t.Run("should fail if the peers return different anchor blocks", func(t *testing.T) {
+		t.Parallel()
+
+		chain, stateStorage, _ := newSnapTestChain(t, 100, 70)
+		otherChain, otherStorage, _ := newSnapTestChain(t, 100, 80)
+
+		services := map[peer.ID]*snapService{
+			"A": newSnapService(nil, chain, stateStorage),
+			"B": newSnapService(nil, otherChain, otherStorage),
+		}
+
+		syncer, _, anchor := newSyncer(t, services, nil)
+
+		_, err := syncer.Sync([]*NoForkPeer{{ID: "A"}, {ID: "B"}}, pivot)
+		assert.ErrorIs(t, err, errAnchorMismatch)
+		assert.Nil(t, anchor.block)
+	})
+
+	t.Run("should fail if the consensus doesn't verify the anchor block", func(t *testing.T) {
+		t.Parallel()
+
+		chain, stateStorage, headers := newSnapTestChain(t, 100, 70)
+
+		services := map[peer.ID]*snapService{
+			"A": newSnapService(nil, chain, stateStorage),
+			"B": newSnapService(nil, chain, stateStorage),
+		}
+
+		syncer, downloaded, anchor := newSyncer(t, services, errors.New("invalid committed seals"))
+
+		_, err := syncer.Sync([]*NoForkPeer{{ID: "A"}, {ID: "B"}}, pivot)
+		assert.ErrorIs(t, err, errInvalidAnchor)
+		assert.Nil(t, anchor.block)
+
+		// no state is downloaded before the anchor is verified
+		_, ok := downloaded.Get(headers[pivot].StateRoot.Bytes())
+		assert.False(t, ok)
+	})
+
+	t.Run("should fail if no peer has the state", func(t *testing.T) {
+		t.Parallel()
+
+		chain, _, _ := newSnapTestChain(t, 100, 70)
+
+		services := map[peer.ID]*snapService{
+			"A": newSnapService(nil, chain, itrie.NewMemoryStorage()),
+		}
+
+		syncer, _, anchor := newSyncer(t, services, nil)
+
+		_, err := syncer.Sync([]*NoForkPeer{{ID: "A"}}, pivot)
+		assert.ErrorIs(t, err, errUnfinishedSnapTasks)
+		assert.Nil(t, anchor.block)
+	})
+}
+
+type mockAnchor struct {
+	ancestors []*types.Header
+	block     *types.Block
+	td        *big.Int
+
+	verified   *types.Header
+	epochBegin *types.Header
+}
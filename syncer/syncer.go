@@ -7,6 +7,7 @@ import (
 
 	"github.com/LaChain/polygon-edge/helper/progress"
 	"github.com/LaChain/polygon-edge/network/event"
+	itrie "github.com/LaChain/polygon-edge/state/immutable-trie"
 	"github.com/LaChain/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -32,6 +33,18 @@ type syncer struct {
 	syncPeerService SyncPeerService
 	syncPeerClient  SyncPeerClient
 
+	// downloader downloads the blocks from several peers concurrently
+	downloader *blockDownloader
+
+	// snapService serves the state to the peers downloading it by snap sync
+	snapService SyncPeerService
+	// snapSyncer downloads the state from the peers, nil unless the chain is synced by snap sync
+	snapSyncer   *snapSyncer
+	snapAttempts int
+
+	// headerSyncer syncs only the headers, nil unless the node is a light node
+	headerSyncer *headerSyncer
+
 	// Timeout for syncing a block
 	blockTimeout time.Duration
 
@@ -44,17 +57,33 @@ func NewSyncer(
 	network Network,
 	blockchain Blockchain,
 	blockTimeout time.Duration,
+	stateStorage itrie.Storage,
+	syncMode SyncMode,
+	epochSize uint64,
 ) Syncer {
-	return &syncer{
+	syncPeerClient := NewSyncPeerClient(logger, network, blockchain)
+
+	s := &syncer{
 		logger:          logger.Named(syncerName),
 		blockchain:      blockchain,
 		syncProgression: progress.NewProgressionWrapper(progress.ChainSyncBulk),
 		syncPeerService: NewSyncPeerService(network, blockchain),
-		syncPeerClient:  NewSyncPeerClient(logger, network, blockchain),
+		syncPeerClient:  syncPeerClient,
+		downloader:      newBlockDownloader(logger, blockchain, syncPeerClient, blockTimeout),
+		snapService:     newSnapService(network, blockchain, stateStorage),
 		blockTimeout:    blockTimeout,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
 	}
+
+	switch syncMode {
+	case SnapSync:
+		s.snapSyncer = newSnapSyncer(logger, network, blockchain, stateStorage, epochSize)
+	case LightSync:
+		s.headerSyncer = newHeaderSyncer(logger, network, blockchain)
+	}
+
+	return s
 }
 
 // Start starts goroutine processes
@@ -63,7 +92,13 @@ func (s *syncer) Start() error {
 		return err
 	}
 
+	// light nodes don't have the blocks to serve to the peers
+	if s.headerSyncer != nil {
+		s.syncPeerClient.DisablePublishingPeerStatus()
+	}
+
 	s.syncPeerService.Start()
+	s.snapService.Start()
 
 	s.initializePeerMap()
 
@@ -81,6 +116,10 @@ func (s *syncer) Close() error {
 		return err
 	}
 
+	if err := s.snapService.Close(); err != nil {
+		return err
+	}
+
 	s.syncPeerClient.Close()
 
 	return nil
@@ -134,6 +173,7 @@ func (s *syncer) putToPeerMap(status *NoForkPeer) {
 // removeFromPeerMap removes the peer from peer map
 func (s *syncer) removeFromPeerMap(peerID peer.ID) {
 	s.peerMap.Remove(peerID)
+	s.downloader.RemovePeer(peerID)
 }
 
 // notifyNewStatusEvent emits signal to newStatusCh
@@ -186,8 +226,46 @@ func (s *syncer) Sync(callback func(*types.Block) bool) error {
 			continue
 		}
 
-		// fetch block from the peer
-		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, callback)
+		// download the state of a recent block instead of executing all the blocks of the empty chain
+		if s.snapSyncer != nil && localLatest == 0 && bestPeer.Number > snapPivotDistance {
+			if s.snapSync(bestPeer, callback) {
+				break
+			}
+
+			continue
+		}
+
+		var (
+			lastNumber      uint64
+			shouldTerminate bool
+			err             error
+		)
+
+		s.syncProgression.StartProgression(localLatest, s.blockchain.SubscribeEvents())
+		s.syncProgression.UpdateHighestProgression(bestPeer.Number)
+
+		peers := s.syncPeers(skipList, localLatest)
+
+		switch {
+		case s.headerSyncer != nil:
+			// fetch only the headers from the peer
+			lastNumber, shouldTerminate, err = s.headerSyncer.Sync(bestPeer.ID, bestPeer.Number, callback)
+		case len(peers) > 1:
+			var failed []peer.ID
+
+			// fetch blocks from all the peers concurrently
+			lastNumber, shouldTerminate, failed, err = s.downloader.Sync(peers, callback)
+
+			for _, id := range failed {
+				skipList[id] = true
+			}
+		default:
+			// fetch block from the peer
+			lastNumber, shouldTerminate, err = s.bulkSyncWithPeer(bestPeer.ID, callback)
+		}
+
+		s.syncProgression.StopProgression()
+
 		if err != nil {
 			s.logger.Warn("failed to complete bulk sync with peer, try to next one", "peer ID", "error", bestPeer.ID, err)
 		}
@@ -207,6 +285,60 @@ func (s *syncer) Sync(callback func(*types.Block) bool) error {
 	return nil
 }
 
+// syncPeers returns the peers having blocks after the local head, except the skipped ones
+func (s *syncer) syncPeers(skipList map[peer.ID]bool, localLatest uint64) []*NoForkPeer {
+	peers := make([]*NoForkPeer, 0)
+
+	s.peerMap.Range(func(key, value interface{}) bool {
+		if p, ok := value.(*NoForkPeer); ok && !skipList[p.ID] && p.Number > localLatest {
+			peers = append(peers, p)
+		}
+
+		return true
+	})
+
+	return peers
+}
+
+// snapSync downloads the state at the pivot block behind the best peer from the peers having it,
+// and returns the result of the callback for the pivot block.
+// Snap sync is not attempted anymore once it succeeds or fails too many times
+func (s *syncer) snapSync(bestPeer *NoForkPeer, callback func(*types.Block) bool) bool {
+	pivot := bestPeer.Number - snapPivotDistance
+	peers := []*NoForkPeer{bestPeer}
+
+	s.peerMap.Range(func(key, value interface{}) bool {
+		if p, ok := value.(*NoForkPeer); ok && p.ID != bestPeer.ID && p.Number >= pivot {
+			peers = append(peers, p)
+		}
+
+		return true
+	})
+
+	s.snapAttempts++
+
+	s.logger.Info("snap syncing", "pivot", pivot, "peers", len(peers), "attempt", s.snapAttempts)
+
+	anchor, err := s.snapSyncer.Sync(peers, pivot)
+	if err != nil {
+		s.logger.Warn("failed to complete snap sync", "pivot", pivot, "err", err)
+
+		if s.snapAttempts == maxSnapSyncAttempts {
+			s.logger.Warn("falling back to full sync")
+
+			s.snapSyncer = nil
+		}
+
+		return false
+	}
+
+	s.logger.Info("snap sync completed", "number", anchor.Number(), "hash", anchor.Hash())
+
+	s.snapSyncer = nil
+
+	return callback(anchor)
+}
+
 // bulkSyncWithPeer syncs block with a given peer
 func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.Block) bool) (uint64, bool, error) {
 	localLatest := s.blockchain.Header().Number
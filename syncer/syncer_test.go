@@ -36,11 +36,18 @@ func (m *mockProgression) GetProgression() *progress.Progression {
 }
 
 type mockBlockchain struct {
-	subscription                blockchain.Subscription
-	headerHandler               func() *types.Header
-	getBlockByNumberHandler     func(uint64, bool) (*types.Block, bool)
-	verifyFinalizedBlockHandler func(*types.Block) error
-	writeBlockHandler           func(*types.Block) error
+	subscription                 blockchain.Subscription
+	headerHandler                func() *types.Header
+	getBlockByNumberHandler      func(uint64, bool) (*types.Block, bool)
+	verifyFinalizedBlockHandler  func(*types.Block) error
+	writeBlockHandler            func(*types.Block) error
+	getHeaderByNumberHandler     func(uint64) (*types.Header, bool)
+	getReceiptsByHashHandler     func(types.Hash) ([]*types.Receipt, error)
+	getTDHandler                 func(types.Hash) (*big.Int, bool)
+	writeAnchorHandler           func([]*types.Header, *types.Block, []*types.Receipt, *big.Int) error
+	verifyFinalizedHeaderHandler func(*types.Header) error
+	writeHeaderHandler           func(*types.Header) error
+	verifyAnchorHandler          func(*types.Header, func(uint64) (*types.Header, error)) error
 }
 
 func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
@@ -63,6 +70,39 @@ func (m *mockBlockchain) WriteBlock(b *types.Block, s string) error {
 	return m.writeBlockHandler(b)
 }
 
+func (m *mockBlockchain) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	return m.getHeaderByNumberHandler(number)
+}
+
+func (m *mockBlockchain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return m.getReceiptsByHashHandler(hash)
+}
+
+func (m *mockBlockchain) GetTD(hash types.Hash) (*big.Int, bool) {
+	return m.getTDHandler(hash)
+}
+
+func (m *mockBlockchain) WriteAnchor(
+	ancestors []*types.Header,
+	anchor *types.Block,
+	receipts []*types.Receipt,
+	td *big.Int,
+) error {
+	return m.writeAnchorHandler(ancestors, anchor, receipts, td)
+}
+
+func (m *mockBlockchain) VerifyFinalizedHeader(h *types.Header) error {
+	return m.verifyFinalizedHeaderHandler(h)
+}
+
+func (m *mockBlockchain) WriteHeader(h *types.Header, s string) error {
+	return m.writeHeaderHandler(h)
+}
+
+func (m *mockBlockchain) VerifyAnchor(h *types.Header, getHeader func(uint64) (*types.Header, error)) error {
+	return m.verifyAnchorHandler(h, getHeader)
+}
+
 func newSimpleHeaderHandler(num uint64) func() *types.Header {
 	return func() *types.Header {
 		return &types.Header{
@@ -85,6 +125,7 @@ type mockSyncPeerClient struct {
 	getPeerStatusHandler                  func(peer.ID) (*NoForkPeer, error)
 	getConnectedPeerStatusesHandler       func() []*NoForkPeer
 	getBlocksHandler                      func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	getBlockRangeHandler                  func(peer.ID, uint64, uint64) ([]*types.Block, error)
 	getPeerStatusUpdateChHandler          func() <-chan *NoForkPeer
 	getPeerConnectionUpdateEventChHandler func() <-chan *event.PeerEvent
 }
@@ -115,6 +156,14 @@ func (m *mockSyncPeerClient) GetBlocks(
 	return m.getBlocksHandler(id, start, timeoutPerBlock)
 }
 
+func (m *mockSyncPeerClient) GetBlockRange(
+	id peer.ID,
+	from, to uint64,
+	timeoutPerBlock time.Duration,
+) ([]*types.Block, error) {
+	return m.getBlockRangeHandler(id, from, to)
+}
+
 func (m *mockSyncPeerClient) GetPeerStatusUpdateCh() <-chan *NoForkPeer {
 	return m.getPeerStatusUpdateChHandler()
 }
@@ -164,7 +213,9 @@ func NewTestSyncer(
 		blockchain:      blockchain,
 		syncProgression: mockProgression,
 		syncPeerService: &mockSyncPeerService{},
+		snapService:     &mockSyncPeerService{},
 		syncPeerClient:  mockSyncPeerClient,
+		downloader:      newBlockDownloader(hclog.NewNullLogger(), blockchain, mockSyncPeerClient, blockTimeout),
 		blockTimeout:    blockTimeout,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
@@ -502,6 +553,7 @@ func TestSync(t *testing.T) {
 		peerStatuses []*NoForkPeer
 
 		peerBlocksCh   map[peer.ID]<-chan *types.Block
+		peerBlocks     map[peer.ID][]*types.Block
 		newStatusDelay time.Duration
 
 		// handlers
@@ -538,10 +590,9 @@ func TestSync(t *testing.T) {
 					return nil
 				}
 			},
-			blocks: blocks[:10],
-			// TODO: need to fix implementation?
+			blocks:             blocks[:10],
 			progressionStart:   0,
-			progressionHighest: 0,
+			progressionHighest: 10,
 			err:                nil,
 		},
 		{
@@ -569,6 +620,10 @@ func TestSync(t *testing.T) {
 				peer.ID("A"): blocksToCh(blocks[:10], 0),
 				peer.ID("B"): blocksToCh(blocks[4:10], 0),
 			},
+			peerBlocks: map[peer.ID][]*types.Block{
+				peer.ID("A"): blocks[:10],
+				peer.ID("B"): blocks[4:10],
+			},
 			createVerifyFinalizedBlockHandler: func() func(*types.Block) error {
 				count := 0
 
@@ -584,10 +639,9 @@ func TestSync(t *testing.T) {
 					return nil
 				}
 			},
-			blocks: blocks[:10],
-			// TODO: need to fix implementation?
+			blocks:             blocks[:10],
 			progressionStart:   0,
-			progressionHighest: 0,
+			progressionHighest: 10,
 			err:                nil,
 		},
 	}
@@ -623,6 +677,17 @@ func TestSync(t *testing.T) {
 
 							return peerCh, nil
 						},
+						getBlockRangeHandler: func(i peer.ID, from, to uint64) ([]*types.Block, error) {
+							peerBlocks := make([]*types.Block, 0)
+
+							for _, b := range test.peerBlocks[i] {
+								if b.Number() >= from && b.Number() <= to {
+									peerBlocks = append(peerBlocks, b)
+								}
+							}
+
+							return peerBlocks, nil
+						},
 					},
 					progression,
 				)
@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -16,6 +17,28 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// SyncMode is the way the node catches up with the chain
+type SyncMode string
+
+const (
+	// FullSync downloads and executes all the blocks
+	FullSync SyncMode = "full"
+	// SnapSync downloads the state at a recent block from the peers, then the blocks after it
+	SnapSync SyncMode = "snap"
+	// LightSync downloads and verifies only the headers, the rest is fetched from the peers on demand
+	LightSync SyncMode = "light"
+)
+
+// ParseSyncMode returns the sync mode with the given name
+func ParseSyncMode(name string) (SyncMode, error) {
+	switch mode := SyncMode(name); mode {
+	case FullSync, SnapSync, LightSync:
+		return mode, nil
+	}
+
+	return "", fmt.Errorf("unknown sync mode: %s", name)
+}
+
 type Blockchain interface {
 	// SubscribeEvents subscribes new blockchain event
 	SubscribeEvents() blockchain.Subscription
@@ -27,6 +50,20 @@ type Blockchain interface {
 	VerifyFinalizedBlock(*types.Block) error
 	// WriteBlock writes a given block to chain
 	WriteBlock(*types.Block, string) error
+	// GetHeaderByNumber returns header by number
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+	// GetReceiptsByHash returns the receipts of the block with the given hash
+	GetReceiptsByHash(types.Hash) ([]*types.Receipt, error)
+	// GetTD returns the total difficulty of the block with the given hash
+	GetTD(types.Hash) (*big.Int, bool)
+	// WriteAnchor writes the block whose state has been downloaded as the head of the empty chain
+	WriteAnchor([]*types.Header, *types.Block, []*types.Receipt, *big.Int) error
+	// VerifyAnchor verifies the header of the anchor block, fetching the headers it depends on
+	VerifyAnchor(*types.Header, func(uint64) (*types.Header, error)) error
+	// VerifyFinalizedHeader verifies the header of a finalized block without its body
+	VerifyFinalizedHeader(*types.Header) error
+	// WriteHeader writes the header of a block without its body
+	WriteHeader(*types.Header, string) error
 }
 
 type Network interface {
@@ -95,6 +132,8 @@ type SyncPeerClient interface {
 	GetConnectedPeerStatuses() []*NoForkPeer
 	// GetBlocks returns a stream of blocks from given height to peer's latest
 	GetBlocks(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	// GetBlockRange returns the blocks in the given range, or up to peer's latest if it has less
+	GetBlockRange(peer.ID, uint64, uint64, time.Duration) ([]*types.Block, error)
 	// GetPeerStatusUpdateCh returns a channel of peer's status update
 	GetPeerStatusUpdateCh() <-chan *NoForkPeer
 	// GetPeerConnectionUpdateEventCh returns peer's connection change event
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"math/big"
 	"sync"
 	"sync/atomic"
 
@@ -229,22 +230,74 @@ func (a *account) reset(nonce uint64, promoteCh chan<- promoteRequest) (
 }
 
 // enqueue attempts tp push the transaction onto the enqueued queue.
-func (a *account) enqueue(tx *types.Transaction) error {
+// A transaction with the same nonce as an enqueued or promoted one replaces it
+// if it pays at least priceBump percent more, in which case the replaced
+// transaction is returned along with whether it was promoted.
+func (a *account) enqueue(tx *types.Transaction, priceBump uint64) (
+	replaced *types.Transaction,
+	replacedPromoted bool,
+	err error,
+) {
+	a.promoted.lock(true)
 	a.enqueued.lock(true)
-	defer a.enqueued.unlock()
 
-	if a.enqueued.length() == a.maxEnqueued {
-		return ErrMaxEnqueuedLimitReached
-	}
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
 
-	// reject low nonce tx
+	// low nonce tx can only replace a promoted tx
 	if tx.Nonce < a.getNonce() {
-		return ErrNonceTooLow
+		old := a.promoted.get(tx.Nonce)
+		if old == nil {
+			return nil, false, ErrNonceTooLow
+		}
+
+		if !canReplace(old, tx, priceBump) {
+			return nil, false, ErrReplacementUnderpriced
+		}
+
+		return a.promoted.replace(tx), true, nil
+	}
+
+	if old := a.enqueued.get(tx.Nonce); old != nil {
+		if !canReplace(old, tx, priceBump) {
+			return nil, false, ErrReplacementUnderpriced
+		}
+
+		return a.enqueued.replace(tx), false, nil
+	}
+
+	if a.enqueued.length() == a.maxEnqueued {
+		return nil, false, ErrMaxEnqueuedLimitReached
 	}
 
 	// enqueue tx
 	a.enqueued.push(tx)
 
+	return nil, false, nil
+}
+
+// checkReplacement returns an error if the account has a transaction
+// with the same nonce that the given transaction can't replace.
+func (a *account) checkReplacement(tx *types.Transaction, priceBump uint64) error {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	old := a.promoted.get(tx.Nonce)
+	if old == nil {
+		old = a.enqueued.get(tx.Nonce)
+	}
+
+	if old != nil && !canReplace(old, tx, priceBump) {
+		return ErrReplacementUnderpriced
+	}
+
 	return nil
 }
 
@@ -333,3 +386,31 @@ func (a *account) getLowestTx() *types.Transaction {
 
 	return nil
 }
+
+// canReplace returns true if the new transaction pays at least priceBump percent more
+// than the old one, both in gas price (fee cap) and in tip.
+func canReplace(old, tx *types.Transaction, priceBump uint64) bool {
+	return isBumped(old.GetGasPrice(0), tx.GetGasPrice(0), priceBump) &&
+		isBumped(gasTipCap(old), gasTipCap(tx), priceBump)
+}
+
+// isBumped returns true if the new price is higher than the old one by at least priceBump percent.
+func isBumped(oldPrice, newPrice *big.Int, priceBump uint64) bool {
+	if newPrice.Cmp(oldPrice) <= 0 {
+		return false
+	}
+
+	// newPrice * 100 >= oldPrice * (100 + priceBump)
+	threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+priceBump))
+
+	return new(big.Int).Mul(newPrice, big.NewInt(100)).Cmp(threshold) >= 0
+}
+
+// gasTipCap returns the maximum tip paid by the transaction.
+func gasTipCap(tx *types.Transaction) *big.Int {
+	if tx.Type == types.DynamicFeeTx {
+		return tx.GasTipCap
+	}
+
+	return tx.GasPrice
+}
@@ -15,11 +15,14 @@ var mockHeader = &types.Header{
 
 type defaultMockStore struct {
 	DefaultHeader *types.Header
+
+	// DefaultStorage is the storage of every account
+	DefaultStorage map[types.Hash]types.Hash
 }
 
 func NewDefaultMockStore(header *types.Header) defaultMockStore {
 	return defaultMockStore{
-		header,
+		DefaultHeader: header,
 	}
 }
 
@@ -41,6 +44,10 @@ func (m defaultMockStore) GetBalance(types.Hash, types.Address) (*big.Int, error
 	return balance, nil
 }
 
+func (m defaultMockStore) GetStorage(_ types.Hash, _ types.Address, key types.Hash) types.Hash {
+	return m.DefaultStorage[key]
+}
+
 type faultyMockStore struct {
 }
 
@@ -60,6 +67,10 @@ func (fms faultyMockStore) GetBalance(root types.Hash, addr types.Address) (*big
 	return nil, fmt.Errorf("unable to fetch account state")
 }
 
+func (fms faultyMockStore) GetStorage(types.Hash, types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
 type mockSigner struct {
 }
 
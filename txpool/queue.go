@@ -77,6 +77,32 @@ func (q *accountQueue) push(tx *types.Transaction) {
 	heap.Push(&q.queue, tx)
 }
 
+// get returns the transaction with the given nonce, if any.
+func (q *accountQueue) get(nonce uint64) *types.Transaction {
+	for _, tx := range q.queue {
+		if tx.Nonce == nonce {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+// replace replaces the transaction with the same nonce as the given one
+// and returns it, or nil if there is no such transaction.
+func (q *accountQueue) replace(tx *types.Transaction) *types.Transaction {
+	for i, old := range q.queue {
+		if old.Nonce == tx.Nonce {
+			q.queue[i] = tx
+			heap.Fix(&q.queue, i)
+
+			return old
+		}
+	}
+
+	return nil
+}
+
 // peek returns the first transaction from the queue without removing it.
 func (q *accountQueue) peek() *types.Transaction {
 	if q.length() == 0 {
@@ -129,7 +155,7 @@ func (q *minNonceQueue) Swap(i, j int) {
 func (q *minNonceQueue) Less(i, j int) bool {
 	// The higher gas price Tx comes first if the nonces are same
 	if (*q)[i].Nonce == (*q)[j].Nonce {
-		return (*q)[i].GasPrice.Cmp((*q)[j].GasPrice) > 0
+		return (*q)[i].GetGasPrice(0).Cmp((*q)[j].GetGasPrice(0)) > 0
 	}
 
 	return (*q)[i].Nonce < (*q)[j].Nonce
@@ -153,38 +179,75 @@ func (q *minNonceQueue) Pop() interface{} {
 	return x
 }
 
+// A thread-safe wrapper of a maxPriceQueue.
 type pricedQueue struct {
-	queue maxPriceQueue
+	lock  sync.Mutex
+	queue *maxPriceQueue
 }
 
 func newPricedQueue() *pricedQueue {
 	q := pricedQueue{
-		queue: make(maxPriceQueue, 0),
+		queue: &maxPriceQueue{
+			txs: make([]*types.Transaction, 0),
+		},
 	}
 
-	heap.Init(&q.queue)
+	heap.Init(q.queue)
 
 	return &q
 }
 
 // clear empties the underlying queue.
 func (q *pricedQueue) clear() {
-	q.queue = q.queue[:0]
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.queue.txs = q.queue.txs[:0]
+}
+
+// setBaseFee sets the base fee used for sorting the transactions.
+// It should only be called on an empty queue
+func (q *pricedQueue) setBaseFee(baseFee uint64) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.queue.baseFee = baseFee
 }
 
 // Pushes the given transactions onto the queue.
 func (q *pricedQueue) push(tx *types.Transaction) {
-	heap.Push(&q.queue, tx)
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	heap.Push(q.queue, tx)
+}
+
+// replace replaces the old transaction with the new one, if it is in the queue.
+func (q *pricedQueue) replace(old, tx *types.Transaction) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for i, queued := range q.queue.txs {
+		if queued == old {
+			q.queue.txs[i] = tx
+			heap.Fix(q.queue, i)
+
+			return
+		}
+	}
 }
 
 // Pop removes the first transaction from the queue
 // or nil if the queue is empty.
 func (q *pricedQueue) pop() *types.Transaction {
-	if q.length() == 0 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.queue.Len() == 0 {
 		return nil
 	}
 
-	transaction, ok := heap.Pop(&q.queue).(*types.Transaction)
+	transaction, ok := heap.Pop(q.queue).(*types.Transaction)
 	if !ok {
 		return nil
 	}
@@ -194,11 +257,18 @@ func (q *pricedQueue) pop() *types.Transaction {
 
 // length returns the number of transactions in the queue.
 func (q *pricedQueue) length() uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
 	return uint64(q.queue.Len())
 }
 
-// transactions sorted by gas price (descending)
-type maxPriceQueue []*types.Transaction
+// transactions sorted by gas price (descending).
+// After London, they are sorted by the effective tip paid over the base fee
+type maxPriceQueue struct {
+	baseFee uint64
+	txs     []*types.Transaction
+}
 
 /* Queue methods required by the heap interface */
 
@@ -207,19 +277,19 @@ func (q *maxPriceQueue) Peek() *types.Transaction {
 		return nil
 	}
 
-	return (*q)[0]
+	return q.txs[0]
 }
 
 func (q *maxPriceQueue) Len() int {
-	return len(*q)
+	return len(q.txs)
 }
 
 func (q *maxPriceQueue) Swap(i, j int) {
-	(*q)[i], (*q)[j] = (*q)[j], (*q)[i]
+	q.txs[i], q.txs[j] = q.txs[j], q.txs[i]
 }
 
 func (q *maxPriceQueue) Less(i, j int) bool {
-	return (*q)[i].GasPrice.Uint64() > (*q)[j].GasPrice.Uint64()
+	return q.txs[i].EffectiveTip(q.baseFee).Cmp(q.txs[j].EffectiveTip(q.baseFee)) > 0
 }
 
 func (q *maxPriceQueue) Push(x interface{}) {
@@ -228,14 +298,14 @@ func (q *maxPriceQueue) Push(x interface{}) {
 		return
 	}
 
-	*q = append(*q, transaction)
+	q.txs = append(q.txs, transaction)
 }
 
 func (q *maxPriceQueue) Pop() interface{} {
-	old := q
-	n := len(*old)
-	x := (*old)[n-1]
-	*q = (*old)[0 : n-1]
+	old := q.txs
+	n := len(old)
+	x := old[n-1]
+	q.txs = old[0 : n-1]
 
 	return x
 }
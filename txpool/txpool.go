@@ -9,6 +9,7 @@ import (
 
 	"github.com/LaChain/polygon-edge/blockchain"
 	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
 	"github.com/LaChain/polygon-edge/network"
 	"github.com/LaChain/polygon-edge/state"
 	"github.com/LaChain/polygon-edge/txpool/proto"
@@ -54,7 +55,10 @@ var (
 	ErrOversizedData           = errors.New("oversized data")
 	ErrMaxEnqueuedLimitReached = errors.New("maximum number of enqueued transactions reached")
 	ErrRejectFutureTx          = errors.New("rejected future tx due to low slots")
-	ErrSmartContractRestricted = errors.New("smart contract deployment restricted")
+	ErrSmartContractRestricted = chain.ErrDeploymentRestricted
+	ErrTxTypeNotSupported      = errors.New("transaction type not supported")
+	ErrTipAboveFeeCap          = errors.New("max priority fee per gas higher than max fee per gas")
+	ErrReplacementUnderpriced  = errors.New("replacement transaction underpriced")
 )
 
 // indicates origin of a transaction
@@ -84,18 +88,31 @@ type store interface {
 	Header() *types.Header
 	GetNonce(root types.Hash, addr types.Address) uint64
 	GetBalance(root types.Hash, addr types.Address) (*big.Int, error)
+	GetStorage(root types.Hash, addr types.Address, key types.Hash) types.Hash
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
 }
 
+// stateStorage reads the storage of the accounts in the state of a block
+type stateStorage struct {
+	store store
+	root  types.Hash
+}
+
+func (s *stateStorage) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	return s.store.GetStorage(s.root, addr, key)
+}
+
 type signer interface {
 	Sender(tx *types.Transaction) (types.Address, error)
 }
 
 type Config struct {
-	PriceLimit          uint64
-	MaxSlots            uint64
-	MaxAccountEnqueued  uint64
-	DeploymentWhitelist []types.Address
+	PriceLimit            uint64
+	MaxSlots              uint64
+	MaxAccountEnqueued    uint64
+	PriceBump             uint64
+	Whitelists            *chain.Whitelists
+	AccessControlContract *types.Address
 }
 
 /* All requests are passed to the main loop
@@ -138,7 +155,7 @@ type promoteRequest struct {
 type TxPool struct {
 	logger hclog.Logger
 	signer signer
-	forks  chain.ForksInTime
+	forks  *chain.Forks
 	store  store
 
 	// map of all accounts registered by the pool
@@ -160,6 +177,10 @@ type TxPool struct {
 	// priceLimit is a lower threshold for gas price
 	priceLimit uint64
 
+	// priceBump is the minimum percentage by which a transaction
+	// must outbid the transaction with the same nonce it replaces
+	priceBump uint64
+
 	// channels on which the pool's event loop
 	// does dispatching/handling requests.
 	enqueueReqCh chan enqueueRequest
@@ -176,8 +197,11 @@ type TxPool struct {
 	// Event manager for txpool events
 	eventManager *eventManager
 
-	// deploymentWhitelist map
-	deploymentWhitelist deploymentWhitelist
+	// whitelists restricting the senders, recipients and deployers of transactions
+	whitelists *chain.Whitelists
+
+	// accessControlContract grants the roles to send transactions and deploy contracts, if set
+	accessControlContract *types.Address
 
 	// indicates which txpool operator commands should be implemented
 	proto.UnimplementedTxnPoolOperatorServer
@@ -187,45 +211,10 @@ type TxPool struct {
 	pending int64
 }
 
-// deploymentWhitelist map which contains all addresses which can deploy contracts
-// if empty anyone can
-type deploymentWhitelist struct {
-	// Contract deployment whitelist
-	addresses map[string]bool
-}
-
-// add an address to deploymentWhitelist map
-func (w *deploymentWhitelist) add(addr types.Address) {
-	w.addresses[addr.String()] = true
-}
-
-// allowed checks if address can deploy smart contract
-func (w *deploymentWhitelist) allowed(addr types.Address) bool {
-	if len(w.addresses) == 0 {
-		return true
-	}
-
-	_, ok := w.addresses[addr.String()]
-
-	return ok
-}
-
-func newDeploymentWhitelist(deploymentWhitelistRaw []types.Address) deploymentWhitelist {
-	deploymentWhitelist := deploymentWhitelist{
-		addresses: map[string]bool{},
-	}
-
-	for _, addr := range deploymentWhitelistRaw {
-		deploymentWhitelist.add(addr)
-	}
-
-	return deploymentWhitelist
-}
-
 // NewTxPool returns a new pool for processing incoming transactions.
 func NewTxPool(
 	logger hclog.Logger,
-	forks chain.ForksInTime,
+	forks *chain.Forks,
 	store store,
 	grpcServer *grpc.Server,
 	network *network.Server,
@@ -240,6 +229,10 @@ func NewTxPool(
 		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
+		priceBump:   config.PriceBump,
+		whitelists:  config.Whitelists,
+
+		accessControlContract: config.AccessControlContract,
 
 		//	main loop channels
 		enqueueReqCh: make(chan enqueueRequest),
@@ -265,9 +258,6 @@ func NewTxPool(
 		pool.topic = topic
 	}
 
-	// initialize deployment whitelist
-	pool.deploymentWhitelist = newDeploymentWhitelist(config.DeploymentWhitelist)
-
 	if grpcServer != nil {
 		proto.RegisterTxnPoolOperatorServer(grpcServer, pool)
 	}
@@ -377,12 +367,15 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 
 // Prepare generates all the transactions
 // ready for execution. (primaries)
-func (p *TxPool) Prepare() {
+// The transactions are sorted by the tip they pay over the given base fee
+func (p *TxPool) Prepare(baseFee uint64) {
 	// clear from previous round
 	if p.executables.length() != 0 {
 		p.executables.clear()
 	}
 
+	p.executables.setBaseFee(baseFee)
+
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
@@ -416,7 +409,14 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 	defer account.promoted.unlock()
 
 	// pop the top most promoted tx
-	account.promoted.pop()
+	popped := account.promoted.pop()
+	if popped != nil && popped.Hash != tx.Hash {
+		// the tx was replaced while it was being executed,
+		// so its replacement is stale
+		p.index.remove(popped)
+
+		tx = popped
+	}
 
 	// successfully popping an account resets its demotions count to 0
 	account.resetDemotions()
@@ -634,9 +634,41 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		tx.From = from
 	}
 
-	// Check if transaction can deploy smart contract
-	if tx.IsContractCreation() && !p.deploymentWhitelist.allowed(tx.From) {
-		return ErrSmartContractRestricted
+	// Grab the latest block header, the transaction
+	// is expected to be included in the next block
+	latestHeader := p.store.Header()
+	forks := p.forks.At(latestHeader.Number + 1)
+
+	// Check if the whitelists allow the sender, recipient or contract deployment
+	if err := p.whitelists.At(latestHeader.Number+1).ValidateTx(tx.From, tx.To); err != nil {
+		return err
+	}
+
+	// Check if the access control contract grants the roles to the sender
+	if p.accessControlContract != nil {
+		if err := accesscontrol.ValidateTx(
+			&stateStorage{store: p.store, root: latestHeader.StateRoot},
+			*p.accessControlContract,
+			tx.From,
+			tx.IsContractCreation(),
+		); err != nil {
+			return err
+		}
+	}
+
+	// Check if the transaction type is supported and its fee fields are sane
+	if tx.Type == types.AccessListTx && !forks.Berlin {
+		return ErrTxTypeNotSupported
+	}
+
+	if tx.Type == types.DynamicFeeTx {
+		if !forks.London {
+			return ErrTxTypeNotSupported
+		}
+
+		if tx.GasTipCap.Cmp(tx.GasFeeCap) > 0 {
+			return ErrTipAboveFeeCap
+		}
 	}
 
 	// Reject underpriced transactions
@@ -645,7 +677,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Grab the state root for the latest block
-	stateRoot := p.store.Header().StateRoot
+	stateRoot := latestHeader.StateRoot
 
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
@@ -663,7 +695,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, p.forks.Homestead, p.forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul)
 	if err != nil {
 		return err
 	}
@@ -673,7 +705,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Grab the block gas limit for the latest block
-	latestBlockGasLimit := p.store.Header().GasLimit
+	latestBlockGasLimit := latestHeader.GasLimit
 
 	if tx.Gas > latestBlockGasLimit {
 		return ErrBlockLimitExceeded
@@ -754,6 +786,15 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		return ErrAlreadyKnown
 	}
 
+	// reject early a replacement that doesn't outbid the replaced tx
+	if account := p.accounts.get(tx.From); account != nil {
+		if err := account.checkReplacement(tx, p.priceBump); err != nil {
+			p.index.remove(tx)
+
+			return err
+		}
+	}
+
 	// initialize account for this address once
 	p.createAccountOnce(tx.From)
 
@@ -776,7 +817,8 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 	account := p.accounts.get(addr)
 
 	// enqueue tx
-	if err := account.enqueue(tx); err != nil {
+	replaced, replacedPromoted, err := account.enqueue(tx, p.priceBump)
+	if err != nil {
 		p.logger.Error("enqueue request", "err", err)
 
 		p.index.remove(tx)
@@ -788,6 +830,25 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 
 	p.gauge.increase(slotsRequired(tx))
 
+	if replaced != nil {
+		p.logger.Debug("replaced tx", "old", replaced.Hash.String(), "new", tx.Hash.String())
+
+		p.index.remove(replaced)
+		p.gauge.decrease(slotsRequired(replaced))
+
+		p.eventManager.signalEvent(proto.EventType_REPLACED, replaced.Hash)
+	}
+
+	if replacedPromoted {
+		// the tx took the place of a promoted tx,
+		// which might be an executable as well
+		p.executables.replace(replaced, tx)
+
+		p.eventManager.signalEvent(proto.EventType_PROMOTED, tx.Hash)
+
+		return
+	}
+
 	p.eventManager.signalEvent(proto.EventType_ENQUEUED, tx.Hash)
 
 	if tx.Nonce > account.getNonce() {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/LaChain/polygon-edge/chain"
+	"github.com/LaChain/polygon-edge/contracts/accesscontrol"
 	"github.com/LaChain/polygon-edge/crypto"
 	"github.com/LaChain/polygon-edge/helper/tests"
 	"github.com/LaChain/polygon-edge/txpool/proto"
@@ -82,15 +83,14 @@ func newTestPoolWithSlots(maxSlots uint64, mockStore ...store) (*TxPool, error)
 
 	return NewTxPool(
 		hclog.NewNullLogger(),
-		forks.At(0),
+		forks,
 		storeToUse,
 		nil,
 		nil,
 		&Config{
-			PriceLimit:          defaultPriceLimit,
-			MaxSlots:            maxSlots,
-			MaxAccountEnqueued:  defaultMaxAccountEnqueued,
-			DeploymentWhitelist: []types.Address{},
+			PriceLimit:         defaultPriceLimit,
+			MaxSlots:           maxSlots,
+			MaxAccountEnqueued: defaultMaxAccountEnqueued,
 		},
 	)
 }
@@ -926,16 +926,18 @@ func TestPromoteHandler(t *testing.T) {
 			promReq1 := handleEnqueueRequest(enqTx1)
 			promReq2 := handleEnqueueRequest(enqTx2)
 
+			// the second Tx replaces the first Tx
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
-			assert.Equal(t, uint64(2), pool.accounts.get(addr1).enqueued.length())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+			assertTxExists(t, tx1, false)
 			assert.Equal(
 				t,
-				slotsRequired(tx1)+slotsRequired(tx2),
+				slotsRequired(tx2),
 				pool.gauge.read(),
 			)
 
-			// promote the second Tx and remove the first Tx
+			// promote the second Tx
 			pool.handlePromoteRequest(promReq1)
 
 			assert.Equal(t, uint64(1), pool.accounts.get(addr1).getNonce())
@@ -1397,7 +1399,7 @@ func TestPop(t *testing.T) {
 	assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
 
 	// pop the tx
-	pool.Prepare()
+	pool.Prepare(0)
 	tx := pool.Peek()
 	pool.Pop(tx)
 
@@ -1425,7 +1427,7 @@ func TestDrop(t *testing.T) {
 	assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
 
 	// pop the tx
-	pool.Prepare()
+	pool.Prepare(0)
 	tx := pool.Peek()
 	pool.Drop(tx)
 
@@ -1458,7 +1460,7 @@ func TestDemote(t *testing.T) {
 		assert.Equal(t, uint64(0), pool.accounts.get(addr1).Demotions())
 
 		// call demote
-		pool.Prepare()
+		pool.Prepare(0)
 		tx := pool.Peek()
 		pool.Demote(tx)
 
@@ -1494,7 +1496,7 @@ func TestDemote(t *testing.T) {
 		pool.accounts.get(addr1).demotions = maxAccountDemotions
 
 		// call demote
-		pool.Prepare()
+		pool.Prepare(0)
 		tx := pool.Peek()
 		pool.Demote(tx)
 
@@ -1689,8 +1691,9 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 	t.Run("Addresses inside whitelist can deploy smart contract", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
-		pool.deploymentWhitelist.add(addr1)
-		pool.deploymentWhitelist.add(defaultAddr)
+		pool.whitelists = &chain.Whitelists{
+			Deployment: []types.Address{addr1, defaultAddr},
+		}
 
 		tx := newTx(defaultAddr, 0, 1)
 		tx.To = nil
@@ -1700,8 +1703,9 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 	t.Run("Addresses outside whitelist can not deploy smart contract", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
-		pool.deploymentWhitelist.add(addr1)
-		pool.deploymentWhitelist.add(addr2)
+		pool.whitelists = &chain.Whitelists{
+			Deployment: []types.Address{addr1, addr2},
+		}
 
 		tx := newTx(defaultAddr, 0, 1)
 		tx.To = nil
@@ -1713,6 +1717,122 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 	})
 }
 
+func TestWhitelists(t *testing.T) {
+	t.Parallel()
+
+	signer := crypto.NewEIP155Signer(100)
+
+	defaultKey, defaultAddr := tests.GenerateKeyAndAddr(t)
+
+	testCases := []struct {
+		name       string
+		whitelists *chain.Whitelists
+		to         *types.Address
+		err        error
+	}{
+		{
+			name:       "sender in the sender whitelist",
+			whitelists: &chain.Whitelists{Sender: []types.Address{defaultAddr}},
+			to:         &addr1,
+		},
+		{
+			name:       "sender outside the sender whitelist",
+			whitelists: &chain.Whitelists{Sender: []types.Address{addr1}},
+			to:         &addr1,
+			err:        chain.ErrSenderRestricted,
+		},
+		{
+			name:       "sender in the denylist",
+			whitelists: &chain.Whitelists{Denylist: []types.Address{defaultAddr}},
+			to:         &addr1,
+			err:        chain.ErrAddressDenied,
+		},
+		{
+			name:       "recipient in the denylist",
+			whitelists: &chain.Whitelists{Denylist: []types.Address{addr1}},
+			to:         &addr1,
+			err:        chain.ErrAddressDenied,
+		},
+		{
+			name:       "recipient outside the call target whitelist",
+			whitelists: &chain.Whitelists{CallTarget: []types.Address{addr2}},
+			to:         &addr1,
+			err:        chain.ErrCallTargetRestricted,
+		},
+		{
+			name: "whitelists replaced by a fork",
+			whitelists: &chain.Whitelists{
+				Denylist: []types.Address{defaultAddr},
+				Forks: []*chain.WhitelistsFork{
+					{Block: 1, Whitelists: &chain.Whitelists{CallTarget: []types.Address{addr2}}},
+				},
+			},
+			to:  &addr1,
+			err: chain.ErrCallTargetRestricted,
+		},
+		{
+			name: "fork not active in the next block",
+			whitelists: &chain.Whitelists{
+				Forks: []*chain.WhitelistsFork{
+					{Block: 2, Whitelists: &chain.Whitelists{Denylist: []types.Address{defaultAddr}}},
+				},
+			},
+			to: &addr1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+
+			pool.SetSigner(signer)
+			pool.whitelists = testCase.whitelists
+
+			tx := newTx(defaultAddr, 0, 1)
+			tx.To = testCase.to
+
+			signedTx, err := signer.SignTx(tx, defaultKey)
+			assert.NoError(t, err)
+
+			assert.ErrorIs(t, pool.validateTx(signedTx), testCase.err)
+		})
+	}
+}
+
+func TestAccessControl(t *testing.T) {
+	t.Parallel()
+
+	signer := crypto.NewEIP155Signer(100)
+
+	defaultKey, defaultAddr := tests.GenerateKeyAndAddr(t)
+	contract := accesscontrol.AddrAccessControlContract
+
+	// the sender role is granted to addr1 only
+	store := defaultMockStore{
+		DefaultHeader: mockHeader,
+		DefaultStorage: map[types.Hash]types.Hash{
+			accesscontrol.MemberIndex(accesscontrol.RoleSender, addr1): types.BytesToHash([]byte{1}),
+			accesscontrol.MemberCountIndex(accesscontrol.RoleSender):   types.BytesToHash([]byte{1}),
+		},
+	}
+
+	pool, err := newTestPool(store)
+	assert.NoError(t, err)
+
+	pool.SetSigner(signer)
+	pool.accessControlContract = &contract
+
+	signedTx, err := signer.SignTx(newTx(defaultAddr, 0, 1), defaultKey)
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, pool.validateTx(signedTx), accesscontrol.ErrSenderRoleMissing)
+}
+
 /* "Integrated" tests */
 
 // The following tests ensure that the pool's inner event loop
@@ -2417,7 +2537,7 @@ func TestRecovery(t *testing.T) {
 			assert.Len(t, waitForEvents(ctx, promoteSubscription, totalTx), totalTx)
 
 			func() {
-				pool.Prepare()
+				pool.Prepare(0)
 				for {
 					tx := pool.Peek()
 					if tx == nil {
@@ -2729,3 +2849,240 @@ func TestSetSealing(t *testing.T) {
 		})
 	}
 }
+
+func TestPricedQueue_SortByEffectiveTip(t *testing.T) {
+	t.Parallel()
+
+	legacyTx := newTx(addr1, 0, 1)
+	legacyTx.GasPrice = big.NewInt(15)
+
+	dynamicTx := newTx(addr2, 0, 1)
+	dynamicTx.Type = types.DynamicFeeTx
+	dynamicTx.GasPrice = big.NewInt(0)
+	dynamicTx.GasTipCap = big.NewInt(8)
+	dynamicTx.GasFeeCap = big.NewInt(20)
+
+	testTable := []struct {
+		name     string
+		baseFee  uint64
+		expected []*types.Transaction
+	}{
+		{
+			// legacy tip = 15, dynamic tip = 20
+			"without base fee",
+			0,
+			[]*types.Transaction{dynamicTx, legacyTx},
+		},
+		{
+			// legacy tip = 5, dynamic tip = min(8, 20 - 10) = 8
+			"with base fee",
+			10,
+			[]*types.Transaction{dynamicTx, legacyTx},
+		},
+		{
+			// legacy tip = 11, dynamic tip = min(8, 20 - 4) = 8
+			"with low base fee",
+			4,
+			[]*types.Transaction{legacyTx, dynamicTx},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := newPricedQueue()
+			q.setBaseFee(testCase.baseFee)
+
+			q.push(legacyTx)
+			q.push(dynamicTx)
+
+			for _, expected := range testCase.expected {
+				assert.Equal(t, expected, q.pop())
+			}
+		})
+	}
+}
+
+func TestReplaceTx(t *testing.T) {
+	t.Parallel()
+
+	const priceBump = 10
+
+	newPricedTx := func(nonce, gasPrice uint64) *types.Transaction {
+		tx := newTx(addr1, nonce, 1)
+		tx.GasPrice.SetUint64(gasPrice)
+
+		return tx
+	}
+
+	setupPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+		pool.priceBump = priceBump
+
+		return pool
+	}
+
+	// addTx adds the tx and handles its enqueue request,
+	// promoting the tx if it is expected
+	addTx := func(t *testing.T, pool *TxPool, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			assert.NoError(t, pool.addTx(local, tx))
+		}()
+
+		req := <-pool.enqueueReqCh
+
+		if tx.Nonce != pool.accounts.get(addr1).getNonce() {
+			pool.handleEnqueueRequest(req)
+
+			return
+		}
+
+		go pool.handleEnqueueRequest(req)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	assertTxExists := func(t *testing.T, pool *TxPool, tx *types.Transaction, shouldExist bool) {
+		t.Helper()
+
+		_, exists := pool.index.get(tx.Hash)
+		assert.Equal(t, shouldExist, exists)
+	}
+
+	t.Run("replaces an enqueued tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(t)
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_REPLACED})
+
+		oldTx := newPricedTx(1, 10)
+		addTx(t, pool, oldTx)
+
+		// the price is not bumped enough
+		assert.ErrorIs(t, pool.addTx(local, newPricedTx(1, 10)), ErrReplacementUnderpriced)
+
+		newTx := newPricedTx(1, 11)
+		addTx(t, pool, newTx)
+
+		account := pool.accounts.get(addr1)
+		assert.Equal(t, uint64(1), account.enqueued.length())
+		assert.Equal(t, newTx, account.enqueued.peek())
+		assert.Equal(t, slotsRequired(newTx), pool.gauge.read())
+
+		assertTxExists(t, pool, oldTx, false)
+		assertTxExists(t, pool, newTx, true)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancelFn()
+
+		events := waitForEvents(ctx, subscription, 1)
+		assert.Len(t, events, 1)
+		assert.Equal(t, oldTx.Hash.String(), events[0].TxHash)
+	})
+
+	t.Run("replaces a promoted tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(t)
+
+		oldTx := newPricedTx(0, 10)
+		addTx(t, pool, oldTx)
+
+		pool.Prepare(0)
+
+		// the promoted tx is replaced without being promoted again
+		newTx := newPricedTx(0, 20)
+		addTx(t, pool, newTx)
+
+		account := pool.accounts.get(addr1)
+		assert.Equal(t, uint64(1), account.getNonce())
+		assert.Equal(t, uint64(0), account.enqueued.length())
+		assert.Equal(t, uint64(1), account.promoted.length())
+		assert.Equal(t, slotsRequired(newTx), pool.gauge.read())
+
+		assertTxExists(t, pool, oldTx, false)
+		assertTxExists(t, pool, newTx, true)
+
+		// the executables are updated as well
+		tx := pool.Peek()
+		assert.Equal(t, newTx, tx)
+
+		pool.Pop(tx)
+
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, uint64(0), account.promoted.length())
+	})
+
+	t.Run("pops the replacement of an executed tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := setupPool(t)
+
+		oldTx := newPricedTx(0, 10)
+		addTx(t, pool, oldTx)
+
+		pool.Prepare(0)
+		tx := pool.Peek()
+
+		// the tx is replaced while it is executed
+		newTx := newPricedTx(0, 20)
+		addTx(t, pool, newTx)
+
+		pool.Pop(tx)
+
+		assert.Equal(t, uint64(0), pool.gauge.read())
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assertTxExists(t, pool, newTx, false)
+	})
+}
+
+func TestCanReplace(t *testing.T) {
+	t.Parallel()
+
+	newDynamicTx := func(tipCap, feeCap int64) *types.Transaction {
+		return &types.Transaction{
+			Type:      types.DynamicFeeTx,
+			GasTipCap: big.NewInt(tipCap),
+			GasFeeCap: big.NewInt(feeCap),
+		}
+	}
+
+	newLegacyTx := func(gasPrice int64) *types.Transaction {
+		return &types.Transaction{
+			GasPrice: big.NewInt(gasPrice),
+		}
+	}
+
+	testTable := []struct {
+		name      string
+		old, tx   *types.Transaction
+		priceBump uint64
+		expected  bool
+	}{
+		{"legacy tx bumped enough", newLegacyTx(100), newLegacyTx(110), 10, true},
+		{"legacy tx not bumped enough", newLegacyTx(100), newLegacyTx(109), 10, false},
+		{"same price without bump", newLegacyTx(100), newLegacyTx(100), 0, false},
+		{"dynamic fee tx bumped enough", newDynamicTx(10, 100), newDynamicTx(11, 110), 10, true},
+		{"dynamic fee tx with tip not bumped", newDynamicTx(10, 100), newDynamicTx(10, 200), 10, false},
+		{"dynamic fee tx with fee cap not bumped", newDynamicTx(10, 100), newDynamicTx(20, 100), 10, false},
+		{"legacy tx replaced by dynamic fee tx", newLegacyTx(100), newDynamicTx(110, 110), 10, true},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, canReplace(testCase.old, testCase.tx, testCase.priceBump))
+		})
+	}
+}
@@ -9,34 +9,20 @@ import (
 
 var arenaPool fastrlp.ArenaPool
 
-// CalculateReceiptsRoot calculates the root of a list of receipts
+// CalculateReceiptsRoot calculates the root of a list of receipts.
+// Typed receipts are stored in the trie in their EIP-2718 envelope form
 func CalculateReceiptsRoot(receipts []*types.Receipt) types.Hash {
-	ar := arenaPool.Get()
-
-	res := calculateRootWithRlp(len(receipts), func(i int) *fastrlp.Value {
-		ar.Reset()
-
-		return receipts[i].MarshalRLPWith(ar)
+	return CalculateRoot(len(receipts), func(i int) []byte {
+		return receipts[i].MarshalRLPTo(nil)
 	})
-
-	arenaPool.Put(ar)
-
-	return res
 }
 
-// CalculateTransactionsRoot calculates the root of a list of transactions
+// CalculateTransactionsRoot calculates the root of a list of transactions.
+// Typed transactions are stored in the trie in their EIP-2718 envelope form
 func CalculateTransactionsRoot(transactions []*types.Transaction) types.Hash {
-	ar := arenaPool.Get()
-
-	res := calculateRootWithRlp(len(transactions), func(i int) *fastrlp.Value {
-		ar.Reset()
-
-		return transactions[i].MarshalRLPWith(ar)
+	return CalculateRoot(len(transactions), func(i int) []byte {
+		return transactions[i].MarshalRLPTo(nil)
 	})
-
-	arenaPool.Put(ar)
-
-	return res
 }
 
 // CalculateUncleRoot calculates the root of a list of uncles
@@ -59,14 +45,6 @@ func CalculateUncleRoot(uncles []*types.Header) types.Hash {
 	return types.BytesToHash(root)
 }
 
-func calculateRootWithRlp(num int, h func(indx int) *fastrlp.Value) types.Hash {
-	hF := func(indx int) []byte {
-		return h(indx).MarshalTo(nil)
-	}
-
-	return CalculateRoot(num, hF)
-}
-
 // CalculateRoot calculates a root with a callback
 func CalculateRoot(num int, h func(indx int) []byte) types.Hash {
 	if num == 0 {
@@ -25,6 +25,7 @@ type Header struct {
 	ExtraData    []byte
 	MixHash      Hash
 	Nonce        Nonce
+	BaseFee      uint64
 	Hash         Hash
 }
 
@@ -75,6 +76,7 @@ func (h *Header) Copy() *Header {
 		GasLimit:     h.GasLimit,
 		GasUsed:      h.GasUsed,
 		Timestamp:    h.Timestamp,
+		BaseFee:      h.BaseFee,
 	}
 
 	newHeader.Miner = make([]byte, len(h.Miner))
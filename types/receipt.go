@@ -23,6 +23,7 @@ type Receipt struct {
 	LogsBloom         Bloom
 	Logs              []*Log
 	Status            *ReceiptStatus
+	TransactionType   TxType
 
 	// context fields
 	GasUsed         uint64
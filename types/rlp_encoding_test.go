@@ -129,3 +129,115 @@ func TestRLPUnmarshal_Header_ComputeHash(t *testing.T) {
 	assert.NoError(t, h2.UnmarshalRLP(data))
 	assert.Equal(t, h.Hash, h2.Hash)
 }
+
+func TestRLPMarshall_And_Unmarshall_DynamicFeeTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	txn := &Transaction{
+		Type:      DynamicFeeTx,
+		ChainID:   big.NewInt(100),
+		Nonce:     1,
+		GasPrice:  big.NewInt(0),
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(10),
+		Gas:       11,
+		To:        &addrTo,
+		Value:     big.NewInt(1),
+		Input:     []byte{1, 2},
+		V:         big.NewInt(1),
+		S:         big.NewInt(26),
+		R:         big.NewInt(27),
+	}
+	txn.ComputeHash()
+
+	marshaledRlp := txn.MarshalRLP()
+	assert.Equal(t, byte(DynamicFeeTx), marshaledRlp[0])
+
+	unmarshalledTxn := new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	// typed transactions are wrapped in the block and store encodings
+	block := &Block{
+		Header:       &Header{BaseFee: 7},
+		Transactions: []*Transaction{txn},
+	}
+
+	unmarshalledBlock := new(Block)
+	assert.NoError(t, unmarshalledBlock.UnmarshalRLP(block.MarshalRLP()))
+	assert.Equal(t, txn, unmarshalledBlock.Transactions[0])
+	assert.Equal(t, uint64(7), unmarshalledBlock.Header.BaseFee)
+
+	txn.From = StringToAddress("22")
+	storedTxn := new(Transaction)
+	assert.NoError(t, storedTxn.UnmarshalStoreRLP(txn.MarshalStoreRLPTo(nil)))
+	assert.Equal(t, txn, storedTxn)
+}
+
+func TestRLPMarshall_And_Unmarshall_AccessListTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	txn := &Transaction{
+		Type:     AccessListTx,
+		ChainID:  big.NewInt(100),
+		Nonce:    1,
+		GasPrice: big.NewInt(10),
+		Gas:      11,
+		To:       &addrTo,
+		Value:    big.NewInt(1),
+		Input:    []byte{1, 2},
+		AccessList: TxAccessList{
+			{
+				Address:     StringToAddress("12"),
+				StorageKeys: []Hash{StringToHash("1"), StringToHash("2")},
+			},
+		},
+		V: big.NewInt(1),
+		S: big.NewInt(26),
+		R: big.NewInt(27),
+	}
+	txn.ComputeHash()
+
+	marshaledRlp := txn.MarshalRLP()
+	assert.Equal(t, byte(AccessListTx), marshaledRlp[0])
+
+	unmarshalledTxn := new(Transaction)
+	assert.NoError(t, unmarshalledTxn.UnmarshalRLP(marshaledRlp))
+	assert.Equal(t, txn, unmarshalledTxn)
+
+	txn.From = StringToAddress("22")
+	storedTxn := new(Transaction)
+	assert.NoError(t, storedTxn.UnmarshalStoreRLP(txn.MarshalStoreRLPTo(nil)))
+	assert.Equal(t, txn, storedTxn)
+}
+
+func TestRLPMarshall_And_Unmarshall_TypedReceipt(t *testing.T) {
+	receipt := &Receipt{
+		CumulativeGasUsed: 10,
+		TransactionType:   DynamicFeeTx,
+		TxHash:            StringToHash("10"),
+		GasUsed:           10,
+	}
+	receipt.SetStatus(ReceiptSuccess)
+
+	marshaledRlp := receipt.MarshalRLP()
+	assert.Equal(t, byte(DynamicFeeTx), marshaledRlp[0])
+
+	unmarshalledReceipt := new(Receipt)
+	assert.NoError(t, unmarshalledReceipt.UnmarshalStoreRLP(receipt.MarshalStoreRLPTo(nil)))
+	assert.Exactly(t, receipt, unmarshalledReceipt)
+}
+
+func TestRLPUnmarshal_Header_BaseFee(t *testing.T) {
+	// the base fee is not encoded before London,
+	// so the hash of older headers does not change
+	h := &Header{}
+	h.ComputeHash()
+
+	h2 := &Header{BaseFee: 1}
+	h2.ComputeHash()
+	assert.NotEqual(t, h.Hash, h2.Hash)
+
+	h3 := new(Header)
+	assert.NoError(t, h3.UnmarshalRLP(h2.MarshalRLP()))
+	assert.Equal(t, h2.Hash, h3.Hash)
+	assert.Equal(t, uint64(1), h3.BaseFee)
+}
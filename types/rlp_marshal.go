@@ -83,6 +83,12 @@ func (h *Header) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewBytes(h.MixHash.Bytes()))
 	vv.Set(arena.NewCopyBytes(h.Nonce[:]))
 
+	// the base fee is only part of the header after London,
+	// keep the encoding (and the hash) of older headers untouched
+	if h.BaseFee != 0 {
+		vv.Set(arena.NewUint(h.BaseFee))
+	}
+
 	return vv
 }
 
@@ -103,12 +109,29 @@ func (r *Receipt) MarshalRLP() []byte {
 	return r.MarshalRLPTo(nil)
 }
 
+// MarshalRLPTo marshals the receipt in its consensus form:
+// the RLP list for legacy receipts, or type || RLP for typed receipts
 func (r *Receipt) MarshalRLPTo(dst []byte) []byte {
+	if r.TransactionType != LegacyTx {
+		dst = append(dst, byte(r.TransactionType))
+
+		return MarshalRLPTo(r.marshalPayloadWith, dst)
+	}
+
 	return MarshalRLPTo(r.MarshalRLPWith, dst)
 }
 
-// MarshalRLPWith marshals a receipt with a specific fastrlp.Arena
+// MarshalRLPWith marshals a receipt with a specific fastrlp.Arena.
+// Typed receipts are wrapped into an RLP string
 func (r *Receipt) MarshalRLPWith(a *fastrlp.Arena) *fastrlp.Value {
+	if r.TransactionType != LegacyTx {
+		return a.NewBytes(r.MarshalRLPTo(nil))
+	}
+
+	return r.marshalPayloadWith(a)
+}
+
+func (r *Receipt) marshalPayloadWith(a *fastrlp.Arena) *fastrlp.Value {
 	vv := a.NewArray()
 
 	if r.Status != nil {
@@ -159,12 +182,25 @@ func (t *Transaction) MarshalRLP() []byte {
 	return t.MarshalRLPTo(nil)
 }
 
+// MarshalRLPTo marshals the transaction in its consensus form:
+// the RLP list for legacy transactions, or type || RLP payload (EIP-2718) for typed transactions
 func (t *Transaction) MarshalRLPTo(dst []byte) []byte {
+	if t.Type != LegacyTx {
+		dst = append(dst, byte(t.Type))
+
+		return MarshalRLPTo(t.marshalTypedPayloadWith, dst)
+	}
+
 	return MarshalRLPTo(t.MarshalRLPWith, dst)
 }
 
-// MarshalRLPWith marshals the transaction to RLP with a specific fastrlp.Arena
+// MarshalRLPWith marshals the transaction to RLP with a specific fastrlp.Arena.
+// Typed transactions are wrapped into an RLP string
 func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	if t.Type != LegacyTx {
+		return arena.NewBytes(t.MarshalRLPTo(nil))
+	}
+
 	vv := arena.NewArray()
 
 	vv.Set(arena.NewUint(t.Nonce))
@@ -188,3 +224,66 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 
 	return vv
 }
+
+// marshalTypedPayloadWith marshals the payload of a typed transaction
+func (t *Transaction) marshalTypedPayloadWith(arena *fastrlp.Arena) *fastrlp.Value {
+	vv := arena.NewArray()
+
+	vv.Set(arena.NewBigInt(t.ChainID))
+	vv.Set(arena.NewUint(t.Nonce))
+
+	if t.Type == DynamicFeeTx {
+		vv.Set(arena.NewBigInt(t.GasTipCap))
+		vv.Set(arena.NewBigInt(t.GasFeeCap))
+	} else {
+		vv.Set(arena.NewBigInt(t.GasPrice))
+	}
+
+	vv.Set(arena.NewUint(t.Gas))
+
+	// Address may be empty
+	if t.To != nil {
+		vv.Set(arena.NewBytes((*t.To).Bytes()))
+	} else {
+		vv.Set(arena.NewNull())
+	}
+
+	vv.Set(arena.NewBigInt(t.Value))
+	vv.Set(arena.NewCopyBytes(t.Input))
+
+	vv.Set(t.AccessList.MarshalRLPWith(arena))
+
+	// signature values
+	vv.Set(arena.NewBigInt(t.V))
+	vv.Set(arena.NewBigInt(t.R))
+	vv.Set(arena.NewBigInt(t.S))
+
+	return vv
+}
+
+// MarshalRLPWith marshals the access list to RLP with a specific fastrlp.Arena
+func (al TxAccessList) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	if len(al) == 0 {
+		return arena.NewNullArray()
+	}
+
+	vv := arena.NewArray()
+
+	for _, tuple := range al {
+		tv := arena.NewArray()
+		tv.Set(arena.NewBytes(tuple.Address.Bytes()))
+
+		keys := arena.NewNullArray()
+		if len(tuple.StorageKeys) != 0 {
+			keys = arena.NewArray()
+			for _, key := range tuple.StorageKeys {
+				keys.Set(arena.NewBytes(key.Bytes()))
+			}
+		}
+
+		tv.Set(keys)
+		vv.Set(tv)
+	}
+
+	return vv
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/LaChain/polygon-edge/helper/keccak"
 	"github.com/umbracle/fastrlp"
 )
 
@@ -165,6 +166,13 @@ func (h *Header) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 
 	h.SetNonce(nonce)
 
+	// baseFee, only present after London
+	if len(elems) > 15 {
+		if h.BaseFee, err = elems[15].GetUint64(); err != nil {
+			return err
+		}
+	}
+
 	// compute the hash after the decoding
 	h.ComputeHash()
 
@@ -193,12 +201,33 @@ func (r *Receipts) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	return nil
 }
 
+// UnmarshalRLP unmarshals a Receipt in its consensus form (legacy or typed)
 func (r *Receipt) UnmarshalRLP(input []byte) error {
+	if isTypedEnvelope(input) {
+		r.TransactionType = TxType(input[0])
+
+		return UnmarshalRlp(r.unmarshalPayloadFrom, input[1:])
+	}
+
 	return UnmarshalRlp(r.UnmarshalRLPFrom, input)
 }
 
-// UnmarshalRLP unmarshals a Receipt in RLP format
+// UnmarshalRLPFrom unmarshals a Receipt in RLP format
 func (r *Receipt) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	if v.Type() == fastrlp.TypeBytes {
+		// typed receipt wrapped into an RLP string
+		envelope, err := v.Bytes()
+		if err != nil {
+			return err
+		}
+
+		return r.UnmarshalRLP(envelope)
+	}
+
+	return r.unmarshalPayloadFrom(p, v)
+}
+
+func (r *Receipt) unmarshalPayloadFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	elems, err := v.GetElems()
 	if err != nil {
 		return err
@@ -288,12 +317,46 @@ func (l *Log) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 	return nil
 }
 
+// isTypedEnvelope checks if the input is an EIP-2718 envelope (type || payload)
+// rather than a legacy RLP list
+func isTypedEnvelope(input []byte) bool {
+	return len(input) > 0 && input[0] <= 0x7f
+}
+
+// UnmarshalRLP unmarshals a Transaction in its consensus form (legacy or typed)
 func (t *Transaction) UnmarshalRLP(input []byte) error {
+	if isTypedEnvelope(input) {
+		t.Type = TxType(input[0])
+		if t.Type != AccessListTx && t.Type != DynamicFeeTx {
+			return fmt.Errorf("transaction type %d not supported", t.Type)
+		}
+
+		if err := UnmarshalRlp(t.unmarshalTypedPayloadFrom, input[1:]); err != nil {
+			return err
+		}
+
+		copy(t.Hash[:], keccak.Keccak256(nil, input))
+
+		return nil
+	}
+
 	return UnmarshalRlp(t.UnmarshalRLPFrom, input)
 }
 
 // UnmarshalRLPFrom unmarshals a Transaction in RLP format
 func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	if v.Type() == fastrlp.TypeBytes {
+		// typed transaction wrapped into an RLP string
+		envelope, err := v.Bytes()
+		if err != nil {
+			return err
+		}
+
+		return t.UnmarshalRLP(envelope)
+	}
+
+	t.Type = LegacyTx
+
 	elems, err := v.GetElems()
 	if err != nil {
 		return err
@@ -356,3 +419,143 @@ func (t *Transaction) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 
 	return nil
 }
+
+// unmarshalTypedPayloadFrom unmarshals the payload of a typed transaction
+func (t *Transaction) unmarshalTypedPayloadFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	// access list transactions have a single gas price field instead of the tip and fee caps
+	num := 12
+	if t.Type == AccessListTx {
+		num = 11
+	}
+
+	if len(elems) < num {
+		return fmt.Errorf("incorrect number of elements to decode transaction, expected %d but found %d", num, len(elems))
+	}
+
+	// chainID
+	t.ChainID = new(big.Int)
+	if err := elems[0].GetBigInt(t.ChainID); err != nil {
+		return err
+	}
+	// nonce
+	if t.Nonce, err = elems[1].GetUint64(); err != nil {
+		return err
+	}
+
+	t.GasPrice = new(big.Int)
+
+	if t.Type == DynamicFeeTx {
+		// gasTipCap
+		t.GasTipCap = new(big.Int)
+		if err := elems[2].GetBigInt(t.GasTipCap); err != nil {
+			return err
+		}
+		// gasFeeCap
+		t.GasFeeCap = new(big.Int)
+		if err := elems[3].GetBigInt(t.GasFeeCap); err != nil {
+			return err
+		}
+
+		elems = elems[4:]
+	} else {
+		// gasPrice
+		if err := elems[2].GetBigInt(t.GasPrice); err != nil {
+			return err
+		}
+
+		elems = elems[3:]
+	}
+
+	// gas
+	if t.Gas, err = elems[0].GetUint64(); err != nil {
+		return err
+	}
+	// to
+	if vv, _ := elems[1].Bytes(); len(vv) == 20 {
+		// address
+		addr := BytesToAddress(vv)
+		t.To = &addr
+	} else {
+		// reset To
+		t.To = nil
+	}
+	// value
+	t.Value = new(big.Int)
+	if err := elems[2].GetBigInt(t.Value); err != nil {
+		return err
+	}
+	// input
+	if t.Input, err = elems[3].GetBytes(t.Input[:0]); err != nil {
+		return err
+	}
+	// access list
+	if err := t.AccessList.unmarshalRLPFrom(p, elems[4]); err != nil {
+		return err
+	}
+	// V
+	t.V = new(big.Int)
+	if err = elems[5].GetBigInt(t.V); err != nil {
+		return err
+	}
+	// R
+	t.R = new(big.Int)
+	if err = elems[6].GetBigInt(t.R); err != nil {
+		return err
+	}
+	// S
+	t.S = new(big.Int)
+	if err = elems[7].GetBigInt(t.S); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// unmarshalRLPFrom unmarshals an access list in RLP format
+func (al *TxAccessList) unmarshalRLPFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
+	tuples, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	*al = nil
+
+	for _, tuple := range tuples {
+		elems, err := tuple.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(elems) < 2 {
+			return fmt.Errorf("incorrect number of elements to decode access tuple, expected 2 but found %d", len(elems))
+		}
+
+		accessTuple := AccessTuple{}
+		if err := elems[0].GetAddr(accessTuple.Address[:]); err != nil {
+			return err
+		}
+
+		keys, err := elems[1].GetElems()
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			var storageKey Hash
+			if err := key.GetHash(storageKey[:]); err != nil {
+				return err
+			}
+
+			accessTuple.StorageKeys = append(accessTuple.StorageKeys, storageKey)
+		}
+
+		*al = append(*al, accessTuple)
+	}
+
+	return nil
+}
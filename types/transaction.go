@@ -7,6 +7,28 @@ import (
 	"github.com/LaChain/polygon-edge/helper/keccak"
 )
 
+// TxType is the EIP-2718 type of the transaction
+type TxType byte
+
+const (
+	LegacyTx     TxType = 0x00
+	AccessListTx TxType = 0x01
+	DynamicFeeTx TxType = 0x02
+)
+
+func (t TxType) String() string {
+	switch t {
+	case LegacyTx:
+		return "LegacyTx"
+	case AccessListTx:
+		return "AccessListTx"
+	case DynamicFeeTx:
+		return "DynamicFeeTx"
+	default:
+		return "UnknownTx"
+	}
+}
+
 type Transaction struct {
 	Nonce    uint64
 	GasPrice *big.Int
@@ -20,10 +42,55 @@ type Transaction struct {
 	Hash     Hash
 	From     Address
 
+	// EIP-2718 typed transaction fields
+	Type       TxType
+	ChainID    *big.Int
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	AccessList TxAccessList
+
 	// Cache
 	size atomic.Value
 }
 
+// AccessTuple is an address and the storage keys
+// the transaction plans to access (EIP-2930)
+type AccessTuple struct {
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
+}
+
+// TxAccessList is the access list of a transaction (EIP-2930)
+type TxAccessList []AccessTuple
+
+// StorageKeys returns the total number of storage keys in the access list
+func (al TxAccessList) StorageKeys() int {
+	keys := 0
+	for _, tuple := range al {
+		keys += len(tuple.StorageKeys)
+	}
+
+	return keys
+}
+
+// Copy returns a deep copy of the access list
+func (al TxAccessList) Copy() TxAccessList {
+	if al == nil {
+		return nil
+	}
+
+	newAccessList := make(TxAccessList, len(al))
+
+	for i, tuple := range al {
+		newAccessList[i] = AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]Hash{}, tuple.StorageKeys...),
+		}
+	}
+
+	return newAccessList
+}
+
 // IsContractCreation checks if tx is contract creation
 func (t *Transaction) IsContractCreation() bool {
 	return t.To == nil
@@ -31,6 +98,13 @@ func (t *Transaction) IsContractCreation() bool {
 
 // ComputeHash computes the hash of the transaction
 func (t *Transaction) ComputeHash() *Transaction {
+	if t.Type != LegacyTx {
+		// typed transactions are hashed over their envelope
+		copy(t.Hash[:], keccak.Keccak256(nil, t.MarshalRLP()))
+
+		return t
+	}
+
 	ar := marshalArenaPool.Get()
 	hash := keccak.DefaultKeccakPool.Get()
 
@@ -57,6 +131,18 @@ func (t *Transaction) Copy() *Transaction {
 		tt.Value.Set(t.Value)
 	}
 
+	if t.ChainID != nil {
+		tt.ChainID = new(big.Int).Set(t.ChainID)
+	}
+
+	if t.GasTipCap != nil {
+		tt.GasTipCap = new(big.Int).Set(t.GasTipCap)
+	}
+
+	if t.GasFeeCap != nil {
+		tt.GasFeeCap = new(big.Int).Set(t.GasFeeCap)
+	}
+
 	if t.R != nil {
 		tt.R = new(big.Int)
 		tt.R = big.NewInt(0).SetBits(t.R.Bits())
@@ -70,17 +156,55 @@ func (t *Transaction) Copy() *Transaction {
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
+	tt.AccessList = t.AccessList.Copy()
+
 	return tt
 }
 
-// Cost returns gas * gasPrice + value
+// Cost returns gas * gasPrice + value.
+// For dynamic fee transactions the fee cap is used as the gas price
 func (t *Transaction) Cost() *big.Int {
-	total := new(big.Int).Mul(t.GasPrice, new(big.Int).SetUint64(t.Gas))
+	price := t.GasPrice
+	if t.Type == DynamicFeeTx {
+		price = t.GasFeeCap
+	}
+
+	total := new(big.Int).Mul(price, new(big.Int).SetUint64(t.Gas))
 	total.Add(total, t.Value)
 
 	return total
 }
 
+// GetGasPrice returns the price per gas the transaction pays in a block with the given base fee.
+// For dynamic fee transactions it is min(gasFeeCap, gasTipCap + baseFee),
+// or the fee cap if the base fee is not known
+func (t *Transaction) GetGasPrice(baseFee uint64) *big.Int {
+	if t.Type != DynamicFeeTx {
+		return new(big.Int).Set(t.GasPrice)
+	}
+
+	if baseFee == 0 {
+		return new(big.Int).Set(t.GasFeeCap)
+	}
+
+	price := new(big.Int).Add(t.GasTipCap, new(big.Int).SetUint64(baseFee))
+	if price.Cmp(t.GasFeeCap) > 0 {
+		price.Set(t.GasFeeCap)
+	}
+
+	return price
+}
+
+// EffectiveTip returns the part of the gas price that goes to the block proposer
+// in a block with the given base fee. It is negative if the transaction can't pay the base fee
+func (t *Transaction) EffectiveTip(baseFee uint64) *big.Int {
+	if baseFee == 0 {
+		return t.GetGasPrice(0)
+	}
+
+	return new(big.Int).Sub(t.GetGasPrice(baseFee), new(big.Int).SetUint64(baseFee))
+}
+
 func (t *Transaction) Size() uint64 {
 	if size := t.size.Load(); size != nil {
 		sizeVal, ok := size.(uint64)
@@ -102,5 +226,10 @@ func (t *Transaction) ExceedsBlockGasLimit(blockGasLimit uint64) bool {
 }
 
 func (t *Transaction) IsUnderpriced(priceLimit uint64) bool {
-	return t.GasPrice.Cmp(big.NewInt(0).SetUint64(priceLimit)) < 0
+	price := t.GasPrice
+	if t.Type == DynamicFeeTx {
+		price = t.GasFeeCap
+	}
+
+	return price.Cmp(big.NewInt(0).SetUint64(priceLimit)) < 0
 }
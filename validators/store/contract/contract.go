@@ -3,6 +3,7 @@ package contract
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/LaChain/polygon-edge/state"
 	"github.com/LaChain/polygon-edge/types"
@@ -21,6 +22,7 @@ const (
 var (
 	ErrSignerNotFound                 = errors.New("signer not found")
 	ErrInvalidValidatorsTypeAssertion = errors.New("invalid type assertion for Validators")
+	ErrInvalidStakesTypeAssertion     = errors.New("invalid type assertion for validator stakes")
 )
 
 type ContractValidatorStore struct {
@@ -30,6 +32,8 @@ type ContractValidatorStore struct {
 
 	// LRU cache for the validators
 	validatorSetCache *lru.Cache
+	// LRU cache for the stakes of the validators
+	stakesCache *lru.Cache
 }
 
 type Executor interface {
@@ -44,6 +48,7 @@ func NewContractValidatorStore(
 ) (*ContractValidatorStore, error) {
 	var (
 		validatorsCache *lru.Cache
+		stakesCache     *lru.Cache
 		err             error
 	)
 
@@ -51,6 +56,10 @@ func NewContractValidatorStore(
 		if validatorsCache, err = lru.New(validatorSetCacheSize); err != nil {
 			return nil, fmt.Errorf("unable to create validator set cache, %w", err)
 		}
+
+		if stakesCache, err = lru.New(validatorSetCacheSize); err != nil {
+			return nil, fmt.Errorf("unable to create stakes cache, %w", err)
+		}
 	}
 
 	return &ContractValidatorStore{
@@ -58,6 +67,7 @@ func NewContractValidatorStore(
 		blockchain:        blockchain,
 		executor:          executor,
 		validatorSetCache: validatorsCache,
+		stakesCache:       stakesCache,
 	}, nil
 }
 
@@ -93,13 +103,55 @@ func (s *ContractValidatorStore) GetValidatorsByHeight(
 	return fetchedValidators, nil
 }
 
+// GetValidatorStakesByHeight returns the amounts staked by the validators at the given height
+func (s *ContractValidatorStore) GetValidatorStakesByHeight(
+	validatorType validators.ValidatorType,
+	height uint64,
+) (map[types.Address]*big.Int, error) {
+	cachedStakes, err := s.loadCachedStakes(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if cachedStakes != nil {
+		return cachedStakes, nil
+	}
+
+	vals, err := s.GetValidatorsByHeight(validatorType, height)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := s.getTransitionForQuery(height)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchedStakes, err := FetchValidatorStakes(vals, transition, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	s.saveToStakesCache(height, fetchedStakes)
+
+	return fetchedStakes, nil
+}
+
 func (s *ContractValidatorStore) getTransitionForQuery(height uint64) (*state.Transition, error) {
 	header, ok := s.blockchain.GetHeaderByNumber(height)
 	if !ok {
 		return nil, fmt.Errorf("header not found at %d", height)
 	}
 
-	return s.executor.BeginTxn(header.StateRoot, header, types.ZeroAddress)
+	transition, err := s.executor.BeginTxn(header.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the queries without a gas price should not be rejected for not paying the base fee
+	transition.SetNoBaseFee(true)
+
+	return transition, nil
 }
 
 // loadCachedValidatorSet loads validators from validatorSetCache
@@ -125,3 +177,31 @@ func (s *ContractValidatorStore) saveToValidatorSetCache(height uint64, validato
 
 	return s.validatorSetCache.Add(height, validators)
 }
+
+// loadCachedStakes loads the stakes of the validators from stakesCache
+func (s *ContractValidatorStore) loadCachedStakes(height uint64) (map[types.Address]*big.Int, error) {
+	if s.stakesCache == nil {
+		return nil, nil
+	}
+
+	cachedRawStakes, ok := s.stakesCache.Get(height)
+	if !ok {
+		return nil, nil
+	}
+
+	stakes, ok := cachedRawStakes.(map[types.Address]*big.Int)
+	if !ok {
+		return nil, ErrInvalidStakesTypeAssertion
+	}
+
+	return stakes, nil
+}
+
+// saveToStakesCache saves the stakes of the validators to stakesCache
+func (s *ContractValidatorStore) saveToStakesCache(height uint64, stakes map[types.Address]*big.Int) bool {
+	if s.stakesCache == nil {
+		return false
+	}
+
+	return s.stakesCache.Add(height, stakes)
+}
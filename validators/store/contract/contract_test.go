@@ -2,6 +2,7 @@ package contract
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/LaChain/polygon-edge/chain"
@@ -78,6 +79,15 @@ func newTestTransition(
 ) *state.Transition {
 	t.Helper()
 
+	return newTestTransitionWithBaseFee(t, 0)
+}
+
+func newTestTransitionWithBaseFee(
+	t *testing.T,
+	baseFee uint64,
+) *state.Transition {
+	t.Helper()
+
 	st := itrie.NewState(itrie.NewMemoryStorage())
 
 	ex := state.NewExecutor(&chain.Params{
@@ -97,6 +107,7 @@ func newTestTransition(
 		&types.Header{
 			// Set enough block gas limit for query
 			GasLimit: testBlockGasLimit,
+			BaseFee:  baseFee,
 		},
 		types.ZeroAddress,
 	)
@@ -111,7 +122,15 @@ func newTestTransitionWithPredeployedStakingContract(
 ) *state.Transition {
 	t.Helper()
 
-	transition := newTestTransition(t)
+	return predeployStakingContract(t, newTestTransition(t), validators)
+}
+
+func predeployStakingContract(
+	t *testing.T,
+	transition *state.Transition,
+	validators validators.Validators,
+) *state.Transition {
+	t.Helper()
 
 	contractState, err := stakingHelper.PredeployStakingSC(
 		validators,
@@ -136,9 +155,10 @@ func newTestContractValidatorStore(
 ) *ContractValidatorStore {
 	t.Helper()
 
-	var cache *lru.Cache
+	var cache, stakesCache *lru.Cache
 	if cacheSize > 0 {
 		cache = newTestCache(t, cacheSize)
+		stakesCache = newTestCache(t, cacheSize)
 	}
 
 	return &ContractValidatorStore{
@@ -146,6 +166,7 @@ func newTestContractValidatorStore(
 		blockchain:        blockchain,
 		executor:          executor,
 		validatorSetCache: cache,
+		stakesCache:       stakesCache,
 	}
 }
 
@@ -176,6 +197,7 @@ func TestNewContractValidatorStore(t *testing.T) {
 				blockchain:        blockchain,
 				executor:          executor,
 				validatorSetCache: newTestCache(t, 1),
+				stakesCache:       newTestCache(t, 1),
 			},
 			expectedErr: nil,
 		},
@@ -447,6 +469,76 @@ func TestContractValidatorStoreGetValidators(t *testing.T) {
 	}
 }
 
+func TestContractValidatorStoreGetValidatorStakes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		header = &types.Header{
+			StateRoot: types.StringToHash("1"),
+			BaseFee:   1000,
+		}
+
+		ecdsaValidators = validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(addr1),
+			validators.NewECDSAValidator(addr2),
+		)
+
+		defaultStake, _ = new(big.Int).SetString(stakingHelper.DefaultStakedBalance[2:], 16)
+
+		expectedStakes = map[types.Address]*big.Int{
+			addr1: defaultStake,
+			addr2: defaultStake,
+		}
+
+		beginTxnCalls = 0
+
+		store = newTestContractValidatorStore(
+			t,
+			&store.MockBlockchain{
+				GetHeaderByNumberFn: func(height uint64) (*types.Header, bool) {
+					assert.Equal(t, uint64(1), height)
+
+					return header, true
+				},
+			},
+			&mockExecutor{
+				BeginTxnFn: func(_ types.Hash, head *types.Header, _ types.Address) (*state.Transition, error) {
+					assert.Equal(t, header, head)
+
+					beginTxnCalls++
+
+					// the queries don't pay the base fee of the header
+					return predeployStakingContract(
+						t,
+						newTestTransitionWithBaseFee(t, head.BaseFee),
+						ecdsaValidators,
+					), nil
+				},
+			},
+			1,
+		)
+	)
+
+	stakes, err := store.GetValidatorStakesByHeight(validators.ECDSAValidatorType, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStakes, stakes)
+	assert.Equal(t, 2, beginTxnCalls)
+
+	// the stakes are loaded from the cache
+	stakes, err = store.GetValidatorStakesByHeight(validators.ECDSAValidatorType, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStakes, stakes)
+	assert.Equal(t, 2, beginTxnCalls)
+
+	// the invalid cache is reported
+	store.stakesCache.Add(uint64(2), "fake")
+
+	_, err = store.GetValidatorStakesByHeight(validators.ECDSAValidatorType, 2)
+	assert.ErrorIs(t, err, ErrInvalidStakesTypeAssertion)
+}
+
 func TestContractValidatorStore_CacheChange(t *testing.T) {
 	var (
 		cacheSize = 2
@@ -555,4 +647,11 @@ func TestContractValidatorStore_NoCache(t *testing.T) {
 	)
 
 	assert.Nil(t, store.validatorSetCache)
+
+	assert.False(
+		t,
+		store.saveToStakesCache(0, map[types.Address]*big.Int{}),
+	)
+
+	assert.Nil(t, store.stakesCache)
 }
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/LaChain/polygon-edge/contracts/staking"
 	"github.com/LaChain/polygon-edge/crypto"
@@ -81,3 +82,25 @@ func FetchBLSValidators(
 
 	return blsValidators, nil
 }
+
+// FetchValidatorStakes queries a contract for the amounts staked by the validators
+func FetchValidatorStakes(
+	vals validators.Validators,
+	transition *state.Transition,
+	from types.Address,
+) (map[types.Address]*big.Int, error) {
+	stakes := make(map[types.Address]*big.Int, vals.Len())
+
+	for idx := 0; idx < vals.Len(); idx++ {
+		addr := vals.At(uint64(idx)).Addr()
+
+		stake, err := staking.QueryAccountStake(transition, from, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		stakes[addr] = stake
+	}
+
+	return stakes, nil
+}
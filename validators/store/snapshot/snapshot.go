@@ -56,6 +56,8 @@ type SnapshotValidatorStore struct {
 	store          *snapshotStore
 	candidates     []*store.Candidate
 	candidatesLock sync.RWMutex
+	// jailed are the candidates proposed by the liveness policy
+	jailed map[types.Address]bool
 }
 
 // NewSnapshotValidatorStore creates and initializes *SnapshotValidatorStore
@@ -74,6 +76,7 @@ func NewSnapshotValidatorStore(
 		getSigner:      getSigner,
 		candidates:     make([]*store.Candidate, 0),
 		candidatesLock: sync.RWMutex{},
+		jailed:         make(map[types.Address]bool),
 		epochSize:      epochSize,
 	}
 
@@ -365,6 +368,68 @@ func (s *SnapshotValidatorStore) Propose(candidate validators.Validator, auth bo
 	)
 }
 
+// ProposeJailed replaces the removal candidates proposed by the liveness policy with the jailed validators.
+// The candidates proposed by the operator are kept
+func (s *SnapshotValidatorStore) ProposeJailed(jailed validators.Validators, proposer types.Address) {
+	s.candidatesLock.Lock()
+	defer s.candidatesLock.Unlock()
+
+	var (
+		wasJailed  = s.jailed
+		candidates = make(map[types.Address]bool, len(s.candidates))
+	)
+
+	s.jailed = make(map[types.Address]bool, jailed.Len())
+
+	// the validators removed already are not candidates anymore
+	if snap := s.getLatestSnapshot(); snap != nil {
+		s.cleanObsoleteCandidates(snap.Set)
+	}
+
+	// withdraw the candidates who are not jailed anymore
+	newCandidates := make([]*store.Candidate, 0, len(s.candidates))
+
+	for _, candidate := range s.candidates {
+		addr := candidate.Validator.Addr()
+
+		if wasJailed[addr] && !jailed.Includes(addr) {
+			s.logger.Info("withdrew the removal of the validator not jailed anymore", "validator", addr)
+
+			continue
+		}
+
+		candidates[addr] = true
+		newCandidates = append(newCandidates, candidate)
+	}
+
+	s.candidates = newCandidates
+
+	for idx := 0; idx < jailed.Len(); idx++ {
+		validator := jailed.At(uint64(idx))
+		addr := validator.Addr()
+
+		if addr == proposer {
+			continue
+		}
+
+		if candidates[addr] {
+			if wasJailed[addr] {
+				s.jailed[addr] = true
+			}
+
+			continue
+		}
+
+		s.logger.Info("proposed the removal of the jailed validator", "validator", addr)
+
+		s.candidates = append(s.candidates, &store.Candidate{
+			Validator: validator,
+			Authorize: false,
+		})
+		s.jailed[addr] = true
+	}
+}
+
 // AddCandidate adds new candidate to candidate list
 // unsafe against concurrent access
 func (s *SnapshotValidatorStore) addCandidate(
@@ -93,6 +93,7 @@ func newTestSnapshotValidatorStore(
 		getSigner:      getSigner,
 		candidates:     candidates,
 		candidatesLock: sync.RWMutex{},
+		jailed:         make(map[types.Address]bool),
 		epochSize:      epochSize,
 	}
 }
@@ -1814,6 +1815,55 @@ func TestSnapshotValidatorStorePropose(t *testing.T) {
 	}
 }
 
+func TestSnapshotValidatorStoreProposeJailed(t *testing.T) {
+	t.Parallel()
+
+	// the removal of ecdsaValidator3 is proposed by the operator
+	operatorCandidate := &store.Candidate{
+		Validator: ecdsaValidator3,
+		Authorize: false,
+	}
+
+	snapshotStore := newTestSnapshotValidatorStore(
+		nil,
+		nil,
+		20,
+		nil,
+		[]*store.Candidate{operatorCandidate},
+		10,
+	)
+
+	// the proposer doesn't vote for its own removal
+	snapshotStore.ProposeJailed(
+		validators.NewECDSAValidatorSet(ecdsaValidator1, ecdsaValidator2, ecdsaValidator3),
+		ecdsaValidator1.Address,
+	)
+
+	assert.Equal(
+		t,
+		[]*store.Candidate{
+			operatorCandidate,
+			{
+				Validator: ecdsaValidator2,
+				Authorize: false,
+			},
+		},
+		snapshotStore.Candidates(),
+	)
+
+	// the candidates of the validators not jailed anymore are withdrawn, except for the operator's
+	snapshotStore.ProposeJailed(
+		validators.NewECDSAValidatorSet(),
+		ecdsaValidator1.Address,
+	)
+
+	assert.Equal(
+		t,
+		[]*store.Candidate{operatorCandidate},
+		snapshotStore.Candidates(),
+	)
+}
+
 func TestSnapshotValidatorStore_addCandidate(t *testing.T) {
 	t.Parallel()
 